@@ -0,0 +1,206 @@
+package api
+
+import (
+	"gonews/protos/pb"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// favouriteParams - user_id и news_id сохранённой статьи из пути
+func favouriteParams(c *gin.Context) (uint64, uint64, bool) {
+	userID, err := strconv.ParseUint(c.Param("user_id"), 10, 64)
+	if err != nil || userID == 0 {
+		badRequest(c, "valid user_id is required")
+		return 0, 0, false
+	}
+
+	newsID, err := strconv.ParseUint(c.Param("news_id"), 10, 64)
+	if err != nil || newsID == 0 {
+		badRequest(c, "valid news_id is required")
+		return 0, 0, false
+	}
+
+	return userID, newsID, true
+}
+
+// getFavouriteAnnotation - статья с текстом и пометками пользователя
+func (h *Handler) getFavouriteAnnotation(c *gin.Context) {
+	userID, newsID, ok := favouriteParams(c)
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	resp, err := h.saveClient.GetFavouriteAnnotation(ctx, &pb.GetFavouriteAnnotationRequest{
+		UserId: userID,
+		NewsId: newsID,
+	})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	newsResp, err := h.saveClient.GetNewsByIDs(ctx, &pb.GetNewsByIDsRequest{Ids: []uint64{newsID}})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	var news *pb.News
+	if len(newsResp.News) > 0 {
+		news = newsResp.News[0]
+	}
+
+	c.JSON(http.StatusOK, gin.H{"news": news, "annotation": resp.Annotation})
+}
+
+func (h *Handler) setFavouriteTags(c *gin.Context) {
+	userID, newsID, ok := favouriteParams(c)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Tags []string `json:"tags"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		badRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.saveClient.SetFavouriteTags(c.Request.Context(), &pb.SetFavouriteTagsRequest{
+		UserId: userID,
+		NewsId: newsID,
+		Tags:   req.Tags,
+	})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"annotation": resp.Annotation})
+}
+
+func (h *Handler) setFavouriteNote(c *gin.Context) {
+	userID, newsID, ok := favouriteParams(c)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Note string `json:"note"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		badRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.saveClient.SetFavouriteNote(c.Request.Context(), &pb.SetFavouriteNoteRequest{
+		UserId: userID,
+		NewsId: newsID,
+		Note:   req.Note,
+	})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"annotation": resp.Annotation})
+}
+
+func (h *Handler) addHighlight(c *gin.Context) {
+	userID, newsID, ok := favouriteParams(c)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		StartOffset *int32 `json:"start_offset" binding:"required"`
+		EndOffset   *int32 `json:"end_offset" binding:"required"`
+		Comment     string `json:"comment"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		badRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.saveClient.AddHighlight(c.Request.Context(), &pb.AddHighlightRequest{
+		UserId:      userID,
+		NewsId:      newsID,
+		StartOffset: *req.StartOffset,
+		EndOffset:   *req.EndOffset,
+		Comment:     req.Comment,
+	})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"highlight": resp.Highlight})
+}
+
+func (h *Handler) deleteHighlight(c *gin.Context) {
+	userID, newsID, ok := favouriteParams(c)
+	if !ok {
+		return
+	}
+
+	highlightID, err := strconv.ParseUint(c.Param("highlight_id"), 10, 64)
+	if err != nil || highlightID == 0 {
+		badRequest(c, "valid highlight_id is required")
+		return
+	}
+
+	resp, err := h.saveClient.DeleteHighlight(c.Request.Context(), &pb.DeleteHighlightRequest{
+		UserId:      userID,
+		NewsId:      newsID,
+		HighlightId: highlightID,
+	})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": resp.Success})
+}
+
+// searchFavourites - сохранённые статьи с отбором по ?tag= и поиском по заметкам ?q=
+func (h *Handler) searchFavourites(c *gin.Context) {
+	userID, err := strconv.ParseUint(c.Param("user_id"), 10, 64)
+	if err != nil || userID == 0 {
+		badRequest(c, "valid user_id is required")
+		return
+	}
+
+	cursor, limit, err := pageParams(c)
+	if err != nil {
+		badRequest(c, err.Error())
+		return
+	}
+
+	req := &pb.SearchFavouritesRequest{
+		UserId:    userID,
+		PageToken: cursor,
+		PageSize:  limit,
+	}
+	if tag := c.Query("tag"); tag != "" {
+		req.Tag = &tag
+	}
+	if q := c.Query("q"); q != "" {
+		req.NoteQuery = &q
+	}
+
+	resp, err := h.saveClient.SearchFavourites(c.Request.Context(), req)
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	setNextLink(c, resp.NextPageToken)
+	c.JSON(http.StatusOK, gin.H{"favourites": resp.Favourites, "next_cursor": resp.NextPageToken})
+}
@@ -0,0 +1,98 @@
+package api
+
+import (
+	"gonews/protos/pb"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// createAPIKey - выпускает ключ; открытое значение ключа есть только в этом ответе
+func (h *Handler) createAPIKey(c *gin.Context) {
+	var req struct {
+		Name   string   `json:"name" binding:"required"`
+		Scopes []string `json:"scopes" binding:"required"`
+		UserID uint64   `json:"user_id"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		badRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.saveClient.CreateAPIKey(c.Request.Context(), &pb.CreateAPIKeyRequest{
+		Name:   req.Name,
+		Scopes: req.Scopes,
+		UserId: req.UserID,
+	})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"api_key": resp.ApiKey, "key": resp.Key})
+}
+
+func (h *Handler) listAPIKeys(c *gin.Context) {
+	cursor, limit, err := pageParams(c)
+	if err != nil {
+		badRequest(c, err.Error())
+		return
+	}
+
+	includeRevoked, _ := strconv.ParseBool(c.Query("include_revoked"))
+
+	resp, err := h.saveClient.ListAPIKeys(c.Request.Context(), &pb.ListAPIKeysRequest{
+		PageToken:      cursor,
+		PageSize:       limit,
+		IncludeRevoked: includeRevoked,
+	})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	setNextLink(c, resp.NextPageToken)
+	c.JSON(http.StatusOK, gin.H{"api_keys": resp.ApiKeys, "next_cursor": resp.NextPageToken})
+}
+
+// rotateAPIKey - новый секрет для ключа, старый перестаёт работать сразу
+func (h *Handler) rotateAPIKey(c *gin.Context) {
+	id, ok := apiKeyID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.saveClient.RotateAPIKey(c.Request.Context(), &pb.RotateAPIKeyRequest{Id: id})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"api_key": resp.ApiKey, "key": resp.Key})
+}
+
+func (h *Handler) revokeAPIKey(c *gin.Context) {
+	id, ok := apiKeyID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.saveClient.RevokeAPIKey(c.Request.Context(), &pb.RevokeAPIKeyRequest{Id: id})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": resp.Success})
+}
+
+func apiKeyID(c *gin.Context) (uint64, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || id == 0 {
+		badRequest(c, "valid id is required")
+		return 0, false
+	}
+	return id, true
+}
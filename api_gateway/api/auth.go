@@ -0,0 +1,189 @@
+package api
+
+import (
+	"gonews/protos/pb"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	scopeReadSearch      = "read:search"
+	scopeReadUser        = "read:user"
+	scopeWriteFavourites = "write:favourites"
+	scopeAdmin           = "admin"
+
+	apiKeyContextKey = "api_key"
+)
+
+// userReadRoutes - чтение профиля и выгрузка данных пользователя: требуют read:user
+// и не открываются auth.anonymous_reads
+var userReadRoutes = map[string]bool{
+	"/api/user/export":   true,
+	"/api/user/:user_id": true,
+}
+
+var userReadRESTRoutes = []string{
+	"/v1/users/{user_id}",
+}
+
+// sharedRoutes - публичные ссылки на коллекции: ключ не нужен, доступ даёт сам токен ссылки
+var sharedRoutes = map[string]bool{
+	"/api/shared/collections/:token": true,
+}
+
+var sharedRESTRoutes = []string{
+	"/v1/shared/collections/{share_token}",
+}
+
+// favouriteRoutes - шаблоны маршрутов gin, изменения по которым требуют write:favourites;
+// остальные изменения - admin
+var favouriteRoutes = map[string]bool{
+	"/api/news/:id/seen": true,
+	"/api/favourite/set": true,
+	"/api/favourite/annotations/:user_id/:news_id/tags":                     true,
+	"/api/favourite/annotations/:user_id/:news_id/note":                     true,
+	"/api/favourite/annotations/:user_id/:news_id/highlights":               true,
+	"/api/favourite/annotations/:user_id/:news_id/highlights/:highlight_id": true,
+	"/api/collections":                             true,
+	"/api/collections/:user_id/:id":                true,
+	"/api/collections/:user_id/:id/items":          true,
+	"/api/collections/:user_id/:id/items/:news_id": true,
+	"/api/collections/:user_id/:id/items/copy":     true,
+	"/api/collections/:user_id/:id/order":          true,
+	"/api/collections/:user_id/:id/share":          true,
+}
+
+// favouriteRESTRoutes - то же для /v1: gin видит их как один /v1/*path, поэтому шаблоны
+// google.api.http из news_service.proto сверяются с путём запроса
+var favouriteRESTRoutes = []string{
+	"/v1/users/{user_id}/favourites",
+	"/v1/users/{user_id}/favourites/{news_id}/tags",
+	"/v1/users/{user_id}/favourites/{news_id}/note",
+	"/v1/users/{user_id}/favourites/{news_id}/highlights",
+	"/v1/users/{user_id}/favourites/{news_id}/highlights/{highlight_id}",
+	"/v1/users/{user_id}/seen",
+	"/v1/users/{user_id}/seen:remove",
+	"/v1/users/{user_id}/collections",
+	"/v1/users/{user_id}/collections/{collection_id}",
+	"/v1/users/{user_id}/collections/{collection_id}/items",
+	"/v1/users/{user_id}/collections/{collection_id}/items/{news_id}",
+	"/v1/users/{user_id}/collections/{from_collection_id}/items:copy",
+	"/v1/users/{user_id}/collections/{collection_id}/order",
+	"/v1/users/{user_id}/collections/{collection_id}:share",
+}
+
+// authenticate - проверяем X-API-Key в save service и права ключа на маршрут.
+// Поиск, профили, изменения и /api/admin всегда требуют ключ; остальное чтение без ключа - только при
+// auth.anonymous_reads.
+// Публичные ссылки на коллекции, health, метрики и документация открыты.
+func (h *Handler) authenticate(c *gin.Context) {
+	if !isAPIRoute(c) || matchRoute(c, sharedRoutes, sharedRESTRoutes) {
+		c.Next()
+		return
+	}
+	scope := requiredScope(c)
+
+	secret := c.GetHeader("X-API-Key")
+	if secret == "" {
+		if scope != "" || !h.anonymousReads {
+			writeProblem(c, http.StatusUnauthorized, "unauthenticated", "X-API-Key header is required")
+			return
+		}
+		c.Next()
+		return
+	}
+
+	resp, err := h.saveClient.AuthenticateAPIKey(c.Request.Context(), &pb.AuthenticateAPIKeyRequest{Key: secret})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	if !hasScope(resp.ApiKey.Scopes, scope) {
+		writeProblem(c, http.StatusForbidden, "permission_denied", "API key lacks the "+scope+" scope")
+		return
+	}
+
+	c.Set(apiKeyContextKey, resp.ApiKey)
+	c.Next()
+}
+
+// requiredScope - право ключа, нужное для маршрута; пусто, если хватает любого действующего ключа
+func requiredScope(c *gin.Context) string {
+	read := c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead
+	switch {
+	case isAdminRoute(c):
+		return scopeAdmin
+	case routeGroup(c) == "search":
+		return scopeReadSearch
+	case read && matchRoute(c, userReadRoutes, userReadRESTRoutes):
+		return scopeReadUser
+	case read:
+		return ""
+	case matchRoute(c, favouriteRoutes, favouriteRESTRoutes):
+		return scopeWriteFavourites
+	default:
+		return scopeAdmin
+	}
+}
+
+// isAPIRoute - маршруты /api и /v1; неизвестные пути тоже закрыты, чтобы 404 не подсказывал маршруты
+func isAPIRoute(c *gin.Context) bool {
+	path := c.FullPath()
+	if path == "" {
+		path = c.Request.URL.Path
+	}
+	return strings.HasPrefix(path, "/api/") || strings.HasPrefix(path, restPrefix+"/")
+}
+
+func isAdminRoute(c *gin.Context) bool {
+	return strings.HasPrefix(c.FullPath(), "/api/admin/")
+}
+
+// matchRoute - маршрут gin из routes или, для /v1, путь запроса под одним из шаблонов restRoutes
+func matchRoute(c *gin.Context, routes map[string]bool, restRoutes []string) bool {
+	if routes[c.FullPath()] {
+		return true
+	}
+	if !strings.HasPrefix(c.FullPath(), restPrefix+"/") {
+		return false
+	}
+	for _, template := range restRoutes {
+		if matchTemplate(template, c.Request.URL.Path) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchTemplate - путь подходит под шаблон google.api.http: {var} - один непустой сегмент,
+// суффикс после переменной ({id}:share) должен совпасть
+func matchTemplate(template, path string) bool {
+	templateParts := strings.Split(template, "/")
+	pathParts := strings.Split(path, "/")
+	if len(templateParts) != len(pathParts) {
+		return false
+	}
+
+	for i, part := range templateParts {
+		if !strings.HasPrefix(part, "{") {
+			if part != pathParts[i] {
+				return false
+			}
+			continue
+		}
+		suffix := part[strings.Index(part, "}")+1:]
+		if len(pathParts[i]) <= len(suffix) || !strings.HasSuffix(pathParts[i], suffix) {
+			return false
+		}
+	}
+	return true
+}
+
+// hasScope - admin включает все остальные права
+func hasScope(scopes []string, scope string) bool {
+	return scope == "" || slices.Contains(scopes, scope) || slices.Contains(scopes, scopeAdmin)
+}
@@ -0,0 +1,108 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gotest.tools/v3/assert"
+)
+
+type authSaveClient struct {
+	pb.SaveServiceClient
+}
+
+func (authSaveClient) AuthenticateAPIKey(_ context.Context, req *pb.AuthenticateAPIKeyRequest, _ ...grpc.CallOption) (*pb.APIKeyResponse, error) {
+	switch req.Key {
+	case "gnk_search":
+		return &pb.APIKeyResponse{ApiKey: &pb.APIKey{Id: 1, Scopes: []string{scopeReadSearch}}}, nil
+	case "gnk_profiles":
+		return &pb.APIKeyResponse{ApiKey: &pb.APIKey{Id: 4, Scopes: []string{scopeReadUser}}}, nil
+	case "gnk_user":
+		return &pb.APIKeyResponse{ApiKey: &pb.APIKey{Id: 5, Scopes: []string{scopeWriteFavourites}, UserId: 7}}, nil
+	case "gnk_writer":
+		return &pb.APIKeyResponse{ApiKey: &pb.APIKey{Id: 3, Scopes: []string{scopeWriteFavourites}}}, nil
+	case "gnk_admin":
+		return &pb.APIKeyResponse{ApiKey: &pb.APIKey{Id: 2, Scopes: []string{scopeAdmin}}}, nil
+	default:
+		return nil, status.Error(codes.Unauthenticated, "invalid or revoked API key")
+	}
+}
+
+func TestAuthenticateScopes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newRouter := func(anonymousReads bool) *gin.Engine {
+		h := &Handler{saveClient: authSaveClient{}, anonymousReads: anonymousReads}
+		router := gin.New()
+		router.Use(h.authenticate)
+		ok := func(c *gin.Context) { c.Status(http.StatusNoContent) }
+		router.GET("/livez", ok)
+		router.GET("/api/search/news", ok)
+		router.GET("/api/favourite/list/:user_id", ok)
+		router.GET("/api/user/export", ok)
+		router.GET("/api/user/:user_id", ok)
+		router.GET("/api/shared/collections/:token", ok)
+		router.POST("/api/favourite/set", ok)
+		router.POST("/api/favourite/search-rebuild", ok)
+		router.GET("/api/admin/api-keys", ok)
+		router.Any(restPrefix+"/*path", ok)
+		return router
+	}
+	strict, anonymous := newRouter(false), newRouter(true)
+
+	tests := []struct {
+		name       string
+		router     *gin.Engine
+		method     string
+		path       string
+		key        string
+		wantStatus int
+	}{
+		{"no key on health", strict, http.MethodGet, "/livez", "", http.StatusNoContent},
+		{"no key on shared collection", strict, http.MethodGet, "/api/shared/collections/tok123", "", http.StatusNoContent},
+		{"no key on REST shared collection", strict, http.MethodGet, "/v1/shared/collections/tok123", "", http.StatusNoContent},
+		{"no key on read", strict, http.MethodGet, "/api/favourite/list/1", "", http.StatusUnauthorized},
+		{"anonymous read", anonymous, http.MethodGet, "/api/favourite/list/1", "", http.StatusNoContent},
+		{"anonymous reads do not cover search", anonymous, http.MethodGet, "/api/search/news", "", http.StatusUnauthorized},
+		{"anonymous reads do not cover writes", anonymous, http.MethodPost, "/api/favourite/set", "", http.StatusUnauthorized},
+		{"anonymous reads do not cover REST writes", anonymous, http.MethodDelete, "/v1/users/1", "", http.StatusUnauthorized},
+		{"no key on admin route", anonymous, http.MethodGet, "/api/admin/api-keys", "", http.StatusUnauthorized},
+		{"invalid key", strict, http.MethodGet, "/api/search/news", "gnk_unknown", http.StatusUnauthorized},
+		{"search scope", strict, http.MethodGet, "/api/search/news", "gnk_search", http.StatusNoContent},
+		{"missing write scope", strict, http.MethodPost, "/api/favourite/set", "gnk_search", http.StatusForbidden},
+		{"write scope on REST route", strict, http.MethodPost, "/v1/users/1/collections/2:share", "gnk_writer", http.StatusNoContent},
+		{"write scope does not cover users", strict, http.MethodDelete, "/v1/users/1", "gnk_writer", http.StatusForbidden},
+		{"anonymous reads do not cover export", anonymous, http.MethodGet, "/api/user/export?user_id=1", "", http.StatusUnauthorized},
+		{"anonymous reads do not cover profiles", anonymous, http.MethodGet, "/v1/users/1", "", http.StatusUnauthorized},
+		{"export needs read:user", strict, http.MethodGet, "/api/user/export?user_id=1", "gnk_search", http.StatusForbidden},
+		{"profile needs read:user", strict, http.MethodGet, "/api/user/1", "gnk_writer", http.StatusForbidden},
+		{"read:user scope on export", strict, http.MethodGet, "/api/user/export?user_id=1", "gnk_profiles", http.StatusNoContent},
+		{"read:user scope on REST profile", strict, http.MethodGet, "/v1/users/1", "gnk_profiles", http.StatusNoContent},
+		{"read:user does not cover profile updates", strict, http.MethodPatch, "/v1/users/1", "gnk_profiles", http.StatusForbidden},
+		// путь похож на избранное, но маршрута write:favourites с таким шаблоном нет
+		{"lookalike path needs admin", strict, http.MethodPost, "/api/favourite/search-rebuild", "gnk_writer", http.StatusForbidden},
+		{"missing admin scope", strict, http.MethodGet, "/api/admin/api-keys", "gnk_search", http.StatusForbidden},
+		{"admin covers all scopes", strict, http.MethodPost, "/api/favourite/set", "gnk_admin", http.StatusNoContent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.key != "" {
+				req.Header.Set("X-API-Key", tt.key)
+			}
+
+			rec := httptest.NewRecorder()
+			tt.router.ServeHTTP(rec, req)
+
+			assert.Equal(t, rec.Code, tt.wantStatus)
+		})
+	}
+}
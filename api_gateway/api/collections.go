@@ -0,0 +1,327 @@
+package api
+
+import (
+	"gonews/protos/pb"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// collectionParams - user_id и id коллекции из пути; id = 0 - избранное пользователя
+func collectionParams(c *gin.Context) (uint64, uint64, bool) {
+	userID, err := strconv.ParseUint(c.Param("user_id"), 10, 64)
+	if err != nil || userID == 0 {
+		badRequest(c, "valid user_id is required")
+		return 0, 0, false
+	}
+
+	collectionID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		badRequest(c, "valid collection id is required")
+		return 0, 0, false
+	}
+
+	return userID, collectionID, true
+}
+
+func (h *Handler) createCollection(c *gin.Context) {
+	var req struct {
+		UserID uint64 `json:"user_id" binding:"required"`
+		Name   string `json:"name" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		badRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.saveClient.CreateCollection(c.Request.Context(), &pb.CreateCollectionRequest{
+		UserId: req.UserID,
+		Name:   req.Name,
+	})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"collection": resp.Collection})
+}
+
+func (h *Handler) listCollections(c *gin.Context) {
+	userID, err := strconv.ParseUint(c.Param("user_id"), 10, 64)
+	if err != nil || userID == 0 {
+		badRequest(c, "valid user_id is required")
+		return
+	}
+
+	cursor, limit, err := pageParams(c)
+	if err != nil {
+		badRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.saveClient.ListCollections(c.Request.Context(), &pb.ListCollectionsRequest{
+		UserId:    userID,
+		PageToken: cursor,
+		PageSize:  limit,
+	})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	setNextLink(c, resp.NextPageToken)
+	c.JSON(http.StatusOK, gin.H{"collections": resp.Collections, "next_cursor": resp.NextPageToken})
+}
+
+func (h *Handler) renameCollection(c *gin.Context) {
+	userID, collectionID, ok := collectionParams(c)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Name string `json:"name" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		badRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.saveClient.RenameCollection(c.Request.Context(), &pb.RenameCollectionRequest{
+		UserId:       userID,
+		CollectionId: collectionID,
+		Name:         req.Name,
+	})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"collection": resp.Collection})
+}
+
+func (h *Handler) deleteCollection(c *gin.Context) {
+	userID, collectionID, ok := collectionParams(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.saveClient.DeleteCollection(c.Request.Context(), &pb.DeleteCollectionRequest{
+		UserId:       userID,
+		CollectionId: collectionID,
+	})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": resp.Success})
+}
+
+func (h *Handler) getCollectionItems(c *gin.Context) {
+	userID, collectionID, ok := collectionParams(c)
+	if !ok {
+		return
+	}
+
+	cursor, limit, err := pageParams(c)
+	if err != nil {
+		badRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.saveClient.GetCollectionItems(c.Request.Context(), &pb.GetCollectionItemsRequest{
+		UserId:       userID,
+		CollectionId: collectionID,
+		PageToken:    cursor,
+		PageSize:     limit,
+	})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	setNextLink(c, resp.NextPageToken)
+	c.JSON(http.StatusOK, gin.H{
+		"collection":  resp.Collection,
+		"news":        resp.News,
+		"next_cursor": resp.NextPageToken,
+	})
+}
+
+func (h *Handler) addToCollection(c *gin.Context) {
+	userID, collectionID, ok := collectionParams(c)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		NewsID uint64 `json:"news_id" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		badRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.saveClient.AddToCollection(c.Request.Context(), &pb.AddToCollectionRequest{
+		UserId:       userID,
+		CollectionId: collectionID,
+		NewsId:       req.NewsID,
+	})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": resp.Success})
+}
+
+func (h *Handler) removeFromCollection(c *gin.Context) {
+	userID, collectionID, ok := collectionParams(c)
+	if !ok {
+		return
+	}
+
+	newsID, err := strconv.ParseUint(c.Param("news_id"), 10, 64)
+	if err != nil || newsID == 0 {
+		badRequest(c, "valid news_id is required")
+		return
+	}
+
+	resp, err := h.saveClient.RemoveFromCollection(c.Request.Context(), &pb.RemoveFromCollectionRequest{
+		UserId:       userID,
+		CollectionId: collectionID,
+		NewsId:       newsID,
+	})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": resp.Success})
+}
+
+// copyCollectionItems - копирует или переносит (move = true) новости в другую коллекцию
+func (h *Handler) copyCollectionItems(c *gin.Context) {
+	userID, collectionID, ok := collectionParams(c)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		TargetCollectionID uint64   `json:"target_collection_id"`
+		NewsIDs            []uint64 `json:"news_ids" binding:"required,min=1"`
+		Move               bool     `json:"move"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		badRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.saveClient.CopyCollectionItems(c.Request.Context(), &pb.CopyCollectionItemsRequest{
+		UserId:           userID,
+		FromCollectionId: collectionID,
+		ToCollectionId:   req.TargetCollectionID,
+		NewsIds:          req.NewsIDs,
+		Move:             req.Move,
+	})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": resp.Success})
+}
+
+// reorderCollection - перечисленные новости встают в начало коллекции в заданном порядке
+func (h *Handler) reorderCollection(c *gin.Context) {
+	userID, collectionID, ok := collectionParams(c)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		NewsIDs []uint64 `json:"news_ids" binding:"required,min=1"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		badRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.saveClient.ReorderCollection(c.Request.Context(), &pb.ReorderCollectionRequest{
+		UserId:       userID,
+		CollectionId: collectionID,
+		NewsIds:      req.NewsIDs,
+	})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": resp.Success})
+}
+
+// shareCollection - включает или отзывает ссылку только для чтения
+func (h *Handler) shareCollection(c *gin.Context) {
+	userID, collectionID, ok := collectionParams(c)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Shared bool `json:"shared"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		badRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.saveClient.ShareCollection(c.Request.Context(), &pb.ShareCollectionRequest{
+		UserId:       userID,
+		CollectionId: collectionID,
+		Shared:       req.Shared,
+	})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	result := gin.H{"collection": resp.Collection}
+	if token := resp.Collection.ShareToken; token != "" {
+		result["share_url"] = "/api/shared/collections/" + token
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// getSharedCollection - публичный просмотр коллекции по токену ссылки
+func (h *Handler) getSharedCollection(c *gin.Context) {
+	cursor, limit, err := pageParams(c)
+	if err != nil {
+		badRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.saveClient.GetSharedCollection(c.Request.Context(), &pb.GetSharedCollectionRequest{
+		ShareToken: c.Param("token"),
+		PageToken:  cursor,
+		PageSize:   limit,
+	})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	setNextLink(c, resp.NextPageToken)
+	c.JSON(http.StatusOK, gin.H{
+		"collection":  resp.Collection,
+		"news":        resp.News,
+		"next_cursor": resp.NextPageToken,
+	})
+}
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"encoding/json"
+	"gonews/pkg/logging"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const problemContentType = "application/problem+json"
+
+// problem - тело ошибки по RFC 7807; code - стабильный машинный код ошибки
+type problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Code     string `json:"code"`
+	Instance string `json:"instance,omitempty"`
+}
+
+// httpStatuses - соответствие кодов gRPC статусам HTTP
+var httpStatuses = map[codes.Code]int{
+	codes.InvalidArgument:    http.StatusBadRequest,
+	codes.OutOfRange:         http.StatusBadRequest,
+	codes.Unauthenticated:    http.StatusUnauthorized,
+	codes.PermissionDenied:   http.StatusForbidden,
+	codes.NotFound:           http.StatusNotFound,
+	codes.AlreadyExists:      http.StatusConflict,
+	codes.Aborted:            http.StatusConflict,
+	codes.FailedPrecondition: http.StatusUnprocessableEntity,
+	codes.ResourceExhausted:  http.StatusTooManyRequests,
+	codes.Canceled:           499,
+	codes.Unimplemented:      http.StatusNotImplemented,
+	codes.Unavailable:        http.StatusServiceUnavailable,
+	codes.DeadlineExceeded:   http.StatusGatewayTimeout,
+}
+
+// newProblem - тело ошибки для запроса r
+func newProblem(r *http.Request, httpStatus int, code, detail string) problem {
+	title := http.StatusText(httpStatus)
+	if title == "" {
+		title = "Client Closed Request"
+	}
+
+	return problem{
+		Type:     "about:blank",
+		Title:    title,
+		Status:   httpStatus,
+		Detail:   detail,
+		Code:     code,
+		Instance: r.URL.Path,
+	}
+}
+
+// writeProblem - отвечаем ошибкой в формате application/problem+json
+func writeProblem(c *gin.Context, httpStatus int, code, detail string) {
+	c.Render(httpStatus, problemRender{newProblem(c.Request, httpStatus, code, detail)})
+	c.Abort()
+}
+
+// badRequest - ошибка валидации запроса на стороне шлюза
+func badRequest(c *gin.Context, detail string) {
+	writeProblem(c, http.StatusBadRequest, "invalid_argument", detail)
+}
+
+// grpcError - переводим ошибку gRPC вызова в HTTP ответ
+func grpcError(c *gin.Context, err error) {
+	httpStatus, code, detail := translateGRPCError(c.Request, err)
+	writeProblem(c, httpStatus, code, detail)
+}
+
+// translateGRPCError - HTTP статус, код и текст ошибки gRPC; текст внутренних ошибок клиенту не отдаём
+func translateGRPCError(r *http.Request, err error) (int, string, string) {
+	st := status.Convert(err)
+
+	httpStatus, ok := httpStatuses[st.Code()]
+	if !ok {
+		slog.ErrorContext(r.Context(), "upstream call failed", "method", r.Method, "path", r.URL.Path, logging.Err(err))
+		return http.StatusInternalServerError, "internal", "internal server error"
+	}
+
+	detail := st.Message()
+	if httpStatus >= http.StatusInternalServerError {
+		// сообщения 5xx могут содержать адреса и детали инфраструктуры
+		slog.ErrorContext(r.Context(), "upstream call failed", "method", r.Method, "path", r.URL.Path, logging.Err(err))
+		detail = http.StatusText(httpStatus)
+	}
+
+	return httpStatus, codeName(st.Code()), detail
+}
+
+// codeName - имя кода gRPC в snake_case: NotFound -> not_found
+func codeName(code codes.Code) string {
+	name := code.String()
+
+	var b strings.Builder
+	for i, r := range name {
+		if r >= 'A' && r <= 'Z' {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r += 'a' - 'A'
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// problemRender - JSON с Content-Type application/problem+json
+type problemRender struct {
+	problem problem
+}
+
+func (r problemRender) Render(w http.ResponseWriter) error {
+	r.WriteContentType(w)
+	return json.NewEncoder(w).Encode(r.problem)
+}
+
+func (r problemRender) WriteContentType(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", problemContentType)
+}
@@ -0,0 +1,55 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gotest.tools/v3/assert"
+)
+
+func TestGRPCErrorProblemResponse(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   string
+		wantDetail string
+	}{
+		{"invalid argument", status.Error(codes.InvalidArgument, "query is required"), http.StatusBadRequest, "invalid_argument", "query is required"},
+		{"not found", status.Error(codes.NotFound, "news not found"), http.StatusNotFound, "not_found", "news not found"},
+		{"already exists", status.Error(codes.AlreadyExists, "already subscribed"), http.StatusConflict, "already_exists", "already subscribed"},
+		{"rate limited", status.Error(codes.ResourceExhausted, "slow down"), http.StatusTooManyRequests, "resource_exhausted", "slow down"},
+		{"unavailable", status.Error(codes.Unavailable, "dial tcp 10.0.0.5:50051: connection refused"), http.StatusServiceUnavailable, "unavailable", "Service Unavailable"},
+		{"deadline", status.Error(codes.DeadlineExceeded, "context deadline exceeded"), http.StatusGatewayTimeout, "deadline_exceeded", "Gateway Timeout"},
+		{"internal", status.Error(codes.Internal, "pq: relation \"users\" does not exist"), http.StatusInternalServerError, "internal", "internal server error"},
+		{"not a status", errors.New("boom"), http.StatusInternalServerError, "internal", "internal server error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = httptest.NewRequest(http.MethodGet, "/api/favourites/1", nil)
+
+			grpcError(c, tt.err)
+
+			assert.Equal(t, rec.Code, tt.wantStatus)
+			assert.Equal(t, rec.Header().Get("Content-Type"), problemContentType)
+
+			var body problem
+			assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			assert.Equal(t, body.Status, tt.wantStatus)
+			assert.Equal(t, body.Code, tt.wantCode)
+			assert.Equal(t, body.Detail, tt.wantDetail)
+			assert.Equal(t, body.Instance, "/api/favourites/1")
+		})
+	}
+}
@@ -1,30 +1,66 @@
 package api
 
 import (
-	"context"
 	"fmt"
 	"gonews/api_gateway/config"
+	"gonews/api_gateway/ratelimit"
+	"gonews/pkg/breaker"
+	"gonews/pkg/logging"
+	"gonews/pkg/metrics"
+	"gonews/pkg/tlsconfig"
+	"gonews/pkg/tracing"
 	"gonews/protos/pb"
 	"net/http"
 	"strconv"
-	"time"
+	"sync/atomic"
 
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 type Handler struct {
 	saveClient         pb.SaveServiceClient
 	searchClient       pb.SearchServiceClient
 	notificationClient pb.NotificationServiceClient
+	healthTargets      []healthTarget
+	restMux            http.Handler
+	limiter            RateLimiter
+	rateLimits         atomic.Pointer[map[string]ratelimit.Limit]
+	breakers           []*breaker.Breaker
+	trustedProxies     []string
+	anonymousReads     bool
 }
 
-func NewHandler(cfg *config.Config) *Handler {
+// untracedRoutes - маршруты, которые опрашиваются часто и не интересны в трейсах
+var untracedRoutes = map[string]bool{
+	"/livez":        true,
+	"/readyz":       true,
+	"/health":       true,
+	"/openapi.json": true,
+	"/docs":         true,
+	"/metrics":      true,
+}
+
+func NewHandler(cfg *config.Config, limiter RateLimiter) *Handler {
+	transport, err := tlsconfig.DialOption(cfg.TLS)
+	if err != nil {
+		panic(fmt.Sprintf("Failed to configure TLS: %v", err))
+	}
+
+	// Breaker-ы на каждый сервис: пока сервис недоступен, запросы к нему сразу получают 503
+	saveBreaker := breaker.New("save_service", cfg.CircuitBreaker, breaker.GRPCFailure)
+	searchBreaker := breaker.New("search_service", cfg.CircuitBreaker, breaker.GRPCFailure)
+	notificationBreaker := breaker.New("notification_service", cfg.CircuitBreaker, breaker.GRPCFailure)
+
 	// Подключаемся к save service
 	saveConn, err := grpc.Dial(
 		fmt.Sprintf("%s:%d", cfg.SaveService.Host, cfg.SaveService.Port),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		transport,
+		tracing.DialOption(),
+		logging.DialOption(),
+		metrics.DialOption(),
+		saveBreaker.DialOption(),
 	)
 	if err != nil {
 		panic(fmt.Sprintf("Failed to connect to save service: %v", err))
@@ -33,7 +69,11 @@ func NewHandler(cfg *config.Config) *Handler {
 	// Подключаемся к search service
 	searchConn, err := grpc.Dial(
 		fmt.Sprintf("%s:%d", cfg.SearchService.Host, cfg.SearchService.Port),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		transport,
+		tracing.DialOption(),
+		logging.DialOption(),
+		metrics.DialOption(),
+		searchBreaker.DialOption(),
 	)
 	if err != nil {
 		panic(fmt.Sprintf("Failed to connect to search service: %v", err))
@@ -42,27 +82,63 @@ func NewHandler(cfg *config.Config) *Handler {
 	// Подключаемся к notification service
 	notificationConn, err := grpc.Dial(
 		fmt.Sprintf("%s:%d", cfg.NotifyService.Host, cfg.NotifyService.Port),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		transport,
+		tracing.DialOption(),
+		logging.DialOption(),
+		metrics.DialOption(),
+		notificationBreaker.DialOption(),
 	)
 	if err != nil {
 		panic(fmt.Sprintf("Failed to connect to notification service: %v", err))
 	}
 
-	return &Handler{
-		saveClient:         pb.NewSaveServiceClient(saveConn),
-		searchClient:       pb.NewSearchServiceClient(searchConn),
+	saveClient := pb.NewSaveServiceClient(saveConn)
+	searchClient := pb.NewSearchServiceClient(searchConn)
+
+	h := &Handler{
+		saveClient:         saveClient,
+		searchClient:       searchClient,
 		notificationClient: pb.NewNotificationServiceClient(notificationConn),
-	}
+		restMux:            newRESTMux(saveClient, searchClient),
+		limiter:            limiter,
+		healthTargets: []healthTarget{
+			{name: "save_service", conn: saveConn},
+			{name: "search_service", conn: searchConn},
+			{name: "notification_service", conn: notificationConn},
+		},
+		breakers:       []*breaker.Breaker{saveBreaker, searchBreaker, notificationBreaker},
+		trustedProxies: cfg.HTTP.TrustedProxies,
+		anonymousReads: cfg.Auth.AnonymousReads,
+	}
+	h.SetRateLimits(cfg.RateLimit)
+
+	return h
 }
 
 func (h *Handler) SetupRouter() *gin.Engine {
-	router := gin.Default()
+	router := gin.New()
+	router.HandleMethodNotAllowed = true
+
+	// По умолчанию gin верит X-Forwarded-For от кого угодно; ClientIP нужен лимитам и логам
+	if err := router.SetTrustedProxies(h.trustedProxies); err != nil {
+		panic(fmt.Sprintf("Failed to set trusted proxies: %v", err))
+	}
+
+	// Идентификатор запроса нужен до всего остального: его видят трейс, логи и ответы с ошибкой
+	router.Use(requestID, gin.Recovery())
+
+	// Трейс запроса: спан gin и контекст для исходящих gRPC вызовов; пробы и документацию не трейсим
+	router.Use(otelgin.Middleware("api-gateway", otelgin.WithGinFilter(func(c *gin.Context) bool {
+		return !untracedRoutes[c.FullPath()]
+	})))
+	router.Use(observeRequest, accessLog)
 
 	// CORS middleware
 	router.Use(func(c *gin.Context) {
 		c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
-		c.Writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		c.Writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-API-Key, X-Request-ID")
+		c.Writer.Header().Set("Access-Control-Expose-Headers", "Link, Content-Disposition, X-Request-ID, X-RateLimit-Limit, X-RateLimit-Remaining, X-RateLimit-Reset, Retry-After")
 
 		if c.Request.Method == "OPTIONS" {
 			c.AbortWithStatus(204)
@@ -72,70 +148,125 @@ func (h *Handler) SetupRouter() *gin.Engine {
 		c.Next()
 	})
 
+	router.Use(h.authenticate)
+	if h.limiter != nil {
+		router.Use(h.rateLimit)
+	}
+
 	// API routes
 	api := router.Group("/api")
 	{
 		// User endpoints
 		api.POST("/user/create", h.createUser)
+		api.GET("/user/export", h.exportUserData)
+		api.GET("/user/:user_id", h.getUser)
+		api.PUT("/user/:user_id", h.updateUser)
+		api.DELETE("/user/:user_id", h.deleteUser)
 
 		// Search endpoints
 		api.GET("/search/news", h.searchNews)
 		api.GET("/search/headlines", h.getTopHeadlines)
 		api.GET("/search/history/:user_id", h.getSearchHistory)
+		api.POST("/search/history/:user_id/:search_id/rerun", h.rerunSearch)
+
+		// Seen endpoints
+		api.POST("/news/:id/seen", h.markSeen)
+		api.DELETE("/news/:id/seen", h.markUnseen)
+		api.GET("/news/seen/:user_id", h.getSeen)
 
 		// Favourite endpoints
 		api.POST("/favourite/set", h.addFavourite)
 		api.GET("/favourite/list/:user_id", h.getFavourites)
+		api.GET("/favourite/search/:user_id", h.searchFavourites)
+		api.GET("/favourite/annotations/:user_id/:news_id", h.getFavouriteAnnotation)
+		api.PUT("/favourite/annotations/:user_id/:news_id/tags", h.setFavouriteTags)
+		api.PUT("/favourite/annotations/:user_id/:news_id/note", h.setFavouriteNote)
+		api.POST("/favourite/annotations/:user_id/:news_id/highlights", h.addHighlight)
+		api.DELETE("/favourite/annotations/:user_id/:news_id/highlights/:highlight_id", h.deleteHighlight)
 
 		// Notification endpoints
 		api.POST("/notification/subscribe", h.subscribe)
-	}
-
-	// Health check
-	router.GET("/health", h.healthCheck)
+		api.GET("/notification/subscriptions/:user_id", h.getSubscriptions)
+
+		// Collection endpoints, id = 0 - избранное пользователя
+		api.POST("/collections", h.createCollection)
+		api.GET("/collections/:user_id", h.listCollections)
+		api.PUT("/collections/:user_id/:id", h.renameCollection)
+		api.DELETE("/collections/:user_id/:id", h.deleteCollection)
+		api.GET("/collections/:user_id/:id/items", h.getCollectionItems)
+		api.POST("/collections/:user_id/:id/items", h.addToCollection)
+		api.DELETE("/collections/:user_id/:id/items/:news_id", h.removeFromCollection)
+		api.POST("/collections/:user_id/:id/items/copy", h.copyCollectionItems)
+		api.PUT("/collections/:user_id/:id/order", h.reorderCollection)
+		api.POST("/collections/:user_id/:id/share", h.shareCollection)
+		api.GET("/shared/collections/:token", h.getSharedCollection)
+
+		// Saved search endpoints
+		api.POST("/saved-searches", h.createSavedSearch)
+		api.GET("/saved-searches/:user_id", h.listSavedSearches)
+		api.GET("/saved-searches/:user_id/:id", h.getSavedSearch)
+		api.PUT("/saved-searches/:user_id/:id", h.updateSavedSearch)
+		api.DELETE("/saved-searches/:user_id/:id", h.deleteSavedSearch)
+		api.POST("/saved-searches/:user_id/:id/run", h.runSavedSearch)
+		api.GET("/saved-searches/:user_id/:id/new", h.getSavedSearchNewResults)
+
+		// API key endpoints, только с ключом admin
+		api.POST("/admin/api-keys", h.createAPIKey)
+		api.GET("/admin/api-keys", h.listAPIKeys)
+		api.POST("/admin/api-keys/:id/rotate", h.rotateAPIKey)
+		api.DELETE("/admin/api-keys/:id", h.revokeAPIKey)
+
+		// Подробная готовность с ошибками и задержками зависимостей, тоже только admin
+		api.GET("/admin/health", h.adminHealth)
+	}
+
+	// Health checks: /livez - процесс жив, /readyz - сервисы и их зависимости готовы
+	router.GET("/livez", h.livez)
+	router.GET("/readyz", h.readyz)
+	router.GET("/health", h.readyz)
+
+	// Метрики Prometheus
+	router.GET("/metrics", h.metrics)
+
+	// REST по google.api.http аннотациям (grpc-gateway); middleware gin действует и здесь
+	router.Any(restPrefix+"/*path", h.forgetDeletedRESTUser, gin.WrapH(h.restMux))
+
+	// Документация: OpenAPI из api_gateway/openapi и Swagger UI
+	router.GET("/openapi.json", h.openAPISpec)
+	router.GET("/docs", h.swaggerUI)
+
+	router.NoRoute(func(c *gin.Context) {
+		writeProblem(c, http.StatusNotFound, "not_found", "route not found")
+	})
+	router.NoMethod(func(c *gin.Context) {
+		writeProblem(c, http.StatusMethodNotAllowed, "method_not_allowed", "method not allowed for this route")
+	})
 
 	return router
 }
 
 // Handlers implementation
-func (h *Handler) createUser(c *gin.Context) {
-	var req struct {
-		Name string `json:"name" binding:"required"`
-	}
-
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
-
-	resp, err := h.saveClient.CreateUser(c.Request.Context(), &pb.CreateUserRequest{
-		Name: req.Name,
-	})
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-
-	c.JSON(http.StatusOK, gin.H{"user_id": resp.UserId})
-}
-
 func (h *Handler) searchNews(c *gin.Context) {
 	userID, err := strconv.ParseUint(c.Query("user_id"), 10, 64)
 	if err != nil || userID == 0 {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "valid user_id is required"})
+		badRequest(c, "valid user_id is required")
 		return
 	}
 
 	query := c.Query("q")
 	if query == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "query parameter 'q' is required"})
+		badRequest(c, "query parameter 'q' is required")
 		return
 	}
 
+	// ?unseen_only=true - без новостей, которые пользователь уже просмотрел
+	unseenOnly, _ := strconv.ParseBool(c.Query("unseen_only"))
+
 	// Собираем параметры запроса
 	req := &pb.SearchNewsRequest{
-		UserId: userID,
-		Query:  query,
+		UserId:     userID,
+		Query:      query,
+		UnseenOnly: unseenOnly,
 	}
 
 	// Опциональные строковые параметры
@@ -175,7 +306,7 @@ func (h *Handler) searchNews(c *gin.Context) {
 
 	resp, err := h.searchClient.SearchNews(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		grpcError(c, err)
 		return
 	}
 
@@ -188,12 +319,15 @@ func (h *Handler) searchNews(c *gin.Context) {
 func (h *Handler) getTopHeadlines(c *gin.Context) {
 	userID, err := strconv.ParseUint(c.Query("user_id"), 10, 64)
 	if err != nil || userID == 0 {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "valid user_id is required"})
+		badRequest(c, "valid user_id is required")
 		return
 	}
 
+	unseenOnly, _ := strconv.ParseBool(c.Query("unseen_only"))
+
 	req := &pb.GetTopHeadlinesRequest{
-		UserId: userID,
+		UserId:     userID,
+		UnseenOnly: unseenOnly,
 	}
 
 	// Опциональные строковые параметры
@@ -227,7 +361,7 @@ func (h *Handler) getTopHeadlines(c *gin.Context) {
 
 	resp, err := h.searchClient.GetTopHeadlines(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		grpcError(c, err)
 		return
 	}
 
@@ -240,19 +374,83 @@ func (h *Handler) getTopHeadlines(c *gin.Context) {
 func (h *Handler) getSearchHistory(c *gin.Context) {
 	userID, err := strconv.ParseUint(c.Param("user_id"), 10, 64)
 	if err != nil || userID == 0 {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "valid user_id is required"})
+		badRequest(c, "valid user_id is required")
 		return
 	}
 
+	cursor, limit, err := pageParams(c)
+	if err != nil {
+		badRequest(c, err.Error())
+		return
+	}
+
+	// ?unique=true - недавние уникальные запросы без повторов
+	unique, _ := strconv.ParseBool(c.Query("unique"))
+
 	resp, err := h.saveClient.GetSearchHistory(c.Request.Context(), &pb.GetSearchHistoryRequest{
-		UserId: userID,
+		UserId:        userID,
+		PageToken:     cursor,
+		PageSize:      limit,
+		UniqueQueries: unique,
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		grpcError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"history": resp.Queries})
+	setNextLink(c, resp.NextPageToken)
+	c.JSON(http.StatusOK, gin.H{"history": resp.Entries, "next_cursor": resp.NextPageToken})
+}
+
+// rerunSearch - повторяет поиск из истории с исходными фильтрами
+func (h *Handler) rerunSearch(c *gin.Context) {
+	userID, err := strconv.ParseUint(c.Param("user_id"), 10, 64)
+	if err != nil || userID == 0 {
+		badRequest(c, "valid user_id is required")
+		return
+	}
+
+	searchID, err := strconv.ParseUint(c.Param("search_id"), 10, 64)
+	if err != nil || searchID == 0 {
+		badRequest(c, "valid search_id is required")
+		return
+	}
+
+	entryResp, err := h.saveClient.GetSearchHistoryEntry(c.Request.Context(), &pb.GetSearchHistoryEntryRequest{
+		UserId:   userID,
+		SearchId: searchID,
+	})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	entry := entryResp.Entry
+	req := &pb.SearchNewsRequest{
+		UserId: userID,
+		Query:  entry.Query,
+	}
+	if f := entry.Filters; f != nil {
+		req.Sources = f.Sources
+		req.Domains = f.Domains
+		req.From = f.From
+		req.To = f.To
+		req.Language = f.Language
+		req.SortBy = f.SortBy
+		req.PageSize = f.PageSize
+		req.Page = f.Page
+	}
+
+	resp, err := h.searchClient.SearchNews(c.Request.Context(), req)
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"news":          resp.News,
+		"total_results": resp.TotalResults,
+	})
 }
 
 func (h *Handler) addFavourite(c *gin.Context) {
@@ -262,7 +460,7 @@ func (h *Handler) addFavourite(c *gin.Context) {
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		badRequest(c, err.Error())
 		return
 	}
 
@@ -271,7 +469,7 @@ func (h *Handler) addFavourite(c *gin.Context) {
 		NewsId: req.NewsID,
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		grpcError(c, err)
 		return
 	}
 
@@ -281,87 +479,81 @@ func (h *Handler) addFavourite(c *gin.Context) {
 func (h *Handler) getFavourites(c *gin.Context) {
 	userID, err := strconv.ParseUint(c.Param("user_id"), 10, 64)
 	if err != nil || userID == 0 {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "valid user_id is required"})
+		badRequest(c, "valid user_id is required")
 		return
 	}
 
+	cursor, limit, err := pageParams(c)
+	if err != nil {
+		badRequest(c, err.Error())
+		return
+	}
+
+	unseenOnly, _ := strconv.ParseBool(c.Query("unseen_only"))
+
 	resp, err := h.saveClient.GetFavourites(c.Request.Context(), &pb.GetFavouritesRequest{
-		UserId: userID,
+		UserId:     userID,
+		PageToken:  cursor,
+		PageSize:   limit,
+		UnseenOnly: unseenOnly,
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		grpcError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"favourites": resp.News})
+	setNextLink(c, resp.NextPageToken)
+	c.JSON(http.StatusOK, gin.H{"favourites": resp.News, "next_cursor": resp.NextPageToken})
 }
 
 func (h *Handler) subscribe(c *gin.Context) {
 	var req struct {
-		UserID  uint64 `json:"user_id" binding:"required"`
-		Keyword string `json:"keyword" binding:"required"`
+		UserID   uint64 `json:"user_id" binding:"required"`
+		Keyword  string `json:"keyword" binding:"required"`
+		Schedule string `json:"schedule"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		badRequest(c, err.Error())
 		return
 	}
 
 	resp, err := h.saveClient.Subscribe(c.Request.Context(), &pb.SubscribeRequest{
-		UserId:  req.UserID,
-		Keyword: req.Keyword,
+		UserId:   req.UserID,
+		Keyword:  req.Keyword,
+		Schedule: req.Schedule,
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		grpcError(c, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{"success": resp.Success})
 }
 
-func (h *Handler) healthCheck(c *gin.Context) {
-	// Проверяем соединения со всеми сервисами
-	ctx, cancel := context.WithTimeout(c.Request.Context(), 2*time.Second)
-	defer cancel()
-
-	health := map[string]string{
-		"api_gateway": "ok",
-	}
-
-	// Проверка save service
-	_, err := h.saveClient.CreateUser(ctx, &pb.CreateUserRequest{Name: "health_check"})
-	if err != nil {
-		// Если ошибка "user already exists" - это нормально
-		if err.Error() != "user already exists" {
-			health["save_service"] = "error: " + err.Error()
-		} else {
-			health["save_service"] = "ok"
-		}
-	} else {
-		health["save_service"] = "ok"
+func (h *Handler) getSubscriptions(c *gin.Context) {
+	userID, err := strconv.ParseUint(c.Param("user_id"), 10, 64)
+	if err != nil || userID == 0 {
+		badRequest(c, "valid user_id is required")
+		return
 	}
 
-	// Проверка search service
-	_, err = h.searchClient.SearchNews(ctx, &pb.SearchNewsRequest{
-		UserId: 1,
-		Query:  "test",
-	})
+	cursor, limit, err := pageParams(c)
 	if err != nil {
-		health["search_service"] = "error: " + err.Error()
-	} else {
-		health["search_service"] = "ok"
+		badRequest(c, err.Error())
+		return
 	}
 
-	// Проверка notification service
-	_, err = h.notificationClient.SendNotification(ctx, &pb.SendNotificationRequest{
-		UserId:  1,
-		Message: "health check",
+	resp, err := h.saveClient.GetSubscriptions(c.Request.Context(), &pb.GetSubscriptionsRequest{
+		UserId:    userID,
+		PageToken: cursor,
+		PageSize:  limit,
 	})
 	if err != nil {
-		health["notification_service"] = "error: " + err.Error()
-	} else {
-		health["notification_service"] = "ok"
+		grpcError(c, err)
+		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"status": "ok", "services": health})
+	setNextLink(c, resp.NextPageToken)
+	c.JSON(http.StatusOK, gin.H{"subscriptions": resp.Subscriptions, "next_cursor": resp.NextPageToken})
 }
@@ -0,0 +1,91 @@
+package api
+
+import (
+	"context"
+	"gonews/pkg/health"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+)
+
+// healthTimeout - сколько ждём ответа health-сервиса каждого backend-а
+const healthTimeout = 2 * time.Second
+
+type healthTarget struct {
+	name string
+	conn grpc.ClientConnInterface
+}
+
+// livez - процесс gateway жив; зависимости не проверяются
+func (h *Handler) livez(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+// readyz - готовность всех сервисов по grpc.health.v1. Маршрут открыт без ключа, поэтому в ответе
+// только названия статусов; тексты ошибок и задержки - в /api/admin/health.
+// Ничего не создаёт и не расходует квоту внешних API.
+func (h *Handler) readyz(c *gin.Context) {
+	reports, ready := h.probeServices(c.Request.Context())
+
+	services := make(map[string]health.StatusSummary, len(reports))
+	for name, report := range reports {
+		services[name] = report.Summary()
+	}
+
+	if !ready {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"status": "not_ready", "services": services, "breakers": h.breakerStates()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "ready", "services": services, "breakers": h.breakerStates()})
+}
+
+// adminHealth - подробный отчёт о готовности: задержки и ошибки последних проверок зависимостей
+func (h *Handler) adminHealth(c *gin.Context) {
+	reports, ready := h.probeServices(c.Request.Context())
+
+	status := "ready"
+	if !ready {
+		status = "not_ready"
+	}
+	c.JSON(http.StatusOK, gin.H{"status": status, "services": reports, "breakers": h.breakerStates()})
+}
+
+// probeServices - опрашивает health-сервисы всех backend-ов параллельно
+func (h *Handler) probeServices(ctx context.Context) (map[string]health.ServiceReport, bool) {
+	ctx, cancel := context.WithTimeout(ctx, healthTimeout)
+	defer cancel()
+
+	reports := make(map[string]health.ServiceReport, len(h.healthTargets))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, target := range h.healthTargets {
+		wg.Add(1)
+		go func(target healthTarget) {
+			defer wg.Done()
+			report := health.Probe(ctx, target.conn)
+
+			mu.Lock()
+			reports[target.name] = report
+			mu.Unlock()
+		}(target)
+	}
+	wg.Wait()
+
+	ready := true
+	for _, report := range reports {
+		ready = ready && report.Ready()
+	}
+	return reports, ready
+}
+
+// breakerStates - состояние breaker-ов самого gateway; разомкнутый breaker на готовность не влияет
+func (h *Handler) breakerStates() map[string]string {
+	breakers := make(map[string]string, len(h.breakers))
+	for _, b := range h.breakers {
+		breakers[b.Name()] = b.State()
+	}
+	return breakers
+}
@@ -0,0 +1,49 @@
+package api
+
+import (
+	"gonews/pkg/logging"
+	"log/slog"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// requestID - X-Request-ID клиента или новый; попадает в ответ, логи и metadata исходящих gRPC вызовов
+func requestID(c *gin.Context) {
+	id := c.GetHeader(logging.Header)
+	if !logging.ValidRequestID(id) {
+		id = logging.NewRequestID()
+	}
+
+	c.Header(logging.Header, id)
+	c.Request = c.Request.WithContext(logging.WithRequestID(c.Request.Context(), id))
+	c.Next()
+}
+
+// accessLog - запись о каждом запросе вместо текстового логгера gin; пробы и /metrics только в debug
+func accessLog(c *gin.Context) {
+	start := time.Now()
+	c.Next()
+
+	status := c.Writer.Status()
+	level := slog.LevelInfo
+	switch {
+	case status >= 500:
+		level = slog.LevelError
+	case untracedRoutes[c.FullPath()]:
+		level = slog.LevelDebug
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", c.Request.Method),
+		slog.String("path", c.Request.URL.Path),
+		slog.String("route", c.FullPath()),
+		slog.Int("status", status),
+		slog.Int64("duration_ms", time.Since(start).Milliseconds()),
+		slog.String("client_ip", c.ClientIP()),
+	}
+	if len(c.Errors) > 0 {
+		attrs = append(attrs, slog.String("error", c.Errors.String()))
+	}
+	slog.LogAttrs(c.Request.Context(), level, "http request", attrs...)
+}
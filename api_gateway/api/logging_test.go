@@ -0,0 +1,52 @@
+package api
+
+import (
+	"gonews/pkg/logging"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"gotest.tools/v3/assert"
+)
+
+func TestRequestIDReachesResponseAndContext(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(requestID)
+	var inContext string
+	router.GET("/api/search/news", func(c *gin.Context) {
+		inContext = logging.RequestID(c.Request.Context())
+		c.Status(http.StatusNoContent)
+	})
+
+	tests := []struct {
+		name     string
+		incoming string
+		want     string
+	}{
+		{"client id is kept", "client-123", "client-123"},
+		{"missing id is generated", "", ""},
+		{"invalid id is replaced", "bad id", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/search/news", nil)
+			if tt.incoming != "" {
+				req.Header.Set(logging.Header, tt.incoming)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			got := w.Header().Get(logging.Header)
+			assert.Equal(t, inContext, got)
+			if tt.want != "" {
+				assert.Equal(t, tt.want, got)
+			} else {
+				assert.Equal(t, 32, len(got))
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package api
+
+import (
+	"gonews/pkg/metrics"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var httpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: metrics.Namespace,
+	Subsystem: "gateway",
+	Name:      "http_request_duration_seconds",
+	Help:      "Gateway HTTP request latency by method, route template and status code.",
+}, []string{"method", "route", "status"})
+
+// observeRequest - метрика запроса по шаблону маршрута, чтобы id в пути не плодили серии
+func observeRequest(c *gin.Context) {
+	start := time.Now()
+	c.Next()
+
+	route := c.FullPath()
+	if route == "" {
+		route = "unmatched"
+	}
+	httpRequestDuration.
+		WithLabelValues(c.Request.Method, route, strconv.Itoa(c.Writer.Status())).
+		Observe(time.Since(start).Seconds())
+}
+
+func (h *Handler) metrics(c *gin.Context) {
+	metrics.Handler().ServeHTTP(c.Writer, c.Request)
+}
@@ -0,0 +1,36 @@
+package api
+
+import (
+	"gonews/api_gateway/openapi"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// swaggerUIPage - Swagger UI из swagger-ui-dist, документ берётся с /openapi.json
+const swaggerUIPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>GoNews API</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js"></script>
+  <script>
+    window.ui = SwaggerUIBundle({url: "/openapi.json", dom_id: "#swagger-ui"});
+  </script>
+</body>
+</html>
+`
+
+// openAPISpec - OpenAPI документ REST API
+func (h *Handler) openAPISpec(c *gin.Context) {
+	c.Data(http.StatusOK, "application/json", openapi.Spec)
+}
+
+// swaggerUI - страница Swagger UI
+func (h *Handler) swaggerUI(c *gin.Context) {
+	c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(swaggerUIPage))
+}
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"encoding/json"
+	"gonews/api_gateway/config"
+	"gonews/api_gateway/openapi"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"gotest.tools/v3/assert"
+)
+
+var ginParam = regexp.MustCompile(`:(\w+)`)
+
+// TestOpenAPIMatchesRoutes - каждый маршрут gin описан в openapi.json и наоборот
+func TestOpenAPIMatchesRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var spec struct {
+		Paths map[string]map[string]json.RawMessage `json:"paths"`
+	}
+	assert.NilError(t, json.Unmarshal(openapi.Spec, &spec))
+
+	var documented []string
+	for path, operations := range spec.Paths {
+		for method := range operations {
+			documented = append(documented, strings.ToUpper(method)+" "+path)
+		}
+	}
+
+	router := NewHandler(&config.Config{}, nil).SetupRouter()
+
+	var registered []string
+	for _, route := range router.Routes() {
+		// маршруты grpc-gateway описаны аннотациями google.api.http в news_service.proto
+		if strings.HasPrefix(route.Path, restPrefix+"/") {
+			continue
+		}
+		registered = append(registered, route.Method+" "+ginParam.ReplaceAllString(route.Path, "{$1}"))
+	}
+
+	sort.Strings(documented)
+	sort.Strings(registered)
+	assert.DeepEqual(t, documented, registered)
+}
@@ -0,0 +1,39 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// pageParams - читаем ?cursor= и ?limit= (limit не обязателен, максимум ограничивает save service)
+func pageParams(c *gin.Context) (string, int32, error) {
+	cursor := c.Query("cursor")
+
+	var limit int32
+	if limitStr := c.Query("limit"); limitStr != "" {
+		l, err := strconv.ParseInt(limitStr, 10, 32)
+		if err != nil || l <= 0 {
+			return "", 0, errors.New("limit must be a positive integer")
+		}
+		limit = int32(l)
+	}
+
+	return cursor, limit, nil
+}
+
+// setNextLink - ссылка на следующую страницу в заголовке Link (RFC 5988)
+func setNextLink(c *gin.Context, nextCursor string) {
+	if nextCursor == "" {
+		return
+	}
+
+	next := *c.Request.URL
+	query := next.Query()
+	query.Set("cursor", nextCursor)
+	next.RawQuery = query.Encode()
+
+	c.Header("Link", fmt.Sprintf(`<%s>; rel="next"`, next.RequestURI()))
+}
@@ -0,0 +1,145 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"gonews/api_gateway/config"
+	"gonews/api_gateway/ratelimit"
+	"gonews/pkg/logging"
+	"gonews/protos/pb"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RateLimiter interface {
+	Allow(ctx context.Context, group string, limit ratelimit.Limit, subjects ...string) (ratelimit.Result, error)
+	Forget(ctx context.Context, subject string, groups ...string) error
+}
+
+// searchRoutes - маршруты, которые ходят в NewsAPI и расходуют его квоту
+var searchRoutes = map[string]bool{
+	"/api/search/news":                              true,
+	"/api/search/headlines":                         true,
+	"/api/search/history/:user_id/:search_id/rerun": true,
+	"/api/saved-searches/:user_id/:id/run":          true,
+}
+
+// SetRateLimits - лимиты групп маршрутов; применяются к следующим запросам без перезапуска
+func (h *Handler) SetRateLimits(cfg config.RateLimitConfig) {
+	limits := rateLimits(cfg)
+	h.rateLimits.Store(&limits)
+}
+
+func rateLimits(cfg config.RateLimitConfig) map[string]ratelimit.Limit {
+	limits := make(map[string]ratelimit.Limit, len(cfg.Groups))
+	for group, groupCfg := range cfg.Groups {
+		if groupCfg.RequestsPerMinute <= 0 || groupCfg.Burst <= 0 {
+			continue
+		}
+		limits[group] = ratelimit.Limit{
+			RequestsPerMinute: groupCfg.RequestsPerMinute,
+			Burst:             groupCfg.Burst,
+		}
+	}
+	return limits
+}
+
+// rateLimit - token bucket в Redis по группе маршрута для IP, API ключа и пользователя.
+// Идёт после authenticate, чтобы знать владельца ключа. Если Redis недоступен, запрос пропускаем:
+// лимиты не должны ронять API.
+func (h *Handler) rateLimit(c *gin.Context) {
+	group := routeGroup(c)
+	limit, ok := (*h.rateLimits.Load())[group]
+	if !ok {
+		c.Next()
+		return
+	}
+
+	result, err := h.limiter.Allow(c.Request.Context(), group, limit, rateLimitSubjects(c)...)
+	if err != nil {
+		slog.WarnContext(c.Request.Context(), "rate limit check failed, request allowed", logging.Err(err))
+		c.Next()
+		return
+	}
+
+	c.Header("X-RateLimit-Limit", strconv.Itoa(result.Limit))
+	c.Header("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+	c.Header("X-RateLimit-Reset", strconv.Itoa(ceilSeconds(result.ResetAfter)))
+
+	if !result.Allowed {
+		c.Header("Retry-After", strconv.Itoa(ceilSeconds(result.RetryAfter)))
+		writeProblem(c, http.StatusTooManyRequests, "resource_exhausted", "rate limit exceeded for "+group+" requests")
+		return
+	}
+
+	c.Next()
+}
+
+// routeGroup - группа лимитов маршрута; пусто для health, документации и неизвестных путей
+func routeGroup(c *gin.Context) string {
+	path := c.FullPath()
+	switch {
+	case !strings.HasPrefix(path, "/api/") && !strings.HasPrefix(path, restPrefix+"/"):
+		return ""
+	case searchRoutes[path] || strings.HasPrefix(c.Request.URL.Path, restPrefix+"/search/"):
+		return "search"
+	case c.Request.Method == http.MethodGet:
+		return "read"
+	default:
+		return "write"
+	}
+}
+
+// userSubject - субъект лимитов пользователя
+func userSubject(userID uint64) string {
+	return "user:" + strconv.FormatUint(userID, 10)
+}
+
+// forgetUser - удаляет бакеты удалённого пользователя. Ошибку только логируем: аккаунт уже удалён,
+// а бакеты и так истекают по TTL
+func (h *Handler) forgetUser(ctx context.Context, userID uint64) {
+	if h.limiter == nil {
+		return
+	}
+
+	limits := *h.rateLimits.Load()
+	groups := make([]string, 0, len(limits))
+	for group := range limits {
+		groups = append(groups, group)
+	}
+
+	if err := h.limiter.Forget(ctx, userSubject(userID), groups...); err != nil {
+		slog.WarnContext(ctx, "failed to forget rate limits of deleted user", "user_id", userID, logging.Err(err))
+	}
+}
+
+// rateLimitSubjects - IP (с учётом http.trusted_proxies) всегда; API ключ, если есть (в Redis только хэш);
+// пользователь - только владелец ключа, проверенного authenticate. user_id из пути или query не годится:
+// его задаёт клиент и мог бы исчерпать чужой лимит
+func rateLimitSubjects(c *gin.Context) []string {
+	subjects := []string{"ip:" + c.ClientIP()}
+
+	if apiKey := c.GetHeader("X-API-Key"); apiKey != "" {
+		sum := sha256.Sum256([]byte(apiKey))
+		subjects = append(subjects, "key:"+hex.EncodeToString(sum[:8]))
+	}
+
+	if value, ok := c.Get(apiKeyContextKey); ok {
+		if key, ok := value.(*pb.APIKey); ok && key.UserId != 0 {
+			subjects = append(subjects, userSubject(key.UserId))
+		}
+	}
+
+	return subjects
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,81 @@
+package api
+
+import (
+	"gonews/api_gateway/config"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"gotest.tools/v3/assert"
+)
+
+// TestRateLimitSubjectsTrustedProxies - X-Forwarded-For учитывается только от http.trusted_proxies
+func TestRateLimitSubjectsTrustedProxies(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	cases := []struct {
+		name    string
+		proxies []string
+		want    string
+	}{
+		{"no trusted proxies", nil, "ip:203.0.113.7"},
+		{"request from trusted proxy", []string{"203.0.113.0/24"}, "ip:198.51.100.1"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := &config.Config{HTTP: config.HTTPConfig{TrustedProxies: tc.proxies}}
+			router := NewHandler(cfg, nil).SetupRouter()
+			router.GET("/subjects", func(c *gin.Context) {
+				c.JSON(http.StatusOK, rateLimitSubjects(c))
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/subjects", nil)
+			req.RemoteAddr = "203.0.113.7:41000"
+			req.Header.Set("X-Forwarded-For", "198.51.100.1")
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, `["`+tc.want+`"]`, rec.Body.String())
+		})
+	}
+}
+
+// TestRateLimitSubjectsUser - лимит пользователя считается только по владельцу проверенного ключа,
+// user_id из пути чужой бакет не трогает
+func TestRateLimitSubjectsUser(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	h := &Handler{saveClient: authSaveClient{}, anonymousReads: true}
+	router := gin.New()
+	router.Use(h.authenticate)
+	router.GET("/api/favourite/list/:user_id", func(c *gin.Context) {
+		c.JSON(http.StatusOK, rateLimitSubjects(c))
+	})
+
+	cases := []struct {
+		name string
+		key  string
+		want string
+	}{
+		{"anonymous", "", `["ip:192.0.2.1"]`},
+		{"service key", "gnk_search", `["ip:192.0.2.1","key:c6a9d87a122c1d6a"]`},
+		{"user key", "gnk_user", `["ip:192.0.2.1","key:a67a67a3f05c1991","user:7"]`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/favourite/list/9", nil)
+			if tc.key != "" {
+				req.Header.Set("X-API-Key", tc.key)
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, tc.want, rec.Body.String())
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// restPrefix - маршруты, которые grpc-gateway строит по google.api.http в news_service.proto
+const restPrefix = "/v1"
+
+// newRESTMux - grpc-gateway поверх уже открытых клиентов; JSON с именами полей как в proto
+func newRESTMux(saveClient pb.SaveServiceClient, searchClient pb.SearchServiceClient) *runtime.ServeMux {
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions:   protojson.MarshalOptions{UseProtoNames: true},
+			UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+		}),
+		runtime.WithErrorHandler(restErrorHandler),
+		runtime.WithRoutingErrorHandler(restRoutingErrorHandler),
+	)
+
+	ctx := context.Background()
+	// RegisterXHandlerClient возвращает ошибку только при nil клиенте
+	_ = pb.RegisterSaveServiceHandlerClient(ctx, mux, saveClient)
+	_ = pb.RegisterSearchServiceHandlerClient(ctx, mux, searchClient)
+
+	return mux
+}
+
+// restErrorHandler - ошибки gRPC из grpc-gateway в том же формате RFC 7807, что и у gin-обработчиков
+func restErrorHandler(_ context.Context, _ *runtime.ServeMux, _ runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	httpStatus, code, detail := translateGRPCError(r, err)
+	writeProblemHTTP(w, r, httpStatus, code, detail)
+}
+
+// restRoutingErrorHandler - неизвестный путь или метод внутри /v1
+func restRoutingErrorHandler(_ context.Context, _ *runtime.ServeMux, _ runtime.Marshaler, w http.ResponseWriter, r *http.Request, httpStatus int) {
+	switch httpStatus {
+	case http.StatusMethodNotAllowed:
+		writeProblemHTTP(w, r, httpStatus, "method_not_allowed", "method not allowed for this route")
+	case http.StatusNotFound:
+		writeProblemHTTP(w, r, httpStatus, "not_found", "route not found")
+	default:
+		writeProblemHTTP(w, r, httpStatus, "invalid_argument", http.StatusText(httpStatus))
+	}
+}
+
+// writeProblemHTTP - writeProblem для обработчиков без gin.Context
+func writeProblemHTTP(w http.ResponseWriter, r *http.Request, httpStatus int, code, detail string) {
+	render := problemRender{newProblem(r, httpStatus, code, detail)}
+	render.WriteContentType(w)
+	w.WriteHeader(httpStatus)
+	_ = render.Render(w)
+}
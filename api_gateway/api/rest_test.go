@@ -0,0 +1,54 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"gonews/protos/pb"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gotest.tools/v3/assert"
+)
+
+type stubSaveClient struct {
+	pb.SaveServiceClient
+}
+
+func (stubSaveClient) GetUser(_ context.Context, req *pb.GetUserRequest, _ ...grpc.CallOption) (*pb.UserResponse, error) {
+	if req.UserId != 7 {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	return &pb.UserResponse{User: &pb.User{Id: 7, Handle: "analyst", DisplayName: "Analyst"}}, nil
+}
+
+func TestRESTMuxTranscodesRequests(t *testing.T) {
+	mux := newRESTMux(stubSaveClient{}, nil)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/users/7", nil))
+
+	assert.Equal(t, rec.Code, http.StatusOK)
+	var body struct {
+		User struct {
+			ID          string `json:"id"`
+			DisplayName string `json:"display_name"`
+		} `json:"user"`
+	}
+	assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, body.User.ID, "7")
+	assert.Equal(t, body.User.DisplayName, "Analyst")
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/users/8", nil))
+
+	assert.Equal(t, rec.Code, http.StatusNotFound)
+	assert.Equal(t, rec.Header().Get("Content-Type"), problemContentType)
+	var problemBody problem
+	assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &problemBody))
+	assert.Equal(t, problemBody.Code, "not_found")
+	assert.Equal(t, problemBody.Detail, "user not found")
+}
@@ -0,0 +1,281 @@
+package api
+
+import (
+	"gonews/protos/pb"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// searchFiltersJSON - фильтры сохранённого поиска в теле запроса, те же, что у /api/search/news
+type searchFiltersJSON struct {
+	Sources  *string `json:"sources"`
+	Domains  *string `json:"domains"`
+	From     *string `json:"from"`
+	To       *string `json:"to"`
+	Language *string `json:"language"`
+	SortBy   *string `json:"sort_by"`
+	PageSize *int32  `json:"page_size"`
+	Page     *int32  `json:"page"`
+}
+
+func (f *searchFiltersJSON) toProto() *pb.SearchFilters {
+	if f == nil {
+		return nil
+	}
+
+	return &pb.SearchFilters{
+		Sources:  f.Sources,
+		Domains:  f.Domains,
+		From:     f.From,
+		To:       f.To,
+		Language: f.Language,
+		SortBy:   f.SortBy,
+		PageSize: f.PageSize,
+		Page:     f.Page,
+	}
+}
+
+// savedSearchParams - user_id и id сохранённого поиска из пути
+func savedSearchParams(c *gin.Context) (uint64, uint64, bool) {
+	userID, err := strconv.ParseUint(c.Param("user_id"), 10, 64)
+	if err != nil || userID == 0 {
+		badRequest(c, "valid user_id is required")
+		return 0, 0, false
+	}
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || id == 0 {
+		badRequest(c, "valid id is required")
+		return 0, 0, false
+	}
+
+	return userID, id, true
+}
+
+func (h *Handler) createSavedSearch(c *gin.Context) {
+	var req struct {
+		UserID                 uint64             `json:"user_id" binding:"required"`
+		Name                   string             `json:"name" binding:"required"`
+		Query                  string             `json:"query" binding:"required"`
+		Filters                *searchFiltersJSON `json:"filters"`
+		RefreshIntervalMinutes int32              `json:"refresh_interval_minutes"`
+		Notify                 bool               `json:"notify"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		badRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.saveClient.CreateSavedSearch(c.Request.Context(), &pb.CreateSavedSearchRequest{
+		UserId:                 req.UserID,
+		Name:                   req.Name,
+		Query:                  req.Query,
+		Filters:                req.Filters.toProto(),
+		RefreshIntervalMinutes: req.RefreshIntervalMinutes,
+		Notify:                 req.Notify,
+	})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"saved_search": resp.SavedSearch})
+}
+
+func (h *Handler) listSavedSearches(c *gin.Context) {
+	userID, err := strconv.ParseUint(c.Param("user_id"), 10, 64)
+	if err != nil || userID == 0 {
+		badRequest(c, "valid user_id is required")
+		return
+	}
+
+	cursor, limit, err := pageParams(c)
+	if err != nil {
+		badRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.saveClient.ListSavedSearches(c.Request.Context(), &pb.ListSavedSearchesRequest{
+		UserId:    userID,
+		PageToken: cursor,
+		PageSize:  limit,
+	})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	setNextLink(c, resp.NextPageToken)
+	c.JSON(http.StatusOK, gin.H{"saved_searches": resp.SavedSearches, "next_cursor": resp.NextPageToken})
+}
+
+func (h *Handler) getSavedSearch(c *gin.Context) {
+	userID, id, ok := savedSearchParams(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.saveClient.GetSavedSearch(c.Request.Context(), &pb.GetSavedSearchRequest{
+		UserId: userID,
+		Id:     id,
+	})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"saved_search": resp.SavedSearch})
+}
+
+func (h *Handler) updateSavedSearch(c *gin.Context) {
+	userID, id, ok := savedSearchParams(c)
+	if !ok {
+		return
+	}
+
+	// Отсутствующие поля не меняются, filters заменяется целиком
+	var req struct {
+		Name                   *string            `json:"name"`
+		Query                  *string            `json:"query"`
+		Filters                *searchFiltersJSON `json:"filters"`
+		RefreshIntervalMinutes *int32             `json:"refresh_interval_minutes"`
+		Notify                 *bool              `json:"notify"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		badRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.saveClient.UpdateSavedSearch(c.Request.Context(), &pb.UpdateSavedSearchRequest{
+		UserId:                 userID,
+		Id:                     id,
+		Name:                   req.Name,
+		Query:                  req.Query,
+		Filters:                req.Filters.toProto(),
+		RefreshIntervalMinutes: req.RefreshIntervalMinutes,
+		Notify:                 req.Notify,
+	})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"saved_search": resp.SavedSearch})
+}
+
+func (h *Handler) deleteSavedSearch(c *gin.Context) {
+	userID, id, ok := savedSearchParams(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.saveClient.DeleteSavedSearch(c.Request.Context(), &pb.DeleteSavedSearchRequest{
+		UserId: userID,
+		Id:     id,
+	})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": resp.Success})
+}
+
+// runSavedSearch - выполняет сохранённый поиск вручную и отмечает новые результаты
+func (h *Handler) runSavedSearch(c *gin.Context) {
+	userID, id, ok := savedSearchParams(c)
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	savedResp, err := h.saveClient.GetSavedSearch(ctx, &pb.GetSavedSearchRequest{
+		UserId: userID,
+		Id:     id,
+	})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	savedSearch := savedResp.SavedSearch
+	req := &pb.SearchNewsRequest{
+		UserId:      userID,
+		Query:       savedSearch.Query,
+		SkipHistory: true,
+	}
+	if f := savedSearch.Filters; f != nil {
+		req.Sources = f.Sources
+		req.Domains = f.Domains
+		req.From = f.From
+		req.To = f.To
+		req.Language = f.Language
+		req.SortBy = f.SortBy
+		req.PageSize = f.PageSize
+		req.Page = f.Page
+	}
+
+	resp, err := h.searchClient.SearchNews(ctx, req)
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	resultIDs := make([]uint64, 0, len(resp.News))
+	for _, n := range resp.News {
+		if n.Id != 0 {
+			resultIDs = append(resultIDs, n.Id)
+		}
+	}
+
+	runResp, err := h.saveClient.RecordSavedSearchRun(ctx, &pb.RecordSavedSearchRunRequest{
+		Id:        id,
+		ResultIds: resultIDs,
+	})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"news":           resp.News,
+		"total_results":  resp.TotalResults,
+		"new_result_ids": runResp.SavedSearch.NewResultIds,
+	})
+}
+
+// getSavedSearchNewResults - новости, появившиеся при последнем запуске сохранённого поиска
+func (h *Handler) getSavedSearchNewResults(c *gin.Context) {
+	userID, id, ok := savedSearchParams(c)
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	savedResp, err := h.saveClient.GetSavedSearch(ctx, &pb.GetSavedSearchRequest{
+		UserId: userID,
+		Id:     id,
+	})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	newIDs := savedResp.SavedSearch.NewResultIds
+	if len(newIDs) == 0 {
+		c.JSON(http.StatusOK, gin.H{"news": []*pb.News{}, "last_run_at": savedResp.SavedSearch.LastRunAt})
+		return
+	}
+
+	resp, err := h.saveClient.GetNewsByIDs(ctx, &pb.GetNewsByIDsRequest{Ids: newIDs})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"news": resp.News, "last_run_at": savedResp.SavedSearch.LastRunAt})
+}
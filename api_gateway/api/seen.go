@@ -0,0 +1,105 @@
+package api
+
+import (
+	"gonews/protos/pb"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// markSeen - отмечает новость как просмотренную пользователем
+func (h *Handler) markSeen(c *gin.Context) {
+	newsID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || newsID == 0 {
+		badRequest(c, "valid news id is required")
+		return
+	}
+
+	var req struct {
+		UserID uint64 `json:"user_id" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		badRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.saveClient.MarkSeen(c.Request.Context(), &pb.MarkSeenRequest{
+		UserId:  req.UserID,
+		NewsIds: []uint64{newsID},
+	})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": resp.Success})
+}
+
+// markUnseen - снимает отметку о просмотре
+func (h *Handler) markUnseen(c *gin.Context) {
+	newsID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || newsID == 0 {
+		badRequest(c, "valid news id is required")
+		return
+	}
+
+	userID, err := strconv.ParseUint(c.Query("user_id"), 10, 64)
+	if err != nil || userID == 0 {
+		badRequest(c, "valid user_id is required")
+		return
+	}
+
+	resp, err := h.saveClient.MarkUnseen(c.Request.Context(), &pb.MarkUnseenRequest{
+		UserId:  userID,
+		NewsIds: []uint64{newsID},
+	})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": resp.Success})
+}
+
+// getSeen - просмотренные новости пользователя; ?ids=1,2,3 - проверить только эти новости
+func (h *Handler) getSeen(c *gin.Context) {
+	userID, err := strconv.ParseUint(c.Param("user_id"), 10, 64)
+	if err != nil || userID == 0 {
+		badRequest(c, "valid user_id is required")
+		return
+	}
+
+	cursor, limit, err := pageParams(c)
+	if err != nil {
+		badRequest(c, err.Error())
+		return
+	}
+
+	req := &pb.GetSeenRequest{
+		UserId:    userID,
+		PageToken: cursor,
+		PageSize:  limit,
+	}
+	if ids := c.Query("ids"); ids != "" {
+		for _, idStr := range strings.Split(ids, ",") {
+			id, err := strconv.ParseUint(strings.TrimSpace(idStr), 10, 64)
+			if err != nil || id == 0 {
+				badRequest(c, "ids must be a comma-separated list of news ids")
+				return
+			}
+			req.NewsIds = append(req.NewsIds, id)
+		}
+	}
+
+	resp, err := h.saveClient.GetSeen(c.Request.Context(), req)
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	setNextLink(c, resp.NextPageToken)
+	c.JSON(http.StatusOK, gin.H{"seen": resp.Seen, "next_cursor": resp.NextPageToken})
+}
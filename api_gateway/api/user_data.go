@@ -0,0 +1,64 @@
+package api
+
+import (
+	"fmt"
+	"gonews/protos/pb"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// exportUserData - отдаёт все данные пользователя файлом; ?format=json (по умолчанию) или zip
+func (h *Handler) exportUserData(c *gin.Context) {
+	userID, err := strconv.ParseUint(c.Query("user_id"), 10, 64)
+	if err != nil || userID == 0 {
+		badRequest(c, "valid user_id is required")
+		return
+	}
+
+	resp, err := h.saveClient.ExportUserData(c.Request.Context(), &pb.ExportUserDataRequest{
+		UserId: userID,
+		Format: c.Query("format"),
+	})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", resp.Filename))
+	c.Data(http.StatusOK, resp.ContentType, resp.Data)
+}
+
+// deleteUser - удаляет пользователя вместе со всеми его данными, включая бакеты лимитов в Redis
+func (h *Handler) deleteUser(c *gin.Context) {
+	userID, err := strconv.ParseUint(c.Param("user_id"), 10, 64)
+	if err != nil || userID == 0 {
+		badRequest(c, "valid user_id is required")
+		return
+	}
+
+	resp, err := h.saveClient.DeleteUser(c.Request.Context(), &pb.DeleteUserRequest{UserId: userID})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+	h.forgetUser(c.Request.Context(), userID)
+
+	c.JSON(http.StatusOK, gin.H{"success": resp.Success})
+}
+
+// forgetDeletedRESTUser - то же для DELETE /v1/users/{user_id}: grpc-gateway вызывает save service сам,
+// поэтому бакеты удаляем после успешного ответа
+func (h *Handler) forgetDeletedRESTUser(c *gin.Context) {
+	c.Next()
+
+	path := c.Request.URL.Path
+	if c.Request.Method != http.MethodDelete || c.Writer.Status() != http.StatusOK || !matchTemplate(restPrefix+"/users/{user_id}", path) {
+		return
+	}
+	if userID, err := strconv.ParseUint(strings.TrimPrefix(path, restPrefix+"/users/"), 10, 64); err == nil {
+		h.forgetUser(c.Request.Context(), userID)
+	}
+}
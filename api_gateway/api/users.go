@@ -0,0 +1,110 @@
+package api
+
+import (
+	"gonews/protos/pb"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// createUser - создаёт пользователя; без handle он строится из отображаемого имени
+func (h *Handler) createUser(c *gin.Context) {
+	var req struct {
+		DisplayName string `json:"display_name"`
+		// Name - прежнее название поля, принимается, пока клиенты не перейдут на display_name
+		Name           string             `json:"name"`
+		Handle         string             `json:"handle"`
+		Email          string             `json:"email"`
+		Language       string             `json:"language"`
+		Country        string             `json:"country"`
+		Timezone       string             `json:"timezone"`
+		DefaultFilters *searchFiltersJSON `json:"default_filters"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		badRequest(c, err.Error())
+		return
+	}
+	if req.DisplayName == "" {
+		req.DisplayName = req.Name
+	}
+	if req.DisplayName == "" && req.Handle == "" {
+		badRequest(c, "display_name or handle is required")
+		return
+	}
+
+	resp, err := h.saveClient.CreateUser(c.Request.Context(), &pb.CreateUserRequest{
+		Name:           req.DisplayName,
+		Handle:         req.Handle,
+		Email:          req.Email,
+		Language:       req.Language,
+		Country:        req.Country,
+		Timezone:       req.Timezone,
+		DefaultFilters: req.DefaultFilters.toProto(),
+	})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"user_id": resp.UserId, "user": resp.User})
+}
+
+// getUser - профиль пользователя
+func (h *Handler) getUser(c *gin.Context) {
+	userID, err := strconv.ParseUint(c.Param("user_id"), 10, 64)
+	if err != nil || userID == 0 {
+		badRequest(c, "valid user_id is required")
+		return
+	}
+
+	resp, err := h.saveClient.GetUser(c.Request.Context(), &pb.GetUserRequest{UserId: userID})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"user": resp.User})
+}
+
+// updateUser - меняет только переданные поля профиля; default_filters заменяются целиком
+func (h *Handler) updateUser(c *gin.Context) {
+	userID, err := strconv.ParseUint(c.Param("user_id"), 10, 64)
+	if err != nil || userID == 0 {
+		badRequest(c, "valid user_id is required")
+		return
+	}
+
+	var req struct {
+		Handle         *string            `json:"handle"`
+		Email          *string            `json:"email"`
+		DisplayName    *string            `json:"display_name"`
+		Language       *string            `json:"language"`
+		Country        *string            `json:"country"`
+		Timezone       *string            `json:"timezone"`
+		DefaultFilters *searchFiltersJSON `json:"default_filters"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		badRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.saveClient.UpdateUser(c.Request.Context(), &pb.UpdateUserRequest{
+		UserId:         userID,
+		Handle:         req.Handle,
+		Email:          req.Email,
+		DisplayName:    req.DisplayName,
+		Language:       req.Language,
+		Country:        req.Country,
+		Timezone:       req.Timezone,
+		DefaultFilters: req.DefaultFilters.toProto(),
+	})
+	if err != nil {
+		grpcError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"user": resp.User})
+}
@@ -0,0 +1,17 @@
+package bootstrap
+
+import (
+	"gonews/api_gateway/config"
+	"gonews/pkg/configloader"
+	"gonews/pkg/logging"
+	"log"
+	"log/slog"
+)
+
+func InitLogging(cfg *config.Config) {
+	if err := logging.Init("api-gateway", cfg.Logging); err != nil {
+		log.Fatalf("Failed to initialize logging: %v", err)
+	}
+
+	slog.Info("Effective config", "config", configloader.Redact(cfg))
+}
@@ -0,0 +1,19 @@
+package bootstrap
+
+import (
+	"context"
+	"gonews/api_gateway/api"
+	"gonews/api_gateway/config"
+	"gonews/pkg/logging"
+	"log/slog"
+)
+
+// InitConfigReload - по SIGHUP и при изменении config.yaml применяет уровень логов и лимиты групп маршрутов
+func InitConfigReload(cfg *config.Config, args []string, handler *api.Handler) {
+	config.WatchConfig(context.Background(), cfg, args, func(cfg *config.Config) {
+		if err := logging.SetLevel(cfg.Logging.Level); err != nil {
+			slog.Warn("Failed to change log level", logging.Err(err))
+		}
+		handler.SetRateLimits(cfg.RateLimit)
+	})
+}
@@ -5,17 +5,40 @@ import (
 	"fmt"
 	"gonews/api_gateway/api"
 	"gonews/api_gateway/config"
+	"gonews/api_gateway/ratelimit"
+	"gonews/pkg/logging"
+	"gonews/pkg/tlsconfig"
+	"gonews/pkg/tracing"
 	"log"
+	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
+
+	"github.com/go-redis/redis/v8"
 )
 
-func InitHTTPServer(cfg *config.Config) *http.Server {
-	handler := api.NewHandler(cfg)
+// InitRateLimiter - лимиты в Redis; nil, если rate_limit.enabled выключен
+func InitRateLimiter(cfg *config.Config) api.RateLimiter {
+	if !cfg.RateLimit.Enabled {
+		return nil
+	}
+
+	client := redis.NewClient(&redis.Options{
+		Addr: fmt.Sprintf("%s:%d", cfg.Redis.Host, cfg.Redis.Port),
+	})
+	client.AddHook(tracing.RedisHook{})
+
+	return ratelimit.NewLimiter(client, cfg.RateLimit.KeyPrefix)
+}
+
+func InitHandler(cfg *config.Config, limiter api.RateLimiter) *api.Handler {
+	return api.NewHandler(cfg, limiter)
+}
 
+func InitHTTPServer(cfg *config.Config, handler *api.Handler) *http.Server {
 	router := handler.SetupRouter()
 
 	server := &http.Server{
@@ -26,32 +49,54 @@ func InitHTTPServer(cfg *config.Config) *http.Server {
 		IdleTimeout:  60 * time.Second,
 	}
 
+	// HTTPS прямо на шлюзе, без отдельного прокси
+	if cfg.HTTP.TLS.Enabled {
+		tlsConfig, err := tlsconfig.ServerTLS(cfg.HTTP.TLS)
+		if err != nil {
+			log.Fatalf("Failed to configure HTTPS: %v", err)
+		}
+		server.TLSConfig = tlsConfig
+	}
+
 	return server
 }
 
-func AppRun(server *http.Server, cfg *config.Config) {
+func AppRun(server *http.Server, shutdownTracing tracing.Shutdown, cfg *config.Config) {
 	// Graceful shutdown
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
 
 	// Запускаем сервер
 	go func() {
-		log.Printf("API Gateway listening on port %d", cfg.HTTP.Port)
-		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		slog.Info("API Gateway listening", "port", cfg.HTTP.Port, "https", server.TLSConfig != nil)
+
+		var err error
+		if server.TLSConfig != nil {
+			// сертификат берётся из TLSConfig, файлы перечитываются при изменении
+			err = server.ListenAndServeTLS("", "")
+		} else {
+			err = server.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
 			log.Fatalf("Failed to start server: %v", err)
 		}
 	}()
 
 	<-stop
-	log.Println("Shutting down API Gateway...")
+	slog.Info("Shutting down API Gateway")
 
 	// Graceful shutdown
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
 	if err := server.Shutdown(ctx); err != nil {
-		log.Printf("Server shutdown error: %v", err)
+		slog.Error("Server shutdown error", logging.Err(err))
+	}
+
+	// Отправляем оставшиеся спаны
+	if err := shutdownTracing(ctx); err != nil {
+		slog.Error("Tracing shutdown error", logging.Err(err))
 	}
 
-	log.Println("API Gateway stopped")
+	slog.Info("API Gateway stopped")
 }
@@ -0,0 +1,16 @@
+package bootstrap
+
+import (
+	"context"
+	"gonews/api_gateway/config"
+	"gonews/pkg/tracing"
+	"log"
+)
+
+func InitTracing(cfg *config.Config) tracing.Shutdown {
+	shutdown, err := tracing.Init(context.Background(), "api-gateway", cfg.Tracing)
+	if err != nil {
+		log.Fatalf("Failed to initialize tracing: %v", err)
+	}
+	return shutdown
+}
@@ -0,0 +1,4 @@
+// Package client - Go клиент REST API gateway, сгенерированный из api_gateway/openapi/openapi.json
+package client
+
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.5.1 -config oapi-codegen.yaml ../openapi/openapi.json
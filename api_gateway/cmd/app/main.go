@@ -8,12 +8,17 @@ import (
 )
 
 func main() {
-	cfg, err := config.LoadConfig(os.Getenv("configPath"))
+	cfg, err := config.LoadConfig(os.Args[1:])
 	if err != nil {
 		panic(fmt.Sprintf("Config load error: %v", err))
 	}
 
-	server := bootstrap.InitHTTPServer(cfg)
+	bootstrap.InitLogging(cfg)
+	shutdownTracing := bootstrap.InitTracing(cfg)
+	limiter := bootstrap.InitRateLimiter(cfg)
+	handler := bootstrap.InitHandler(cfg, limiter)
+	bootstrap.InitConfigReload(cfg, os.Args[1:], handler)
+	server := bootstrap.InitHTTPServer(cfg, handler)
 
-	bootstrap.AppRun(server, cfg)
+	bootstrap.AppRun(server, shutdownTracing, cfg)
 }
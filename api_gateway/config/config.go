@@ -1,29 +1,70 @@
 package config
 
 import (
+	"context"
 	"fmt"
-	"os"
-
-	"gopkg.in/yaml.v3"
+	"gonews/pkg/breaker"
+	"gonews/pkg/configloader"
+	"gonews/pkg/logging"
+	"gonews/pkg/tlsconfig"
+	"gonews/pkg/tracing"
+	"net"
 )
 
 type SaveServiceConfig struct {
-	Host string `yaml:"host"`
-	Port int    `yaml:"port"`
+	Host string `yaml:"host" default:"save-service" validate:"required"`
+	Port int    `yaml:"port" default:"50051" validate:"port"`
 }
 
 type SearchServiceConfig struct {
-	Host string `yaml:"host"`
-	Port int    `yaml:"port"`
+	Host string `yaml:"host" default:"search-service" validate:"required"`
+	Port int    `yaml:"port" default:"50052" validate:"port"`
 }
 
 type NotifyServiceConfig struct {
-	Host string `yaml:"host"`
-	Port int    `yaml:"port"`
+	Host string `yaml:"host" default:"notify-service" validate:"required"`
+	Port int    `yaml:"port" default:"50053" validate:"port"`
 }
 
 type HTTPConfig struct {
-	Port int `yaml:"port"`
+	Port int              `yaml:"port" default:"8080" validate:"port"`
+	TLS  tlsconfig.Config `yaml:"tls"`
+	// TrustedProxies - IP и подсети прокси, которым верим X-Forwarded-For; пусто - клиент это адрес соединения
+	TrustedProxies []string `yaml:"trusted_proxies"`
+}
+
+func (c HTTPConfig) Validate() error {
+	for _, proxy := range c.TrustedProxies {
+		if _, _, err := net.ParseCIDR(proxy); err != nil && net.ParseIP(proxy) == nil {
+			return fmt.Errorf("trusted_proxies: %q is neither an IP nor a CIDR", proxy)
+		}
+	}
+	return nil
+}
+
+type RedisConfig struct {
+	Host string `yaml:"host" default:"redis" validate:"required"`
+	Port int    `yaml:"port" default:"6379" validate:"port"`
+}
+
+// RateLimitGroupConfig - token bucket группы маршрутов: скорость пополнения и ёмкость; 0 - без лимита
+type RateLimitGroupConfig struct {
+	RequestsPerMinute int `yaml:"requests_per_minute" validate:"min=0" reload:"true"`
+	Burst             int `yaml:"burst" validate:"min=0" reload:"true"`
+}
+
+// RateLimitConfig - лимиты по группам маршрутов (search, read, write), считаются отдельно
+// для IP, API ключа и пользователя - владельца ключа
+type RateLimitConfig struct {
+	Enabled   bool                            `yaml:"enabled"`
+	KeyPrefix string                          `yaml:"key_prefix" default:"ratelimit" validate:"required"`
+	Groups    map[string]RateLimitGroupConfig `yaml:"groups"`
+}
+
+// AuthConfig - доступ по X-API-Key. Поиск, профили с выгрузкой, изменения и /api/admin требуют ключ всегда;
+// AnonymousReads открывает без ключа остальные GET маршруты /api и /v1
+type AuthConfig struct {
+	AnonymousReads bool `yaml:"anonymous_reads"`
 }
 
 type Config struct {
@@ -31,23 +72,32 @@ type Config struct {
 	SearchService SearchServiceConfig `yaml:"search_service"`
 	NotifyService NotifyServiceConfig `yaml:"notify_service"`
 	HTTP          HTTPConfig          `yaml:"http"`
+	Redis         RedisConfig         `yaml:"redis"`
+	RateLimit     RateLimitConfig     `yaml:"rate_limit"`
+	Auth          AuthConfig          `yaml:"auth"`
+	Logging       logging.Config      `yaml:"logging"`
+	Tracing       tracing.Config      `yaml:"tracing"`
+	TLS           tlsconfig.Config    `yaml:"tls"`
+	// CircuitBreaker - пороги breaker-ов save, search и notification service
+	CircuitBreaker breaker.Config `yaml:"circuit_breaker"`
 }
 
-func LoadConfig(filename string) (*Config, error) {
-	if filename == "" {
-		filename = "config/config.yaml"
-	}
-
-	data, err := os.ReadFile(filename)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read config file: %w", err)
-	}
+func options(args []string) configloader.Options {
+	return configloader.Options{DefaultPath: "config/config.yaml", Args: args}
+}
 
+// LoadConfig - значения по умолчанию, YAML, переменные окружения (GONEWS_*) и флаги из args
+func LoadConfig(args []string) (*Config, error) {
 	var config Config
-	err = yaml.Unmarshal(data, &config)
-	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal yaml: %w", err)
+	if err := configloader.Load(&config, options(args)); err != nil {
+		return nil, err
 	}
 
 	return &config, nil
 }
+
+// WatchConfig - по SIGHUP и при изменении файла передаёт в apply конфиг с новыми значениями
+// полей reload:"true"; остальные поля применяются только после перезапуска
+func WatchConfig(ctx context.Context, current *Config, args []string, apply func(*Config)) {
+	configloader.Watch(ctx, current, options(args), apply)
+}
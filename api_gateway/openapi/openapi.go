@@ -0,0 +1,10 @@
+// Package openapi - OpenAPI 3 описание REST API gateway.
+// По нему генерируется клиент в api_gateway/client.
+package openapi
+
+import _ "embed"
+
+// Spec - документ OpenAPI в JSON, отдаётся на /openapi.json
+//
+//go:embed openapi.json
+var Spec []byte
@@ -0,0 +1,163 @@
+// Package ratelimit - распределённый token bucket в Redis: все экземпляры gateway делят одни бакеты.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// tokenBucket - проверяет все бакеты из KEYS и списывает токен, только если разрешают все.
+// ARGV[1] - токенов в секунду, ARGV[2] - ёмкость бакета. Время берём у Redis,
+// чтобы расхождение часов между экземплярами gateway не влияло на лимит.
+var tokenBucket = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local t = redis.call('TIME')
+local now = tonumber(t[1]) + tonumber(t[2]) / 1000000
+local ttl = math.ceil(burst / rate) + 1
+
+local allowed = 1
+local remaining = burst
+local tokens = {}
+for i, key in ipairs(KEYS) do
+  local state = redis.call('HMGET', key, 'tokens', 'ts')
+  local value = tonumber(state[1])
+  local ts = tonumber(state[2])
+  if value == nil or ts == nil then
+    value = burst
+    ts = now
+  end
+  value = math.min(burst, value + math.max(0, now - ts) * rate)
+  tokens[i] = value
+  if value < 1 then
+    allowed = 0
+  end
+  if value < remaining then
+    remaining = value
+  end
+end
+
+for i, key in ipairs(KEYS) do
+  local value = tokens[i]
+  if allowed == 1 then
+    value = value - 1
+  end
+  redis.call('HSET', key, 'tokens', tostring(value), 'ts', tostring(now))
+  redis.call('EXPIRE', key, ttl)
+end
+
+local retry = 0
+if allowed == 1 then
+  remaining = remaining - 1
+else
+  retry = (1 - remaining) / rate
+end
+local reset = (burst - remaining) / rate
+
+return {allowed, tostring(remaining), tostring(retry), tostring(reset)}
+`)
+
+// Limit - лимит группы маршрутов
+type Limit struct {
+	RequestsPerMinute int
+	Burst             int
+}
+
+// Result - решение по запросу для заголовков X-RateLimit-* и Retry-After
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// RetryAfter - через сколько появится токен, если запрос отклонён
+	RetryAfter time.Duration
+	// ResetAfter - через сколько самый пустой бакет снова заполнится
+	ResetAfter time.Duration
+}
+
+type Limiter struct {
+	client redis.Cmdable
+	prefix string
+}
+
+func NewLimiter(client redis.Cmdable, prefix string) *Limiter {
+	return &Limiter{
+		client: client,
+		prefix: prefix,
+	}
+}
+
+// Allow - списываем по токену из бакетов всех субъектов (ip, пользователь, API ключ) группы
+func (l *Limiter) Allow(ctx context.Context, group string, limit Limit, subjects ...string) (Result, error) {
+	keys := make([]string, 0, len(subjects))
+	for _, subject := range subjects {
+		keys = append(keys, l.key(group, subject))
+	}
+
+	rate := float64(limit.RequestsPerMinute) / 60
+	raw, err := tokenBucket.Run(ctx, l.client, keys, rate, limit.Burst).Slice()
+	if err != nil {
+		return Result{}, fmt.Errorf("rate limit script: %w", err)
+	}
+	if len(raw) != 4 {
+		return Result{}, fmt.Errorf("rate limit script: unexpected reply %v", raw)
+	}
+
+	allowed, _ := raw[0].(int64)
+	remaining, err := replyFloat(raw[1])
+	if err != nil {
+		return Result{}, err
+	}
+	retry, err := replyFloat(raw[2])
+	if err != nil {
+		return Result{}, err
+	}
+	reset, err := replyFloat(raw[3])
+	if err != nil {
+		return Result{}, err
+	}
+
+	return Result{
+		Allowed:    allowed == 1,
+		Limit:      limit.Burst,
+		Remaining:  int(math.Max(0, math.Floor(remaining))),
+		RetryAfter: seconds(retry),
+		ResetAfter: seconds(reset),
+	}, nil
+}
+
+// Forget - удаляет бакеты субъекта в группах groups, например пользователя после удаления аккаунта
+func (l *Limiter) Forget(ctx context.Context, subject string, groups ...string) error {
+	if len(groups) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(groups))
+	for _, group := range groups {
+		keys = append(keys, l.key(group, subject))
+	}
+	if err := l.client.Del(ctx, keys...).Err(); err != nil {
+		return fmt.Errorf("rate limit forget: %w", err)
+	}
+	return nil
+}
+
+func (l *Limiter) key(group, subject string) string {
+	return fmt.Sprintf("%s:%s:%s", l.prefix, group, subject)
+}
+
+func replyFloat(v interface{}) (float64, error) {
+	s, ok := v.(string)
+	if !ok {
+		return 0, fmt.Errorf("rate limit script: unexpected value %v", v)
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
@@ -0,0 +1,84 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"gotest.tools/v3/assert"
+)
+
+func newTestLimiter(t *testing.T) (*Limiter, *miniredis.Miniredis) {
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	return NewLimiter(client, "ratelimit"), server
+}
+
+func TestAllowConsumesBurstThenRejects(t *testing.T) {
+	limiter, _ := newTestLimiter(t)
+	ctx := context.Background()
+	limit := Limit{RequestsPerMinute: 60, Burst: 3}
+
+	for i := 0; i < 3; i++ {
+		result, err := limiter.Allow(ctx, "search", limit, "ip:10.0.0.1")
+		assert.NilError(t, err)
+		assert.Assert(t, result.Allowed)
+		assert.Equal(t, result.Remaining, 2-i)
+		assert.Equal(t, result.Limit, 3)
+	}
+
+	result, err := limiter.Allow(ctx, "search", limit, "ip:10.0.0.1")
+	assert.NilError(t, err)
+	assert.Assert(t, !result.Allowed)
+	assert.Equal(t, result.Remaining, 0)
+	assert.Assert(t, result.RetryAfter > 0 && result.RetryAfter <= time.Second)
+
+	// Другая группа и другой субъект - свои бакеты
+	result, err = limiter.Allow(ctx, "read", limit, "ip:10.0.0.1")
+	assert.NilError(t, err)
+	assert.Assert(t, result.Allowed)
+
+	result, err = limiter.Allow(ctx, "search", limit, "ip:10.0.0.2")
+	assert.NilError(t, err)
+	assert.Assert(t, result.Allowed)
+}
+
+func TestAllowRejectsWhenAnySubjectIsExhausted(t *testing.T) {
+	limiter, server := newTestLimiter(t)
+	ctx := context.Background()
+	limit := Limit{RequestsPerMinute: 60, Burst: 1}
+
+	result, err := limiter.Allow(ctx, "write", limit, "user:1")
+	assert.NilError(t, err)
+	assert.Assert(t, result.Allowed)
+
+	// user:1 исчерпан, поэтому токен ip-бакета не списывается
+	result, err = limiter.Allow(ctx, "write", limit, "ip:10.0.0.1", "user:1")
+	assert.NilError(t, err)
+	assert.Assert(t, !result.Allowed)
+
+	tokens := server.HGet("ratelimit:write:ip:10.0.0.1", "tokens")
+	assert.Equal(t, tokens, "1")
+}
+
+func TestForgetResetsSubjectBuckets(t *testing.T) {
+	limiter, server := newTestLimiter(t)
+	ctx := context.Background()
+	limit := Limit{RequestsPerMinute: 60, Burst: 1}
+
+	for _, group := range []string{"search", "read"} {
+		_, err := limiter.Allow(ctx, group, limit, "user:7", "ip:10.0.0.1")
+		assert.NilError(t, err)
+	}
+
+	assert.NilError(t, limiter.Forget(ctx, "user:7", "search", "read", "write"))
+
+	assert.Assert(t, !server.Exists("ratelimit:search:user:7"))
+	assert.Assert(t, !server.Exists("ratelimit:read:user:7"))
+	// бакеты других субъектов не трогаем
+	assert.Assert(t, server.Exists("ratelimit:search:ip:10.0.0.1"))
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"gonews/pkg/tlsconfig"
+	"gonews/protos/pb"
+	"os"
+
+	"google.golang.org/grpc"
+)
+
+// app - клиенты сервисов и вывод; соединения устанавливаются при первом вызове
+type app struct {
+	save   pb.SaveServiceClient
+	search pb.SearchServiceClient
+	notify pb.NotificationServiceClient
+	out    printer
+	conns  []*grpc.ClientConn
+}
+
+func newApp(cfg *Config) (*app, error) {
+	if cfg.Output != "table" && cfg.Output != "json" {
+		return nil, fmt.Errorf("-o: must be table or json, got %q", cfg.Output)
+	}
+
+	transport, err := tlsconfig.DialOption(tlsconfig.Config{
+		Enabled:  cfg.TLS,
+		CertFile: cfg.CertFile,
+		KeyFile:  cfg.KeyFile,
+		CAFile:   cfg.CAFile,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	a := &app{out: printer{w: os.Stdout, json: cfg.Output == "json"}}
+	for _, addr := range []string{cfg.SaveAddr, cfg.SearchAddr, cfg.NotifyAddr} {
+		conn, err := grpc.NewClient(addr, transport)
+		if err != nil {
+			a.Close()
+			return nil, fmt.Errorf("%s: %w", addr, err)
+		}
+		a.conns = append(a.conns, conn)
+	}
+
+	a.save = pb.NewSaveServiceClient(a.conns[0])
+	a.search = pb.NewSearchServiceClient(a.conns[1])
+	a.notify = pb.NewNotificationServiceClient(a.conns[2])
+	return a, nil
+}
+
+func (a *app) Close() error {
+	var errs []error
+	for _, conn := range a.conns {
+		errs = append(errs, conn.Close())
+	}
+	return errors.Join(errs...)
+}
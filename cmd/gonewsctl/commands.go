@@ -0,0 +1,402 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"gonews/protos/pb"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// pageSize - размер страницы при обходе списков; сервис ограничивает его своим максимумом
+const pageSize = 100
+
+// collect - проходит страницы списка, пока не наберёт limit элементов (0 - все)
+func collect[T any](ctx context.Context, limit int, page func(ctx context.Context, token string, size int32) ([]T, string, error)) ([]T, error) {
+	var items []T
+	token := ""
+	for {
+		size := pageSize
+		if limit > 0 && limit-len(items) < size {
+			size = limit - len(items)
+		}
+
+		batch, next, err := page(ctx, token, int32(size))
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, batch...)
+		if next == "" || (limit > 0 && len(items) >= limit) {
+			return items, nil
+		}
+		token = next
+	}
+}
+
+// parseFlags - флаги подкоманды; ошибка разбора - неверные аргументы
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	fs.SetOutput(os.Stderr)
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+	return nil
+}
+
+var userHeader = []string{"ID", "HANDLE", "NAME", "EMAIL", "LANGUAGE", "COUNTRY", "CREATED"}
+
+func userRow(u *pb.User) []string {
+	return []string{strconv.FormatUint(u.Id, 10), u.Handle, u.DisplayName, u.Email, u.Language, u.Country, u.CreatedAt}
+}
+
+func runUsers(ctx context.Context, a *app, args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+
+	switch args[0] {
+	case "list":
+		fs := flag.NewFlagSet("users list", flag.ContinueOnError)
+		limit := fs.Int("limit", 0, "maximum number of users, 0 - all")
+		if err := parseFlags(fs, args[1:]); err != nil {
+			return err
+		}
+
+		users, err := collect(ctx, *limit, func(ctx context.Context, token string, size int32) ([]*pb.User, string, error) {
+			resp, err := a.save.ListUsers(ctx, &pb.ListUsersRequest{PageToken: token, PageSize: size})
+			if err != nil {
+				return nil, "", err
+			}
+			return resp.Users, resp.NextPageToken, nil
+		})
+		if err != nil {
+			return err
+		}
+		return list(a.out, users, userHeader, userRow)
+
+	case "get":
+		if len(args) != 2 {
+			return errUsage
+		}
+		req := &pb.GetUserRequest{Handle: args[1]}
+		if id, err := strconv.ParseUint(args[1], 10, 64); err == nil {
+			req = &pb.GetUserRequest{UserId: id}
+		}
+
+		resp, err := a.save.GetUser(ctx, req)
+		if err != nil {
+			return err
+		}
+		return one(a.out, resp.User, userHeader, userRow)
+	}
+
+	return errUsage
+}
+
+var subscriptionHeader = []string{"ID", "USER", "KEYWORD", "SCHEDULE"}
+
+func subscriptionRow(s *pb.Subscription) []string {
+	return []string{strconv.FormatUint(s.Id, 10), strconv.FormatUint(s.UserId, 10), s.Keyword, s.Schedule}
+}
+
+func runSubscriptions(ctx context.Context, a *app, args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+	if args[0] == "schedule" {
+		return runSubscriptionSchedule(ctx, a, args[1:])
+	}
+	if args[0] != "list" {
+		return errUsage
+	}
+
+	fs := flag.NewFlagSet("subs list", flag.ContinueOnError)
+	userID := fs.Uint64("user", 0, "only subscriptions of this user, 0 - all users")
+	limit := fs.Int("limit", 0, "maximum number of subscriptions, 0 - all")
+	if err := parseFlags(fs, args[1:]); err != nil {
+		return err
+	}
+
+	subs, err := collect(ctx, *limit, func(ctx context.Context, token string, size int32) ([]*pb.Subscription, string, error) {
+		var resp *pb.GetSubscriptionsResponse
+		var err error
+		if *userID == 0 {
+			resp, err = a.save.ListAllSubscriptions(ctx, &pb.ListAllSubscriptionsRequest{PageToken: token, PageSize: size})
+		} else {
+			resp, err = a.save.GetSubscriptions(ctx, &pb.GetSubscriptionsRequest{UserId: *userID, PageToken: token, PageSize: size})
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Subscriptions, resp.NextPageToken, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return list(a.out, subs, subscriptionHeader, subscriptionRow)
+}
+
+// runSubscriptionSchedule - меняет расписание подписки; пустая строка - общий интервал
+func runSubscriptionSchedule(ctx context.Context, a *app, args []string) error {
+	if len(args) != 3 {
+		return errUsage
+	}
+	userID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return errUsage
+	}
+	subscriptionID, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return errUsage
+	}
+
+	resp, err := a.save.UpdateSubscription(ctx, &pb.UpdateSubscriptionRequest{
+		UserId:         userID,
+		SubscriptionId: subscriptionID,
+		Schedule:       args[2],
+	})
+	if err != nil {
+		return err
+	}
+	return one(a.out, resp.Subscription, subscriptionHeader, subscriptionRow)
+}
+
+var apiKeyHeader = []string{"ID", "NAME", "PREFIX", "SCOPES", "USER", "CREATED", "LAST USED", "REVOKED"}
+
+func apiKeyRow(k *pb.APIKey) []string {
+	return []string{strconv.FormatUint(k.Id, 10), k.Name, k.Prefix, strings.Join(k.Scopes, ","),
+		strconv.FormatUint(k.UserId, 10), k.CreatedAt, k.LastUsedAt, k.RevokedAt}
+}
+
+// runAPIKeys - ключи шлюза напрямую через save service; так выпускается первый admin ключ,
+// без которого маршруты /api/admin/api-keys недоступны
+func runAPIKeys(ctx context.Context, a *app, args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+
+	switch args[0] {
+	case "create":
+		fs := flag.NewFlagSet("api-keys create", flag.ContinueOnError)
+		scopes := fs.String("scopes", "", "comma-separated scopes: read:search, read:user, write:favourites, admin")
+		userID := fs.Uint64("user", 0, "user the key is issued to, 0 - service key")
+		if err := parseFlags(fs, args[1:]); err != nil {
+			return err
+		}
+		if fs.NArg() != 1 || *scopes == "" {
+			return errUsage
+		}
+
+		resp, err := a.save.CreateAPIKey(ctx, &pb.CreateAPIKeyRequest{Name: fs.Arg(0), Scopes: strings.Split(*scopes, ","), UserId: *userID})
+		if err != nil {
+			return err
+		}
+		// ключ показывается один раз, в базе только хэш
+		return one(a.out, resp, append([]string{"KEY"}, apiKeyHeader...), func(r *pb.APIKeySecretResponse) []string {
+			return append([]string{r.Key}, apiKeyRow(r.ApiKey)...)
+		})
+
+	case "list":
+		fs := flag.NewFlagSet("api-keys list", flag.ContinueOnError)
+		revoked := fs.Bool("revoked", false, "include revoked keys")
+		if err := parseFlags(fs, args[1:]); err != nil {
+			return err
+		}
+
+		keys, err := collect(ctx, 0, func(ctx context.Context, token string, size int32) ([]*pb.APIKey, string, error) {
+			resp, err := a.save.ListAPIKeys(ctx, &pb.ListAPIKeysRequest{PageToken: token, PageSize: size, IncludeRevoked: *revoked})
+			if err != nil {
+				return nil, "", err
+			}
+			return resp.ApiKeys, resp.NextPageToken, nil
+		})
+		if err != nil {
+			return err
+		}
+		return list(a.out, keys, apiKeyHeader, apiKeyRow)
+
+	case "revoke":
+		if len(args) != 2 {
+			return errUsage
+		}
+		id, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			return errUsage
+		}
+
+		resp, err := a.save.RevokeAPIKey(ctx, &pb.RevokeAPIKeyRequest{Id: id})
+		if err != nil {
+			return err
+		}
+		return one(a.out, resp, []string{"REVOKED"}, func(r *pb.RevokeAPIKeyResponse) []string {
+			return []string{strconv.FormatBool(r.Success)}
+		})
+	}
+
+	return errUsage
+}
+
+// runCheck - CheckNewArticles search service по ключевому слову, как в плановой проверке подписок
+func runCheck(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("check", flag.ContinueOnError)
+	since := fs.Duration("since", 24*time.Hour, "look for articles published within this period")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errUsage
+	}
+
+	resp, err := a.search.CheckNewArticles(ctx, &pb.CheckNewArticlesRequest{
+		Keyword:       fs.Arg(0),
+		LastCheckTime: time.Now().Add(-*since).Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+
+	return list(a.out, resp.NewArticles, []string{"PUBLISHED", "SOURCE", "TITLE", "URL"}, func(n *pb.News) []string {
+		return []string{n.PublishedAt, n.Source, n.Title, n.Url}
+	})
+}
+
+// runTrigger - внеплановая проверка подписок через notify service, с уведомлениями о новых статьях
+func runTrigger(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("trigger", flag.ContinueOnError)
+	userID := fs.Uint64("user", 0, "only subscriptions of this user, 0 - all users")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() > 1 {
+		return errUsage
+	}
+
+	resp, err := a.notify.TriggerCheck(ctx, &pb.TriggerCheckRequest{UserId: *userID, Keyword: fs.Arg(0)})
+	if err != nil {
+		return err
+	}
+
+	return one(a.out, resp, []string{"CHECKED", "NEW ARTICLES"}, func(r *pb.TriggerCheckResponse) []string {
+		return []string{strconv.Itoa(int(r.Checked)), strconv.Itoa(int(r.NewArticles))}
+	})
+}
+
+// runNotify - тестовое уведомление пользователю через notify service и Kafka
+func runNotify(ctx context.Context, a *app, args []string) error {
+	if len(args) != 2 {
+		return errUsage
+	}
+	userID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return errUsage
+	}
+
+	resp, err := a.notify.SendNotification(ctx, &pb.SendNotificationRequest{UserId: userID, Message: args[1]})
+	if err != nil {
+		return err
+	}
+
+	return one(a.out, resp, []string{"SUCCESS", "SENT", "MESSAGE"}, func(r *pb.SendNotificationResponse) []string {
+		return []string{strconv.FormatBool(r.Success), strconv.Itoa(int(r.SentCount)), r.Message}
+	})
+}
+
+func runCache(ctx context.Context, a *app, args []string) error {
+	if len(args) == 0 || args[0] != "purge" || len(args) > 2 {
+		return errUsage
+	}
+
+	req := &pb.PurgeCacheRequest{}
+	if len(args) == 2 {
+		req.Pattern = args[1]
+	}
+
+	resp, err := a.search.PurgeCache(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return one(a.out, resp, []string{"DELETED"}, func(r *pb.PurgeCacheResponse) []string {
+		return []string{strconv.FormatInt(r.Deleted, 10)}
+	})
+}
+
+func runMigrate(ctx context.Context, a *app, args []string) error {
+	if len(args) != 0 {
+		return errUsage
+	}
+
+	resp, err := a.save.RunMigrations(ctx, &pb.RunMigrationsRequest{})
+	if err != nil {
+		return err
+	}
+
+	return one(a.out, resp, []string{"MIGRATIONS"}, func(*pb.RunMigrationsResponse) []string {
+		return []string{"applied"}
+	})
+}
+
+func runDLQ(ctx context.Context, a *app, args []string) error {
+	if len(args) == 0 || args[0] != "replay" {
+		return errUsage
+	}
+
+	fs := flag.NewFlagSet("dlq replay", flag.ContinueOnError)
+	limit := fs.Int("limit", 0, "maximum number of messages to replay, 0 - all")
+	if err := parseFlags(fs, args[1:]); err != nil {
+		return err
+	}
+
+	resp, err := a.notify.ReplayDeadLetters(ctx, &pb.ReplayDeadLettersRequest{Limit: int32(*limit)})
+	if err != nil {
+		return err
+	}
+
+	return one(a.out, resp, []string{"REPLAYED"}, func(r *pb.ReplayDeadLettersResponse) []string {
+		return []string{strconv.Itoa(int(r.Replayed))}
+	})
+}
+
+// runExport - выгрузка данных пользователя в файл; имя по умолчанию предлагает save service
+func runExport(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	format := fs.String("format", "json", "json or zip")
+	out := fs.String("out", "", "output file, - for stdout; default - file name suggested by the service")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errUsage
+	}
+	userID, err := strconv.ParseUint(fs.Arg(0), 10, 64)
+	if err != nil {
+		return errUsage
+	}
+
+	resp, err := a.save.ExportUserData(ctx, &pb.ExportUserDataRequest{UserId: userID, Format: *format})
+	if err != nil {
+		return err
+	}
+
+	if *out == "-" {
+		_, err := a.out.w.Write(resp.Data)
+		return err
+	}
+
+	path := *out
+	if path == "" {
+		path = resp.Filename
+	}
+	// в выгрузке личные данные - файл только для владельца
+	if err := os.WriteFile(path, resp.Data, 0o600); err != nil {
+		return fmt.Errorf("failed to write export: %w", err)
+	}
+
+	if a.out.json {
+		return a.out.writeJSON(map[string]any{"file": path, "bytes": len(resp.Data), "content_type": resp.ContentType})
+	}
+	return a.out.table([]string{"FILE", "BYTES", "CONTENT TYPE"}, [][]string{{path, strconv.Itoa(len(resp.Data)), resp.ContentType}})
+}
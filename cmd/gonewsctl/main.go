@@ -0,0 +1,131 @@
+// gonewsctl - администрирование gonews через gRPC API сервисов: пользователи и подписки,
+// ключи API шлюза, ручная и внеплановая проверка новых статей, тестовое уведомление,
+// очистка кэша, миграции, переотправка dead letter сообщений и выгрузка данных пользователя.
+//
+//	go run ./cmd/gonewsctl users list
+//	go run ./cmd/gonewsctl -o json subs list -user 42
+//	go run ./cmd/gonewsctl api-keys create -scopes admin ops
+//
+// Адреса сервисов и TLS задаются флагами или переменными GONEWSCTL_*; сертификат клиента
+// для mTLS выпускает go run ./scripts/gencerts.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+)
+
+// command - подкоманда верхнего уровня; args - аргументы после её имени
+type command struct {
+	usage string
+	run   func(ctx context.Context, app *app, args []string) error
+}
+
+var commands = map[string]command{
+	"users":    {"users list [-limit N] | users get <id|handle>", runUsers},
+	"api-keys": {"api-keys create -scopes admin [-user id] <name> | api-keys list [-revoked] | api-keys revoke <id>", runAPIKeys},
+	"subs":     {"subs list [-user ID] [-limit N] | subs schedule <user-id> <subscription-id> <cron|@every 2h|\"\">", runSubscriptions},
+	"check":    {"check [-since 24h] <keyword>", runCheck},
+	"trigger":  {"trigger [-user ID] [keyword]", runTrigger},
+	"notify":   {"notify <user-id> <message>", runNotify},
+	"cache":    {"cache purge [pattern]", runCache},
+	"migrate":  {"migrate", runMigrate},
+	"dlq":      {"dlq replay [-limit N]", runDLQ},
+	"export":   {"export [-format json|zip] [-out file|-] <user-id>", runExport},
+}
+
+// errUsage - неверные аргументы подкоманды; печатается справка
+var errUsage = errors.New("invalid arguments")
+
+func main() {
+	fs := flag.NewFlagSet("gonewsctl", flag.ExitOnError)
+	cfg := registerFlags(fs)
+	fs.Usage = func() { usage(fs) }
+	_ = fs.Parse(os.Args[1:])
+
+	if fs.NArg() == 0 {
+		usage(fs)
+		os.Exit(2)
+	}
+	cmd, ok := commands[fs.Arg(0)]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "gonewsctl: unknown command %q\n\n", fs.Arg(0))
+		usage(fs)
+		os.Exit(2)
+	}
+
+	app, err := newApp(cfg)
+	if err != nil {
+		fatal(err)
+	}
+	defer app.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Timeout)
+	defer cancel()
+
+	if err := cmd.run(ctx, app, fs.Args()[1:]); err != nil {
+		if errors.Is(err, errUsage) {
+			fmt.Fprintf(os.Stderr, "usage: gonewsctl %s\n", cmd.usage)
+			os.Exit(2)
+		}
+		fatal(err)
+	}
+}
+
+func usage(fs *flag.FlagSet) {
+	fmt.Fprintln(os.Stderr, "usage: gonewsctl [flags] <command> [args]\n\ncommands:")
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %s\n", commands[name].usage)
+	}
+	fmt.Fprintln(os.Stderr, "\nflags:")
+	fs.PrintDefaults()
+}
+
+func fatal(err error) {
+	fmt.Fprintf(os.Stderr, "gonewsctl: %v\n", err)
+	os.Exit(1)
+}
+
+// Config - флаги верхнего уровня
+type Config struct {
+	SaveAddr   string
+	SearchAddr string
+	NotifyAddr string
+	Output     string
+	Timeout    time.Duration
+	TLS        bool
+	CAFile     string
+	CertFile   string
+	KeyFile    string
+}
+
+func registerFlags(fs *flag.FlagSet) *Config {
+	var cfg Config
+	fs.StringVar(&cfg.SaveAddr, "save", env("GONEWSCTL_SAVE_ADDR", "localhost:50051"), "save service address")
+	fs.StringVar(&cfg.SearchAddr, "search", env("GONEWSCTL_SEARCH_ADDR", "localhost:50052"), "search service address")
+	fs.StringVar(&cfg.NotifyAddr, "notify", env("GONEWSCTL_NOTIFY_ADDR", "localhost:50053"), "notify service address")
+	fs.StringVar(&cfg.Output, "o", env("GONEWSCTL_OUTPUT", "table"), "output format: table or json")
+	fs.DurationVar(&cfg.Timeout, "timeout", time.Minute, "deadline for the whole command")
+	fs.BoolVar(&cfg.TLS, "tls", os.Getenv("GONEWSCTL_TLS") == "true", "connect over mutual TLS with -cert and -key")
+	fs.StringVar(&cfg.CAFile, "ca", env("GONEWSCTL_CA_FILE", "certs/ca.crt"), "CA that signed the service certificates")
+	fs.StringVar(&cfg.CertFile, "cert", env("GONEWSCTL_CERT_FILE", "certs/gonewsctl.crt"), "client certificate")
+	fs.StringVar(&cfg.KeyFile, "key", env("GONEWSCTL_KEY_FILE", "certs/gonewsctl.key"), "client key")
+	return &cfg
+}
+
+func env(name, def string) string {
+	if value, ok := os.LookupEnv(name); ok {
+		return value
+	}
+	return def
+}
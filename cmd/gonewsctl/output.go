@@ -0,0 +1,66 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// printer - таблица для человека или JSON для скриптов (поля как в proto, snake_case)
+type printer struct {
+	w    io.Writer
+	json bool
+}
+
+// list - в JSON массив сообщений, в таблице строка на сообщение
+func list[T proto.Message](p printer, items []T, header []string, row func(T) []string) error {
+	if p.json {
+		raw := make([]json.RawMessage, len(items))
+		for i, item := range items {
+			data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(item)
+			if err != nil {
+				return err
+			}
+			raw[i] = data
+		}
+		return p.writeJSON(raw)
+	}
+
+	rows := make([][]string, len(items))
+	for i, item := range items {
+		rows[i] = row(item)
+	}
+	return p.table(header, rows)
+}
+
+// one - в JSON один объект, в таблице одна строка
+func one[T proto.Message](p printer, item T, header []string, row func(T) []string) error {
+	if p.json {
+		data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(item)
+		if err != nil {
+			return err
+		}
+		return p.writeJSON(json.RawMessage(data))
+	}
+	return p.table(header, [][]string{row(item)})
+}
+
+func (p printer) writeJSON(v any) error {
+	enc := json.NewEncoder(p.w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func (p printer) table(header []string, rows [][]string) error {
+	tw := tabwriter.NewWriter(p.w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"bytes"
+	"gonews/protos/pb"
+	"strconv"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestListPrintsTableAndJSON(t *testing.T) {
+	subs := []*pb.Subscription{{Id: 1, UserId: 7, Keyword: "golang"}, {Id: 12, UserId: 7, Keyword: "kafka"}}
+	row := func(s *pb.Subscription) []string {
+		return []string{strconv.FormatUint(s.Id, 10), s.Keyword}
+	}
+
+	var table bytes.Buffer
+	assert.NilError(t, list(printer{w: &table}, subs, []string{"ID", "KEYWORD"}, row))
+	assert.Equal(t, "ID  KEYWORD\n1   golang\n12  kafka\n", table.String())
+
+	var out bytes.Buffer
+	assert.NilError(t, list(printer{w: &out, json: true}, subs[:1], nil, row))
+	assert.Equal(t, "[\n  {\n    \"id\": \"1\",\n    \"user_id\": \"7\",\n    \"keyword\": \"golang\"\n  }\n]\n", out.String())
+}
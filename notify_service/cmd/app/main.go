@@ -8,11 +8,14 @@ import (
 )
 
 func main() {
-	cfg, err := config.LoadConfig(os.Getenv("configPath"))
+	cfg, err := config.LoadConfig(os.Args[1:])
 	if err != nil {
 		panic(fmt.Sprintf("Config load error: %v", err))
 	}
 
+	bootstrap.InitLogging(cfg, "notify-service")
+	shutdownTracing := bootstrap.InitTracing(cfg, "notify-service")
+	bootstrap.InitMetrics(cfg)
 	kafkaProducer := bootstrap.InitKafkaProducer(cfg)
 	notifyService, err := bootstrap.InitNotifyService(kafkaProducer, cfg)
 	if err != nil {
@@ -20,7 +23,9 @@ func main() {
 	}
 
 	scheduler := bootstrap.InitScheduler(notifyService, cfg)
-	grpcServer := bootstrap.InitGRPCServer(notifyService, cfg)
+	bootstrap.InitConfigReload(cfg, os.Args[1:], scheduler)
+	healthServer := bootstrap.InitHealthServer(kafkaProducer, cfg)
+	grpcServer := bootstrap.InitGRPCServer(notifyService, healthServer, cfg)
 
-	bootstrap.AppRun(grpcServer, scheduler, cfg)
+	bootstrap.AppRun(grpcServer, healthServer, scheduler, shutdownTracing, cfg)
 }
@@ -4,24 +4,35 @@ import (
 	"context"
 	"fmt"
 	"gonews/notify_service/config"
+	"gonews/notify_service/internal/bootstrap"
 	"gonews/notify_service/internal/consumer"
+	"gonews/pkg/logging"
+	"gonews/pkg/metrics"
 	"log"
+	"log/slog"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 )
 
 func main() {
-	cfg, err := config.LoadConfig(os.Getenv("configPath"))
+	cfg, err := config.LoadConfig(os.Args[1:])
 	if err != nil {
 		log.Fatalf("Config load error: %v", err)
 	}
 
+	bootstrap.InitLogging(cfg, "notify-worker")
+	bootstrap.InitConfigReload(cfg, os.Args[1:], nil)
+	shutdownTracing := bootstrap.InitTracing(cfg, "notify-worker")
+	metrics.Serve(cfg.Metrics.WorkerPort)
+
 	broker := fmt.Sprintf("%s:%d", cfg.Kafka.Host, cfg.Kafka.Port)
 	worker := consumer.NewNotificationWorker(
 		[]string{broker},
 		cfg.Kafka.NotificationTopic,
 		cfg.Kafka.ConsumerGroup,
+		cfg.Kafka.DeadLetterTopic,
 	)
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -33,10 +44,18 @@ func main() {
 
 	go func() {
 		<-stop
-		log.Println("Shutting down worker...")
+		slog.Info("Shutting down worker")
 		cancel()
 	}()
 
 	worker.Start(ctx)
-	log.Println("Worker stopped")
+
+	// Отправляем оставшиеся спаны
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer shutdownCancel()
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		slog.Error("Tracing shutdown error", logging.Err(err))
+	}
+
+	slog.Info("Worker stopped")
 }
@@ -1,35 +1,53 @@
 package config
 
 import (
-	"fmt"
-	"os"
-
-	"gopkg.in/yaml.v3"
+	"context"
+	"gonews/pkg/configloader"
+	"gonews/pkg/logging"
+	"gonews/pkg/tlsconfig"
+	"gonews/pkg/tracing"
 )
 
 type KafkaConfig struct {
-	Host              string `yaml:"host"`
-	Port              int    `yaml:"port"`
-	NotificationTopic string `yaml:"notification_topic"`
-	ConsumerGroup     string `yaml:"consumer_group"`
+	Host              string `yaml:"host" default:"kafka" validate:"required"`
+	Port              int    `yaml:"port" default:"9094" validate:"port"`
+	NotificationTopic string `yaml:"notification_topic" default:"notification.new" validate:"required"`
+	ConsumerGroup     string `yaml:"consumer_group" default:"notification-workers" validate:"required"`
+	// DeadLetterTopic - сообщения, которые воркер не смог обработать; переотправляются через gonewsctl dlq replay
+	DeadLetterTopic string `yaml:"dead_letter_topic" default:"notification.new.dlq" validate:"required"`
 }
 
 type GRPCConfig struct {
-	Port int `yaml:"port"`
+	Port int `yaml:"port" default:"50053" validate:"port"`
+	// DefaultDeadlineSeconds - дедлайн вызовов, пришедших без дедлайна клиента
+	DefaultDeadlineSeconds int `yaml:"default_deadline_seconds" default:"60" validate:"min=1"`
 }
 
 type SaveServiceConfig struct {
-	Host string `yaml:"host"`
-	Port int    `yaml:"port"`
+	Host string `yaml:"host" default:"save-service" validate:"required"`
+	Port int    `yaml:"port" default:"50051" validate:"port"`
 }
 
 type SearchServiceConfig struct {
-	Host string `yaml:"host"`
-	Port int    `yaml:"port"`
+	Host string `yaml:"host" default:"search-service" validate:"required"`
+	Port int    `yaml:"port" default:"50052" validate:"port"`
 }
 
 type SchedulerConfig struct {
-	CheckIntervalMinutes int `yaml:"check_interval_minutes"`
+	CheckIntervalMinutes int `yaml:"check_interval_minutes" default:"60" validate:"min=1" reload:"true"`
+	JitterSeconds        int `yaml:"jitter_seconds" default:"30" validate:"min=0"`
+	SyncIntervalSeconds  int `yaml:"sync_interval_seconds" default:"60" validate:"min=1"`
+}
+
+type HealthConfig struct {
+	IntervalSeconds int `yaml:"interval_seconds" default:"10" validate:"min=1"`
+	TimeoutSeconds  int `yaml:"timeout_seconds" default:"2" validate:"min=1"`
+}
+
+// MetricsConfig - порты /metrics сервиса и воркера уведомлений
+type MetricsConfig struct {
+	Port       int `yaml:"port" default:"9103" validate:"port"`
+	WorkerPort int `yaml:"worker_port" default:"9104" validate:"port"`
 }
 
 type Config struct {
@@ -38,23 +56,29 @@ type Config struct {
 	SaveService   SaveServiceConfig   `yaml:"save_service"`
 	SearchService SearchServiceConfig `yaml:"search_service"`
 	Scheduler     SchedulerConfig     `yaml:"scheduler"`
+	Health        HealthConfig        `yaml:"health"`
+	Metrics       MetricsConfig       `yaml:"metrics"`
+	Logging       logging.Config      `yaml:"logging"`
+	Tracing       tracing.Config      `yaml:"tracing"`
+	TLS           tlsconfig.Config    `yaml:"tls"`
 }
 
-func LoadConfig(filename string) (*Config, error) {
-	if filename == "" {
-		filename = "config/config.yaml"
-	}
-
-	data, err := os.ReadFile(filename)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read config file: %w", err)
-	}
+func options(args []string) configloader.Options {
+	return configloader.Options{DefaultPath: "config/config.yaml", Args: args}
+}
 
+// LoadConfig - значения по умолчанию, YAML, переменные окружения (GONEWS_*) и флаги из args
+func LoadConfig(args []string) (*Config, error) {
 	var config Config
-	err = yaml.Unmarshal(data, &config)
-	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal yaml: %w", err)
+	if err := configloader.Load(&config, options(args)); err != nil {
+		return nil, err
 	}
 
 	return &config, nil
 }
+
+// WatchConfig - по SIGHUP и при изменении файла передаёт в apply конфиг с новыми значениями
+// полей reload:"true"; остальные поля применяются только после перезапуска
+func WatchConfig(ctx context.Context, current *Config, args []string, apply func(*Config)) {
+	configloader.Watch(ctx, current, options(args), apply)
+}
@@ -2,8 +2,9 @@ package api
 
 import (
 	"context"
+	"gonews/pkg/logging"
 	"gonews/protos/pb"
-	"log"
+	"log/slog"
 	"time"
 
 	"google.golang.org/grpc/codes"
@@ -11,11 +12,7 @@ import (
 )
 
 func (s *GRPCServer) CheckNewArticles(ctx context.Context, req *pb.CheckNewArticlesRequest) (*pb.CheckNewArticlesResponse, error) {
-	log.Printf("CheckNewArticles called with keyword: %s, last_check_time: %s", req.Keyword, req.LastCheckTime)
-
-	if req.Keyword == "" {
-		return nil, status.Error(codes.InvalidArgument, "keyword is required")
-	}
+	slog.InfoContext(ctx, "check new articles", "keyword", req.Keyword, "last_check_time", req.LastCheckTime)
 
 	// Парсим время последней проверки
 	var lastCheckTime time.Time
@@ -24,7 +21,7 @@ func (s *GRPCServer) CheckNewArticles(ctx context.Context, req *pb.CheckNewArtic
 	if req.LastCheckTime != "" {
 		lastCheckTime, err = time.Parse(time.RFC3339, req.LastCheckTime)
 		if err != nil {
-			log.Printf("Failed to parse last_check_time: %v, using default (24 hours ago)", err)
+			slog.WarnContext(ctx, "invalid last_check_time, checking last 24 hours", logging.Err(err))
 			lastCheckTime = time.Now().Add(-24 * time.Hour)
 		}
 	} else {
@@ -42,16 +39,16 @@ func (s *GRPCServer) CheckNewArticles(ctx context.Context, req *pb.CheckNewArtic
 
 	// Для каждой подписки проверяем новые статьи
 	for _, sub := range subscriptions {
-		log.Printf("Checking subscription for user %d, keyword: %s", sub.UserID, req.Keyword)
+		slog.DebugContext(ctx, "checking subscription", "user_id", sub.UserID, "keyword", req.Keyword)
 
 		newArticles, err := s.notifyService.CheckNewArticlesForSubscription(ctx, sub.UserID, req.Keyword, lastCheckTime)
 		if err != nil {
-			log.Printf("Failed to check new articles for user %d: %v", sub.UserID, err)
+			slog.ErrorContext(ctx, "subscription check failed", "user_id", sub.UserID, "keyword", req.Keyword, logging.Err(err))
 			continue
 		}
 
 		if len(newArticles) > 0 {
-			log.Printf("Found %d new articles for user %d, keyword: %s", len(newArticles), sub.UserID, req.Keyword)
+			slog.InfoContext(ctx, "new articles found", "user_id", sub.UserID, "keyword", req.Keyword, "count", len(newArticles))
 
 			// Конвертируем в protobuf
 			pbArticles := make([]*pb.News, len(newArticles))
@@ -77,7 +74,7 @@ func (s *GRPCServer) CheckNewArticles(ctx context.Context, req *pb.CheckNewArtic
 		}
 	}
 
-	log.Printf("CheckNewArticles completed. Found %d new articles for %d users", len(allNewArticles), len(userArticles))
+	slog.InfoContext(ctx, "check new articles completed", "articles", len(allNewArticles), "users", len(userArticles))
 
 	return &pb.CheckNewArticlesResponse{
 		NewArticles: allNewArticles,
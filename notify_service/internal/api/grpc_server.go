@@ -14,6 +14,8 @@ type NotifyService interface {
 	GetSubscriptionsByKeyword(ctx context.Context, keyword string) ([]*models.Subscription, error)
 	CheckNewArticlesForSubscription(ctx context.Context, userID uint64, keyword string, lastCheckTime time.Time) ([]*models.News, error)
 	SendNotification(ctx context.Context, userID uint64, keyword string, article models.News) error
+	ReplayDeadLetters(ctx context.Context, limit int) (int, error)
+	TriggerCheck(ctx context.Context, userID uint64, keyword string) (checked, found int, err error)
 	GetSaveClient() pb.SaveServiceClient
 	GetSearchClient() pb.SearchServiceClient
 }
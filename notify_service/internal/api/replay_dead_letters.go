@@ -0,0 +1,19 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) ReplayDeadLetters(ctx context.Context, req *pb.ReplayDeadLettersRequest) (*pb.ReplayDeadLettersResponse, error) {
+	replayed, err := s.notifyService.ReplayDeadLetters(ctx, int(req.Limit))
+	if err != nil {
+		// часть сообщений могла уже уйти - число в ошибке, чтобы повтор не удивлял
+		return nil, status.Errorf(codes.Internal, "replayed %d messages, then failed: %v", replayed, err)
+	}
+
+	return &pb.ReplayDeadLettersResponse{Replayed: int32(replayed)}, nil
+}
@@ -3,8 +3,9 @@ package api
 import (
 	"context"
 	"gonews/notify_service/internal/models"
+	"gonews/pkg/logging"
 	"gonews/protos/pb"
-	"log"
+	"log/slog"
 	"time"
 
 	"google.golang.org/grpc/codes"
@@ -12,19 +13,11 @@ import (
 )
 
 func (s *GRPCServer) SendNotification(ctx context.Context, req *pb.SendNotificationRequest) (*pb.SendNotificationResponse, error) {
-	log.Printf("SendNotification called for user %d", req.UserId)
-
-	if req.UserId == 0 {
-		return nil, status.Error(codes.InvalidArgument, "user_id is required")
-	}
-
-	if req.Message == "" && len(req.Articles) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "either message or articles must be provided")
-	}
+	slog.InfoContext(ctx, "send notification", "user_id", req.UserId)
 
 	// Если есть статьи, отправляем уведомления для каждой
 	if len(req.Articles) > 0 {
-		log.Printf("Sending notifications for %d articles to user %d", len(req.Articles), req.UserId)
+		slog.DebugContext(ctx, "sending article notifications", "user_id", req.UserId, "articles", len(req.Articles))
 
 		// Определяем тему уведомления из первой статьи или запроса
 		notificationTopic := "news"
@@ -57,12 +50,12 @@ func (s *GRPCServer) SendNotification(ctx context.Context, req *pb.SendNotificat
 			// Отправляем уведомление
 			err := s.notifyService.SendNotification(ctx, req.UserId, notificationTopic, article)
 			if err != nil {
-				log.Printf("Failed to send notification for article '%s': %v", pbArticle.Title, err)
+				slog.ErrorContext(ctx, "notification not sent", "user_id", req.UserId, "article", pbArticle.Title, logging.Err(err))
 				// Продолжаем отправлять остальные уведомления
 				continue
 			}
 
-			log.Printf("Notification sent for article: %s", pbArticle.Title)
+			slog.DebugContext(ctx, "notification sent", "user_id", req.UserId, "article", pbArticle.Title)
 		}
 
 		return &pb.SendNotificationResponse{
@@ -74,7 +67,7 @@ func (s *GRPCServer) SendNotification(ctx context.Context, req *pb.SendNotificat
 
 	// Если есть только сообщение без статей
 	if req.Message != "" {
-		log.Printf("Sending simple message to user %d: %s", req.UserId, req.Message)
+		slog.InfoContext(ctx, "sending message", "user_id", req.UserId)
 
 		// Создаем фиктивную статью для структуры уведомления
 		article := models.News{
@@ -0,0 +1,18 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) TriggerCheck(ctx context.Context, req *pb.TriggerCheckRequest) (*pb.TriggerCheckResponse, error) {
+	checked, found, err := s.notifyService.TriggerCheck(ctx, req.UserId, req.Keyword)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check subscriptions: %v", err)
+	}
+
+	return &pb.TriggerCheckResponse{Checked: int32(checked), NewArticles: int32(found)}, nil
+}
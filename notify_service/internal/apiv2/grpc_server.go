@@ -0,0 +1,23 @@
+package apiv2
+
+import (
+	"context"
+	"gonews/notify_service/internal/models"
+	pbv2 "gonews/protos/pb/v2"
+)
+
+type NotifyService interface {
+	SendNotification(ctx context.Context, userID uint64, keyword string, article models.News) error
+}
+
+// GRPCServer - реализация news.v2.NotificationService
+type GRPCServer struct {
+	pbv2.UnimplementedNotificationServiceServer
+	notifyService NotifyService
+}
+
+func NewGRPCServer(notifyService NotifyService) *GRPCServer {
+	return &GRPCServer{
+		notifyService: notifyService,
+	}
+}
@@ -0,0 +1,89 @@
+package apiv2
+
+import (
+	"context"
+	"fmt"
+	"gonews/notify_service/internal/models"
+	"gonews/pkg/logging"
+	pbv2 "gonews/protos/pb/v2"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) SendNotification(ctx context.Context, req *pbv2.SendNotificationRequest) (*pbv2.SendNotificationResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if req.Message == "" && len(req.Articles) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "either message or articles must be provided")
+	}
+
+	// Только сообщение - отправляем системное уведомление
+	if len(req.Articles) == 0 {
+		article := models.News{
+			Source:      "System",
+			Author:      "Notification Service",
+			Title:       req.Message,
+			Description: req.Message,
+			PublishedAt: time.Now(),
+		}
+
+		err := s.notifyService.SendNotification(ctx, req.UserId, "system_notification", article)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to send notification: "+err.Error())
+		}
+
+		return &pbv2.SendNotificationResponse{
+			Success:   true,
+			SentCount: 1,
+			Message:   "Message notification sent successfully",
+		}, nil
+	}
+
+	// Сначала проверяем все статьи, чтобы не отправить часть уведомлений при некорректном запросе
+	articles := make([]models.News, len(req.Articles))
+	for i, pbArticle := range req.Articles {
+		var publishedAt time.Time
+		if pbArticle.PublishedAt != nil {
+			if err := pbArticle.PublishedAt.CheckValid(); err != nil {
+				return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("articles[%d].published_at: %v", i, err))
+			}
+			publishedAt = pbArticle.PublishedAt.AsTime()
+		}
+
+		articles[i] = models.News{
+			Source:      pbArticle.Source,
+			Author:      pbArticle.Author,
+			Title:       pbArticle.Title,
+			Description: pbArticle.Description,
+			URL:         pbArticle.Url,
+			URLToImage:  pbArticle.ImageUrl,
+			PublishedAt: publishedAt,
+		}
+	}
+
+	notificationTopic := "new_articles"
+	if req.Message != "" {
+		notificationTopic = req.Message
+	}
+
+	var sent int32
+	for _, article := range articles {
+		err := s.notifyService.SendNotification(ctx, req.UserId, notificationTopic, article)
+		if err != nil {
+			slog.ErrorContext(ctx, "notification not sent", "user_id", req.UserId, "article", article.Title, logging.Err(err))
+			continue
+		}
+		sent++
+	}
+
+	return &pbv2.SendNotificationResponse{
+		Success:   sent > 0,
+		SentCount: sent,
+		Message:   fmt.Sprintf("%d of %d notifications sent", sent, len(articles)),
+	}, nil
+}
@@ -0,0 +1,19 @@
+package bootstrap
+
+import (
+	"context"
+	"gonews/notify_service/config"
+	"gonews/notify_service/internal/producer"
+	"gonews/pkg/health"
+	"time"
+)
+
+func InitHealthServer(kafkaProducer *producer.KafkaProducer, cfg *config.Config) *health.Server {
+	healthServer := health.NewServer(
+		time.Duration(cfg.Health.IntervalSeconds)*time.Second,
+		time.Duration(cfg.Health.TimeoutSeconds)*time.Second,
+	)
+	healthServer.AddCheck("kafka", kafkaProducer.Ping)
+	healthServer.Start(context.Background())
+	return healthServer
+}
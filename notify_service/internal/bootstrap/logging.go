@@ -0,0 +1,18 @@
+package bootstrap
+
+import (
+	"gonews/notify_service/config"
+	"gonews/pkg/configloader"
+	"gonews/pkg/logging"
+	"log"
+	"log/slog"
+)
+
+// InitLogging - логгер процесса: notify-service или notify-worker
+func InitLogging(cfg *config.Config, serviceName string) {
+	if err := logging.Init(serviceName, cfg.Logging); err != nil {
+		log.Fatalf("Failed to initialize logging: %v", err)
+	}
+
+	slog.Info("Effective config", "config", configloader.Redact(cfg))
+}
@@ -5,6 +5,7 @@ import (
 	"gonews/notify_service/config"
 	"gonews/notify_service/internal/producer"
 	"gonews/notify_service/internal/services/notifyService"
+	"gonews/pkg/tlsconfig"
 	"time"
 )
 
@@ -12,10 +13,17 @@ func InitNotifyService(producer *producer.KafkaProducer, cfg *config.Config) (*n
 	saveServiceAddr := fmt.Sprintf("%s:%d", cfg.SaveService.Host, cfg.SaveService.Port)
 	searchServiceAddr := fmt.Sprintf("%s:%d", cfg.SearchService.Host, cfg.SearchService.Port)
 
-	return notifyService.NewNotifyService(saveServiceAddr, searchServiceAddr, producer)
+	transport, err := tlsconfig.DialOption(cfg.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	return notifyService.NewNotifyService(saveServiceAddr, searchServiceAddr, transport, producer)
 }
 
 func InitScheduler(notifyService_ *notifyService.NotifyService, cfg *config.Config) *notifyService.Scheduler {
-	interval := time.Duration(cfg.Scheduler.CheckIntervalMinutes) * time.Minute
-	return notifyService.NewScheduler(notifyService_, interval)
+	return notifyService.NewScheduler(notifyService_,
+		time.Duration(cfg.Scheduler.CheckIntervalMinutes)*time.Minute,
+		time.Duration(cfg.Scheduler.SyncIntervalSeconds)*time.Second,
+		time.Duration(cfg.Scheduler.JitterSeconds)*time.Second)
 }
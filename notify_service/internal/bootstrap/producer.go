@@ -8,5 +8,6 @@ import (
 
 func InitKafkaProducer(cfg *config.Config) *producer.KafkaProducer {
 	broker := fmt.Sprintf("%s:%d", cfg.Kafka.Host, cfg.Kafka.Port)
-	return producer.NewKafkaProducer([]string{broker}, cfg.Kafka.NotificationTopic)
+	return producer.NewKafkaProducer([]string{broker}, cfg.Kafka.NotificationTopic,
+		cfg.Kafka.DeadLetterTopic, cfg.Kafka.ConsumerGroup+"-dlq-replay")
 }
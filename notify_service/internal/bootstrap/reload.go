@@ -0,0 +1,26 @@
+package bootstrap
+
+import (
+	"context"
+	"gonews/notify_service/config"
+	"gonews/notify_service/internal/services/notifyService"
+	"gonews/pkg/logging"
+	"log/slog"
+	"time"
+)
+
+// InitConfigReload - по SIGHUP и при изменении config.yaml применяет уровень логов и интервал планировщика;
+// у воркера уведомлений планировщика нет, scheduler - nil
+func InitConfigReload(cfg *config.Config, args []string, scheduler *notifyService.Scheduler) {
+	interval := cfg.Scheduler.CheckIntervalMinutes
+	config.WatchConfig(context.Background(), cfg, args, func(cfg *config.Config) {
+		if err := logging.SetLevel(cfg.Logging.Level); err != nil {
+			slog.Warn("Failed to change log level", logging.Err(err))
+		}
+		// перезагрузка без смены интервала не должна трогать очередь планировщика
+		if scheduler != nil && cfg.Scheduler.CheckIntervalMinutes != interval {
+			interval = cfg.Scheduler.CheckIntervalMinutes
+			scheduler.SetInterval(time.Duration(interval) * time.Minute)
+		}
+	})
+}
@@ -5,25 +5,43 @@ import (
 	"fmt"
 	"gonews/notify_service/config"
 	"gonews/notify_service/internal/api"
+	"gonews/notify_service/internal/apiv2"
 	"gonews/notify_service/internal/services/notifyService"
+	"gonews/pkg/grpcserver"
+	"gonews/pkg/health"
+	"gonews/pkg/logging"
+	"gonews/pkg/metrics"
+	"gonews/pkg/tracing"
 	"gonews/protos/pb"
+	pbv2 "gonews/protos/pb/v2"
 	"log"
+	"log/slog"
 	"net"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"google.golang.org/grpc"
 )
 
-func InitGRPCServer(notifyService *notifyService.NotifyService, cfg *config.Config) *grpc.Server {
-	grpcServer := grpc.NewServer()
+func InitGRPCServer(notifyService *notifyService.NotifyService, healthServer *health.Server, cfg *config.Config) *grpc.Server {
+	grpcServer, err := grpcserver.New(grpcserver.Config{
+		DefaultDeadline: time.Duration(cfg.GRPC.DefaultDeadlineSeconds) * time.Second,
+		TLS:             cfg.TLS,
+	})
+	if err != nil {
+		log.Fatalf("Failed to create gRPC server: %v", err)
+	}
+	healthServer.Register(grpcServer)
 	notificationServer := api.NewGRPCServer(notifyService)
 	pb.RegisterNotificationServiceServer(grpcServer, notificationServer)
+	pbv2.RegisterNotificationServiceServer(grpcServer, apiv2.NewGRPCServer(notifyService))
+	metrics.InitServer(grpcServer)
 	return grpcServer
 }
 
-func AppRun(grpcServer *grpc.Server, scheduler *notifyService.Scheduler, cfg *config.Config) {
+func AppRun(grpcServer *grpc.Server, healthServer *health.Server, scheduler *notifyService.Scheduler, shutdownTracing tracing.Shutdown, cfg *config.Config) {
 	// Запускаем gRPC сервер
 	go func() {
 		lis, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.GRPC.Port))
@@ -31,7 +49,7 @@ func AppRun(grpcServer *grpc.Server, scheduler *notifyService.Scheduler, cfg *co
 			log.Fatalf("Failed to listen: %v", err)
 		}
 
-		log.Printf("Notification service gRPC server listening on port %d", cfg.GRPC.Port)
+		slog.Info("Notification service gRPC server listening", "port", cfg.GRPC.Port)
 
 		if err := grpcServer.Serve(lis); err != nil {
 			log.Fatalf("Failed to serve: %v", err)
@@ -47,7 +65,10 @@ func AppRun(grpcServer *grpc.Server, scheduler *notifyService.Scheduler, cfg *co
 	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
 
 	<-stop
-	log.Println("Shutting down notification service...")
+	slog.Info("Shutting down notification service")
+
+	// Сначала сообщаем, что сервис больше не готов принимать запросы
+	healthServer.Stop()
 
 	// Останавливаем scheduler
 	scheduler.Stop()
@@ -55,5 +76,12 @@ func AppRun(grpcServer *grpc.Server, scheduler *notifyService.Scheduler, cfg *co
 	// Graceful shutdown gRPC сервера
 	grpcServer.GracefulStop()
 
-	log.Println("Notification service stopped")
+	// Отправляем оставшиеся спаны
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		slog.Error("Tracing shutdown error", logging.Err(err))
+	}
+
+	slog.Info("Notification service stopped")
 }
@@ -0,0 +1,17 @@
+package bootstrap
+
+import (
+	"context"
+	"gonews/notify_service/config"
+	"gonews/pkg/tracing"
+	"log"
+)
+
+// InitTracing - провайдер трейсов процесса: notify-service или notify-worker
+func InitTracing(cfg *config.Config, serviceName string) tracing.Shutdown {
+	shutdown, err := tracing.Init(context.Background(), serviceName, cfg.Tracing)
+	if err != nil {
+		log.Fatalf("Failed to initialize tracing: %v", err)
+	}
+	return shutdown
+}
@@ -0,0 +1,31 @@
+package consumer
+
+import (
+	"gonews/pkg/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	notificationsConsumed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "kafka",
+		Name:      "notifications_consumed_total",
+		Help:      "Notification messages read from Kafka by topic and outcome.",
+	}, []string{"topic", "outcome"})
+
+	notificationsDeadLettered = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "kafka",
+		Name:      "notifications_dead_lettered_total",
+		Help:      "Notification messages that failed processing and were written to the dead letter topic, by source topic and outcome of the write.",
+	}, []string{"topic", "outcome"})
+
+	consumerLag = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "kafka",
+		Name:      "consumer_lag_messages",
+		Help:      "Messages behind the partition high watermark after the last processed message.",
+	}, []string{"topic", "partition"})
+)
@@ -3,18 +3,29 @@ package consumer
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"gonews/notify_service/internal/models"
-	"log"
+	"gonews/pkg/logging"
+	"gonews/pkg/metrics"
+	"gonews/pkg/tracing"
+	"log/slog"
+	"slices"
+	"strconv"
 	"time"
 
 	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type NotificationWorker struct {
 	reader *kafka.Reader
+	// deadLetters - топик для сообщений, которые не удалось обработать
+	deadLetters *kafka.Writer
 }
 
-func NewNotificationWorker(brokers []string, topic, groupID string) *NotificationWorker {
+func NewNotificationWorker(brokers []string, topic, groupID, deadLetterTopic string) *NotificationWorker {
 	reader := kafka.NewReader(kafka.ReaderConfig{
 		Brokers:        brokers,
 		Topic:          topic,
@@ -27,50 +38,104 @@ func NewNotificationWorker(brokers []string, topic, groupID string) *Notificatio
 
 	return &NotificationWorker{
 		reader: reader,
+		deadLetters: &kafka.Writer{
+			Addr:                   kafka.TCP(brokers...),
+			Topic:                  deadLetterTopic,
+			Balancer:               &kafka.LeastBytes{},
+			RequiredAcks:           kafka.RequireOne,
+			AllowAutoTopicCreation: true,
+		},
 	}
 }
 
 func (w *NotificationWorker) Start(ctx context.Context) {
-	log.Println("Starting notification worker...")
+	slog.InfoContext(ctx, "starting notification worker", "topic", w.reader.Config().Topic)
 
 	for {
 		select {
 		case <-ctx.Done():
-			log.Println("Stopping notification worker...")
-			w.reader.Close()
+			slog.InfoContext(ctx, "stopping notification worker")
+			w.Close()
 			return
 		default:
 			msg, err := w.reader.ReadMessage(ctx)
 			if err != nil {
-				log.Printf("Error reading message: %v", err)
+				slog.ErrorContext(ctx, "kafka read failed", logging.Err(err))
 				continue
 			}
 
-			w.processMessage(msg.Value)
+			w.handleMessage(ctx, msg)
 		}
 	}
 }
 
-func (w *NotificationWorker) processMessage(message []byte) {
+// handleMessage - продолжаем трейс и request_id продюсера из заголовков сообщения
+func (w *NotificationWorker) handleMessage(ctx context.Context, msg kafka.Message) {
+	ctx = tracing.ExtractKafka(ctx, &msg)
+	ctx = logging.ExtractKafka(ctx, &msg)
+	ctx, span := tracing.Tracer("gonews/notify_service/consumer").Start(ctx, msg.Topic+" process",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingOperationTypeProcess,
+			semconv.MessagingDestinationName(msg.Topic),
+			semconv.MessagingDestinationPartitionID(strconv.Itoa(msg.Partition)),
+			semconv.MessagingKafkaOffset(int(msg.Offset)),
+		),
+	)
+	defer span.End()
+
+	err := w.processMessage(ctx, msg.Value)
+	notificationsConsumed.WithLabelValues(msg.Topic, metrics.Outcome(err)).Inc()
+	consumerLag.WithLabelValues(msg.Topic, strconv.Itoa(msg.Partition)).Set(float64(msg.HighWaterMark - msg.Offset - 1))
+	if err != nil {
+		w.deadLetter(ctx, msg, err)
+	}
+}
+
+// deadLetter - откладываем сообщение с причиной ошибки в заголовке, чтобы не потерять его
+// и не останавливать обработку остальных
+func (w *NotificationWorker) deadLetter(ctx context.Context, msg kafka.Message, cause error) {
+	headers := append(slices.Clone(msg.Headers), kafka.Header{Key: models.DeadLetterErrorHeader, Value: []byte(cause.Error())})
+	err := w.deadLetters.WriteMessages(ctx, kafka.Message{
+		Key:     msg.Key,
+		Value:   msg.Value,
+		Headers: headers,
+	})
+	notificationsDeadLettered.WithLabelValues(msg.Topic, metrics.Outcome(err)).Inc()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to write message to dead letter topic", "offset", msg.Offset, logging.Err(err))
+		return
+	}
+	slog.WarnContext(ctx, "message moved to dead letter topic", "topic", w.deadLetters.Topic, logging.Err(cause))
+}
+
+func (w *NotificationWorker) processMessage(ctx context.Context, message []byte) error {
 	var notification models.NotificationMessage
 	err := json.Unmarshal(message, &notification)
 	if err != nil {
-		log.Printf("Failed to unmarshal notification: %v", err)
-		return
+		slog.ErrorContext(ctx, "invalid notification message", logging.Err(err))
+		span := trace.SpanFromContext(ctx)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "invalid notification message")
+		return err
 	}
 
 	// fake sending notifications
-	log.Printf("=== NOTIFICATION SENT ===")
-	log.Printf("Event ID: %s", notification.EventID)
-	log.Printf("To topic: %s", notification.NotifTopic)
-	log.Printf("Article: %s", notification.Article.Title)
-	log.Printf("Author: %s", notification.Article.Author)
-	log.Printf("Published: %s", notification.Article.PublishedAt.Format("2006-01-02 15:04"))
-	log.Printf("URL: %s", notification.Article.URL)
-	log.Printf("Timestamp: %s", notification.Timestamp.Format("2006-01-02 15:04:05"))
-	log.Printf("=========================\n")
+	slog.InfoContext(ctx, "notification sent",
+		"event_id", notification.EventID,
+		"topic", notification.NotifTopic,
+		slog.Group("article",
+			"title", notification.Article.Title,
+			"author", notification.Article.Author,
+			"published_at", notification.Article.PublishedAt,
+			"url", notification.Article.URL,
+		),
+		"timestamp", notification.Timestamp,
+	)
+	return nil
 }
 
 func (w *NotificationWorker) Close() error {
-	return w.reader.Close()
+	return errors.Join(w.reader.Close(), w.deadLetters.Close())
 }
@@ -12,6 +12,10 @@ type News struct {
 	PublishedAt time.Time `json:"publishedAt"`
 }
 
+// DeadLetterErrorHeader - заголовок с причиной, по которой воркер не обработал сообщение;
+// при переотправке из dead letter топика снимается
+const DeadLetterErrorHeader = "dead-letter-error"
+
 type NotificationMessage struct {
 	EventID    string    `json:"event_id"`
 	EventType  string    `json:"event_type"`
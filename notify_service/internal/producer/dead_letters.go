@@ -0,0 +1,72 @@
+package producer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gonews/notify_service/internal/models"
+	"gonews/pkg/metrics"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	// replayJoinTimeout - ожидание первого сообщения: сюда входит вступление в группу
+	replayJoinTimeout = 10 * time.Second
+	// replayIdleTimeout - нет следующего сообщения за это время - топик вычитан
+	replayIdleTimeout = 2 * time.Second
+)
+
+// ReplayDeadLetters - возвращает до limit (0 - все) сообщений из dead letter топика в топик уведомлений.
+// Смещения фиксируются в отдельной группе, поэтому каждое сообщение переотправляется один раз.
+func (kp *KafkaProducer) ReplayDeadLetters(ctx context.Context, limit int) (int, error) {
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     kp.brokers,
+		Topic:       kp.deadLetterTopic,
+		GroupID:     kp.replayGroup,
+		StartOffset: kafka.FirstOffset,
+	})
+	defer reader.Close()
+
+	replayed := 0
+	timeout := replayJoinTimeout
+	for limit == 0 || replayed < limit {
+		fetchCtx, cancel := context.WithTimeout(ctx, timeout)
+		msg, err := reader.FetchMessage(fetchCtx)
+		cancel()
+		if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+			break
+		}
+		if err != nil {
+			return replayed, fmt.Errorf("failed to read dead letter topic: %w", err)
+		}
+		timeout = replayIdleTimeout
+
+		err = kp.writer.WriteMessages(ctx, kafka.Message{
+			Key:     msg.Key,
+			Value:   msg.Value,
+			Headers: withoutHeader(msg.Headers, models.DeadLetterErrorHeader),
+		})
+		notificationsReplayed.WithLabelValues(kp.topic, metrics.Outcome(err)).Inc()
+		if err != nil {
+			return replayed, fmt.Errorf("failed to write message to kafka: %w", err)
+		}
+		if err := reader.CommitMessages(ctx, msg); err != nil {
+			return replayed, fmt.Errorf("failed to commit dead letter offset: %w", err)
+		}
+		replayed++
+	}
+
+	return replayed, nil
+}
+
+func withoutHeader(headers []kafka.Header, key string) []kafka.Header {
+	var out []kafka.Header
+	for _, h := range headers {
+		if h.Key != key {
+			out = append(out, h)
+		}
+	}
+	return out
+}
@@ -5,18 +5,28 @@ import (
 	"encoding/json"
 	"fmt"
 	"gonews/notify_service/internal/models"
+	"gonews/pkg/logging"
+	"gonews/pkg/metrics"
+	"gonews/pkg/tracing"
 	"time"
 
 	"github.com/samber/lo"
 	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type KafkaProducer struct {
-	writer *kafka.Writer
-	topic  string
+	writer  *kafka.Writer
+	topic   string
+	brokers []string
+	// deadLetterTopic и replayGroup - откуда и какой группой ReplayDeadLetters забирает сообщения
+	deadLetterTopic string
+	replayGroup     string
 }
 
-func NewKafkaProducer(brokers []string, topic string) *KafkaProducer {
+func NewKafkaProducer(brokers []string, topic, deadLetterTopic, replayGroup string) *KafkaProducer {
 	writer := &kafka.Writer{
 		Addr:         kafka.TCP(brokers...),
 		Topic:        topic,
@@ -26,8 +36,11 @@ func NewKafkaProducer(brokers []string, topic string) *KafkaProducer {
 	}
 
 	return &KafkaProducer{
-		writer: writer,
-		topic:  topic,
+		writer:          writer,
+		topic:           topic,
+		brokers:         brokers,
+		deadLetterTopic: deadLetterTopic,
+		replayGroup:     replayGroup,
 	}
 }
 
@@ -45,22 +58,62 @@ func (kp *KafkaProducer) SendNotification(ctx context.Context, userID uint64, ke
 		return fmt.Errorf("failed to marshal notification message: %w", err)
 	}
 
-	// Отправляем сообщение в Kafka
-	err = kp.writer.WriteMessages(ctx,
-		kafka.Message{
-			Key:   []byte(fmt.Sprintf("user_%d", userID)),
-			Value: messageJSON,
-			Time:  time.Now(),
-		},
+	ctx, span := tracing.Tracer("gonews/notify_service/producer").Start(ctx, kp.topic+" publish",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingOperationTypeSend,
+			semconv.MessagingDestinationName(kp.topic),
+			semconv.MessagingMessageID(message.EventID),
+		),
 	)
+	defer span.End()
+
+	msg := kafka.Message{
+		Key:   []byte(fmt.Sprintf("user_%d", userID)),
+		Value: messageJSON,
+		Time:  time.Now(),
+	}
+	// Контекст трейса и request_id едут в заголовках, воркер продолжит тот же трейс и логи
+	tracing.InjectKafka(ctx, &msg)
+	logging.InjectKafka(ctx, &msg)
 
+	// Отправляем сообщение в Kafka
+	start := time.Now()
+	err = kp.writer.WriteMessages(ctx, msg)
+	produceDuration.WithLabelValues(kp.topic).Observe(time.Since(start).Seconds())
+	notificationsProduced.WithLabelValues(kp.topic, metrics.Outcome(err)).Inc()
 	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
 		return fmt.Errorf("failed to write message to kafka: %w", err)
 	}
 
 	return nil
 }
 
+// Ping - проверка доступности брокера для health check: запрос метаданных кластера
+func (kp *KafkaProducer) Ping(ctx context.Context) error {
+	var lastErr error
+	for _, broker := range kp.brokers {
+		conn, err := kafka.DialContext(ctx, "tcp", broker)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if deadline, ok := ctx.Deadline(); ok {
+			conn.SetDeadline(deadline)
+		}
+		_, err = conn.Brokers()
+		conn.Close()
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+	}
+	return fmt.Errorf("kafka is unavailable: %w", lastErr)
+}
+
 func (kp *KafkaProducer) Close() error {
 	return kp.writer.Close()
 }
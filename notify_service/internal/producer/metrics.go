@@ -0,0 +1,31 @@
+package producer
+
+import (
+	"gonews/pkg/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	notificationsProduced = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "kafka",
+		Name:      "notifications_produced_total",
+		Help:      "Notification messages written to Kafka by topic and outcome.",
+	}, []string{"topic", "outcome"})
+
+	notificationsReplayed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "kafka",
+		Name:      "notifications_replayed_total",
+		Help:      "Messages moved from the dead letter topic back to the notification topic, by topic and outcome.",
+	}, []string{"topic", "outcome"})
+
+	produceDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "kafka",
+		Name:      "produce_duration_seconds",
+		Help:      "Time to write a message to Kafka, including batching and acks.",
+	}, []string{"topic"})
+)
@@ -0,0 +1,109 @@
+package notifyService
+
+import (
+	"context"
+	"errors"
+	"gonews/pkg/logging"
+	"gonews/protos/pb"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrCheckInProgress - подписка уже проверяется: по расписанию или через TriggerCheck
+var ErrCheckInProgress = errors.New("subscription check already in progress")
+
+// firstCheckWindow - за какой период ищем статьи при первой проверке подписки
+const firstCheckWindow = 24 * time.Hour
+
+// checkState - когда подписки проверялись последний раз и какие проверяются сейчас;
+// планировщик и TriggerCheck работают параллельно, а одна статья не должна уйти пользователю дважды
+type checkState struct {
+	mu      sync.Mutex
+	last    map[uint64]time.Time
+	running map[uint64]bool
+}
+
+func newCheckState() *checkState {
+	return &checkState{last: make(map[uint64]time.Time), running: make(map[uint64]bool)}
+}
+
+// begin - с какого времени искать статьи; false - подписка уже проверяется
+func (c *checkState) begin(id uint64, now time.Time) (time.Time, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.running[id] {
+		return time.Time{}, false
+	}
+	c.running[id] = true
+
+	since, ok := c.last[id]
+	if !ok {
+		since = now.Add(-firstCheckWindow)
+	}
+	return since, true
+}
+
+// end - после неудачной проверки время не сдвигается, следующая повторит тот же период
+func (c *checkState) end(id uint64, startedAt time.Time, success bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.running, id)
+	if success {
+		c.last[id] = startedAt
+	}
+}
+
+// forget - подписка удалена (в том числе вместе с пользователем); время проверки больше не нужно
+func (c *checkState) forget(id uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.last, id)
+}
+
+// CheckSubscription - ищет статьи с прошлой проверки подписки и отправляет уведомления;
+// возвращает число найденных статей
+func (ns *NotifyService) CheckSubscription(ctx context.Context, sub *pb.Subscription) (int, error) {
+	startedAt := time.Now()
+	since, ok := ns.checks.begin(sub.Id, startedAt)
+	if !ok {
+		return 0, ErrCheckInProgress
+	}
+
+	news, err := ns.CheckNewArticlesForSubscription(ctx, sub.UserId, sub.Keyword, since)
+	ns.checks.end(sub.Id, startedAt, err == nil)
+	return len(news), err
+}
+
+// TriggerCheck - внеплановая проверка подписок пользователя userID (0 - всех) по ключевому слову
+// keyword (пусто - по всем); подписки, которые уже проверяются, пропускаются
+func (ns *NotifyService) TriggerCheck(ctx context.Context, userID uint64, keyword string) (checked, found int, err error) {
+	subscriptions, err := ns.listSubscriptions(ctx, userID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, sub := range subscriptions {
+		if keyword != "" && !strings.EqualFold(sub.Keyword, keyword) {
+			continue
+		}
+
+		n, err := ns.CheckSubscription(ctx, sub)
+		if errors.Is(err, ErrCheckInProgress) {
+			continue
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "subscription check failed", "subscription_id", sub.Id, "user_id", sub.UserId, "keyword", sub.Keyword, logging.Err(err))
+			continue
+		}
+		checked++
+		found += n
+	}
+
+	slog.InfoContext(ctx, "triggered check completed", "user_id", userID, "keyword", keyword, "checked", checked, "found", found)
+	return checked, found, nil
+}
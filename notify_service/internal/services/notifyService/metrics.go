@@ -0,0 +1,39 @@
+package notifyService
+
+import (
+	"gonews/pkg/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	schedulerTickDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "notify",
+		Name:      "scheduler_tick_duration_seconds",
+		Help:      "Duration of a scheduler run over subscriptions and saved searches that were due.",
+		Buckets:   prometheus.ExponentialBuckets(0.1, 2, 12),
+	})
+
+	scheduledJobs = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "notify",
+		Name:      "scheduled_jobs",
+		Help:      "Subscriptions and saved searches in the scheduler queue by kind.",
+	}, []string{"kind"})
+
+	subscriptionChecks = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "notify",
+		Name:      "subscription_checks_total",
+		Help:      "Subscription keyword checks against the search service by outcome.",
+	}, []string{"outcome"})
+
+	newArticlesFound = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "notify",
+		Name:      "new_articles_found_total",
+		Help:      "New articles found for subscriptions.",
+	})
+)
@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 	"gonews/notify_service/internal/models"
+	"gonews/pkg/logging"
+	"gonews/pkg/metrics"
+	"gonews/pkg/tracing"
 	"gonews/protos/pb"
-	"log"
+	"log/slog"
 	"time"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 type SaveServiceClient interface {
@@ -22,6 +24,7 @@ type SearchServiceClient interface {
 
 type Producer interface {
 	SendNotification(ctx context.Context, userID uint64, keyword string, article models.News) error
+	ReplayDeadLetters(ctx context.Context, limit int) (int, error)
 	Close() error
 }
 
@@ -31,18 +34,19 @@ type NotifyService struct {
 	producer     Producer
 	saveConn     *grpc.ClientConn
 	searchConn   *grpc.ClientConn
-	lastCheck    map[string]time.Time // keyword -> last check time
+	checks       *checkState
 }
 
-func NewNotifyService(saveServiceAddr, searchServiceAddr string, producer Producer) (*NotifyService, error) {
+// NewNotifyService - transport - TLS или незашифрованное соединение до save и search service
+func NewNotifyService(saveServiceAddr, searchServiceAddr string, transport grpc.DialOption, producer Producer) (*NotifyService, error) {
 	// Подключаемся к save service
-	saveConn, err := grpc.Dial(saveServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	saveConn, err := grpc.Dial(saveServiceAddr, transport, tracing.DialOption(), logging.DialOption(), metrics.DialOption())
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to save service: %w", err)
 	}
 
 	// Подключаемся к search service
-	searchConn, err := grpc.Dial(searchServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	searchConn, err := grpc.Dial(searchServiceAddr, transport, tracing.DialOption(), logging.DialOption(), metrics.DialOption())
 	if err != nil {
 		saveConn.Close()
 		return nil, fmt.Errorf("failed to connect to search service: %w", err)
@@ -54,7 +58,7 @@ func NewNotifyService(saveServiceAddr, searchServiceAddr string, producer Produc
 		producer:     producer,
 		saveConn:     saveConn,
 		searchConn:   searchConn,
-		lastCheck:    make(map[string]time.Time),
+		checks:       newCheckState(),
 	}, nil
 }
 
@@ -70,31 +74,14 @@ func (ns *NotifyService) GetSearchClient() pb.SearchServiceClient {
 
 // CheckNewArticlesForAllSubscriptions - проверяет новые статьи для всех подписок
 func (ns *NotifyService) CheckNewArticlesForAllSubscriptions(ctx context.Context) error {
-	log.Println("Checking for new articles for all subscriptions...")
+	slog.DebugContext(ctx, "checking all subscriptions")
 
-	// Получаем все подписки
-	resp, err := ns.saveClient.GetSubscriptions(ctx, &pb.GetSubscriptionsRequest{})
+	checked, _, err := ns.TriggerCheck(ctx, 0, "")
 	if err != nil {
 		return fmt.Errorf("failed to get subscriptions: %w", err)
 	}
 
-	for _, sub := range resp.Subscriptions {
-		lastCheckTime := ns.lastCheck[sub.Keyword]
-		if lastCheckTime.IsZero() {
-			lastCheckTime = time.Now().Add(-24 * time.Hour) // Проверяем за последние 24 часа
-		}
-
-		err := ns.CheckNewArticlesForKeyword(ctx, sub.UserId, sub.Keyword, lastCheckTime)
-		if err != nil {
-			log.Printf("Error checking keyword '%s' for user %d: %v", sub.Keyword, sub.UserId, err)
-			continue
-		}
-
-		// Обновляем время последней проверки
-		ns.lastCheck[sub.Keyword] = time.Now()
-	}
-
-	log.Println("Subscription check completed")
+	slog.DebugContext(ctx, "subscription check completed", "subscriptions", checked)
 	return nil
 }
 
@@ -133,15 +120,40 @@ func (ns *NotifyService) Close() error {
 	return nil
 }
 
+// listSubscriptions - постранично выгружает подписки пользователя (0 - всех) из save service;
+// подписки всех пользователей отдаёт только внутренний ListAllSubscriptions
+func (ns *NotifyService) listSubscriptions(ctx context.Context, userID uint64) ([]*pb.Subscription, error) {
+	var subscriptions []*pb.Subscription
+	pageToken := ""
+	for {
+		var resp *pb.GetSubscriptionsResponse
+		var err error
+		if userID == 0 {
+			resp, err = ns.saveClient.ListAllSubscriptions(ctx, &pb.ListAllSubscriptionsRequest{PageToken: pageToken})
+		} else {
+			resp, err = ns.saveClient.GetSubscriptions(ctx, &pb.GetSubscriptionsRequest{UserId: userID, PageToken: pageToken})
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		subscriptions = append(subscriptions, resp.Subscriptions...)
+		if resp.NextPageToken == "" {
+			return subscriptions, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
 // GetSubscriptionsByKeyword - получает все подписки по ключевому слову
 func (ns *NotifyService) GetSubscriptionsByKeyword(ctx context.Context, keyword string) ([]*models.Subscription, error) {
-	resp, err := ns.saveClient.GetSubscriptions(ctx, &pb.GetSubscriptionsRequest{})
+	allSubscriptions, err := ns.listSubscriptions(ctx, 0)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get subscriptions: %w", err)
 	}
 
 	var subscriptions []*models.Subscription
-	for _, sub := range resp.Subscriptions {
+	for _, sub := range allSubscriptions {
 		if sub.Keyword == keyword {
 			subscriptions = append(subscriptions, &models.Subscription{
 				ID:      sub.Id,
@@ -156,16 +168,18 @@ func (ns *NotifyService) GetSubscriptionsByKeyword(ctx context.Context, keyword
 
 // CheckNewArticlesForSubscription - публичный метод для проверки новых статей
 func (ns *NotifyService) CheckNewArticlesForSubscription(ctx context.Context, userID uint64, keyword string, lastCheckTime time.Time) ([]*models.News, error) {
-	log.Printf("Checking new articles for user %d, keyword: %s, since: %v", userID, keyword, lastCheckTime)
+	slog.DebugContext(ctx, "checking new articles", "user_id", userID, "keyword", keyword, "since", lastCheckTime)
 
 	// Проверяем новые статьи через search service
 	resp, err := ns.searchClient.CheckNewArticles(ctx, &pb.CheckNewArticlesRequest{
 		Keyword:       keyword,
 		LastCheckTime: lastCheckTime.Format(time.RFC3339),
 	})
+	subscriptionChecks.WithLabelValues(metrics.Outcome(err)).Inc()
 	if err != nil {
 		return nil, fmt.Errorf("failed to check new articles: %w", err)
 	}
+	newArticlesFound.Add(float64(len(resp.NewArticles)))
 
 	// Конвертируем в модели
 	var news []*models.News
@@ -188,20 +202,49 @@ func (ns *NotifyService) CheckNewArticlesForSubscription(ctx context.Context, us
 		news = append(news, newsItem)
 
 		// Отправляем уведомление
-		err := ns.producer.SendNotification(ctx, userID, keyword, *newsItem)
+		err := ns.SendNotification(ctx, userID, keyword, *newsItem)
 		if err != nil {
-			log.Printf("Failed to send notification for article '%s': %v", article.Title, err)
+			slog.ErrorContext(ctx, "notification not sent", "user_id", userID, "keyword", keyword, "article", article.Title, logging.Err(err))
 			continue
 		}
 
-		log.Printf("Notification sent for keyword '%s' to user %d: %s", keyword, userID, article.Title)
+		slog.DebugContext(ctx, "notification sent", "user_id", userID, "keyword", keyword, "article", article.Title)
 	}
 
-	log.Printf("Found %d new articles for user %d, keyword: %s", len(news), userID, keyword)
+	slog.InfoContext(ctx, "new articles checked", "user_id", userID, "keyword", keyword, "found", len(news))
 	return news, nil
 }
 
-// SendNotification - отправляет уведомление
+// SendNotification - отправляет уведомление и сохраняет его в истории пользователя.
+// Ошибка сохранения только логируется: уведомление уже отправлено.
 func (ns *NotifyService) SendNotification(ctx context.Context, userID uint64, keyword string, article models.News) error {
-	return ns.producer.SendNotification(ctx, userID, keyword, article)
+	if err := ns.producer.SendNotification(ctx, userID, keyword, article); err != nil {
+		return err
+	}
+
+	_, err := ns.saveClient.RecordNotification(ctx, &pb.RecordNotificationRequest{
+		UserId:  userID,
+		Keyword: keyword,
+		Article: &pb.News{
+			Source:      article.Source,
+			Author:      article.Author,
+			Title:       article.Title,
+			Description: article.Description,
+			Url:         article.URL,
+			ImageUrl:    article.URLToImage,
+			PublishedAt: article.PublishedAt.Format(time.RFC3339),
+		},
+	})
+	if err != nil {
+		slog.WarnContext(ctx, "notification not recorded in history", "user_id", userID, logging.Err(err))
+	}
+
+	return nil
+}
+
+// ReplayDeadLetters - возвращает необработанные воркером сообщения в топик уведомлений
+func (ns *NotifyService) ReplayDeadLetters(ctx context.Context, limit int) (int, error) {
+	replayed, err := ns.producer.ReplayDeadLetters(ctx, limit)
+	slog.InfoContext(ctx, "dead letters replayed", "replayed", replayed)
+	return replayed, err
 }
@@ -0,0 +1,106 @@
+package notifyService
+
+import (
+	"context"
+	"fmt"
+	"gonews/notify_service/internal/models"
+	"gonews/pkg/logging"
+	"gonews/protos/pb"
+	"log/slog"
+	"time"
+)
+
+// listScheduledSavedSearches - постранично выгружает сохранённые поиски всех пользователей с расписанием
+func (ns *NotifyService) listScheduledSavedSearches(ctx context.Context) ([]*pb.SavedSearch, error) {
+	var savedSearches []*pb.SavedSearch
+	pageToken := ""
+	for {
+		resp, err := ns.saveClient.ListAllSavedSearches(ctx, &pb.ListAllSavedSearchesRequest{PageToken: pageToken})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list saved searches: %w", err)
+		}
+
+		for _, savedSearch := range resp.SavedSearches {
+			if savedSearch.RefreshIntervalMinutes > 0 {
+				savedSearches = append(savedSearches, savedSearch)
+			}
+		}
+
+		if resp.NextPageToken == "" {
+			return savedSearches, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+// refreshSavedSearch - повторяет поиск, запоминает результаты и уведомляет о новых статьях;
+// возвращает сохранённый поиск после запуска
+func (ns *NotifyService) refreshSavedSearch(ctx context.Context, savedSearch *pb.SavedSearch) (*pb.SavedSearch, error) {
+	req := &pb.SearchNewsRequest{
+		UserId:      savedSearch.UserId,
+		Query:       savedSearch.Query,
+		SkipHistory: true,
+	}
+	if f := savedSearch.Filters; f != nil {
+		req.Sources, req.Domains, req.From, req.To = f.Sources, f.Domains, f.From, f.To
+		req.Language, req.SortBy, req.PageSize, req.Page = f.Language, f.SortBy, f.PageSize, f.Page
+	}
+
+	searchResp, err := ns.searchClient.SearchNews(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search news: %w", err)
+	}
+
+	resultIDs := make([]uint64, 0, len(searchResp.News))
+	for _, article := range searchResp.News {
+		if article.Id != 0 {
+			resultIDs = append(resultIDs, article.Id)
+		}
+	}
+
+	runResp, err := ns.saveClient.RecordSavedSearchRun(ctx, &pb.RecordSavedSearchRunRequest{
+		Id:        savedSearch.Id,
+		ResultIds: resultIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to record saved search run: %w", err)
+	}
+
+	// Первый запуск задаёт базовый набор результатов, уведомлять не о чем
+	if !savedSearch.Notify || savedSearch.LastRunAt == "" {
+		return runResp.SavedSearch, nil
+	}
+
+	newIDs := make(map[uint64]bool, len(runResp.SavedSearch.NewResultIds))
+	for _, id := range runResp.SavedSearch.NewResultIds {
+		newIDs[id] = true
+	}
+
+	keyword := "saved_search:" + savedSearch.Name
+	for _, article := range searchResp.News {
+		if !newIDs[article.Id] {
+			continue
+		}
+
+		var publishedAt time.Time
+		if article.PublishedAt != "" {
+			publishedAt, _ = time.Parse(time.RFC3339, article.PublishedAt)
+		}
+
+		err := ns.SendNotification(ctx, savedSearch.UserId, keyword, models.News{
+			Source:      article.Source,
+			Author:      article.Author,
+			Title:       article.Title,
+			Description: article.Description,
+			URL:         article.Url,
+			URLToImage:  article.ImageUrl,
+			PublishedAt: publishedAt,
+		})
+		if err != nil {
+			slog.ErrorContext(ctx, "notification not sent", "saved_search_id", savedSearch.Id, "article", article.Title, logging.Err(err))
+		}
+	}
+
+	slog.InfoContext(ctx, "saved search refreshed", "saved_search_id", savedSearch.Id, "results", len(resultIDs), "new", len(newIDs))
+	return runResp.SavedSearch, nil
+}
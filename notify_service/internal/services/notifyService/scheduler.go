@@ -1,49 +1,369 @@
 package notifyService
 
 import (
+	"container/heap"
 	"context"
-	"log"
+	"errors"
+	"gonews/pkg/logging"
+	"gonews/pkg/schedule"
+	"gonews/pkg/tracing"
+	"gonews/protos/pb"
+	"log/slog"
+	"math/rand/v2"
+	"strconv"
 	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	jobSubscription = "subscription"
+	jobSavedSearch  = "saved_search"
 )
 
+// jobKey - у подписок и сохранённых поисков свои последовательности id
+type jobKey struct {
+	kind string
+	id   uint64
+}
+
+// job - подписка или сохранённый поиск в очереди планировщика
+type job struct {
+	key    jobKey
+	sub    *pb.Subscription
+	search *pb.SavedSearch
+	// spec - расписание, из которого построен schedule; по нему видно, что расписание поменялось
+	spec string
+	// schedule - nil только у подписок без своего расписания: общий интервал check_interval_minutes
+	schedule cron.Schedule
+	next     time.Time
+	// lastRun - когда планировщик последний раз запускал задачу; нулевое - ещё не запускал
+	lastRun time.Time
+	index   int
+}
+
+// jobQueue - очередь по времени следующего запуска (container/heap)
+type jobQueue []*job
+
+func (q jobQueue) Len() int           { return len(q) }
+func (q jobQueue) Less(i, j int) bool { return q[i].next.Before(q[j].next) }
+func (q jobQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *jobQueue) Push(x any) {
+	j := x.(*job)
+	j.index = len(*q)
+	*q = append(*q, j)
+}
+
+func (q *jobQueue) Pop() any {
+	old := *q
+	j := old[len(old)-1]
+	old[len(old)-1] = nil
+	*q = old[:len(old)-1]
+	return j
+}
+
+// Scheduler - одна очередь для подписок (по их расписанию cron или @every, иначе по общему интервалу)
+// и сохранённых поисков (по refresh_interval_minutes); состав очереди сверяется с save service
+// раз в syncInterval
 type Scheduler struct {
-	service *NotifyService
-	ticker  *time.Ticker
-	done    chan bool
+	service      *NotifyService
+	defaultEvery time.Duration
+	syncInterval time.Duration
+	jitter       time.Duration
+
+	queue jobQueue
+	jobs  map[jobKey]*job
+
+	// interval - новый общий интервал, применяется в цикле run
+	interval chan time.Duration
+	cancel   context.CancelFunc
+	stopped  chan struct{}
 }
 
-func NewScheduler(service *NotifyService, interval time.Duration) *Scheduler {
+func NewScheduler(service *NotifyService, interval, syncInterval, jitter time.Duration) *Scheduler {
 	return &Scheduler{
-		service: service,
-		ticker:  time.NewTicker(interval),
-		done:    make(chan bool),
+		service:      service,
+		defaultEvery: interval,
+		syncInterval: syncInterval,
+		jitter:       jitter,
+		jobs:         make(map[jobKey]*job),
+		interval:     make(chan time.Duration, 1),
+		stopped:      make(chan struct{}),
+	}
+}
+
+// SetInterval - меняет общий интервал проверок на работающем планировщике; подписки без
+// своего расписания проверяются через interval от прошлой проверки. Последний вызов побеждает,
+// если цикл ещё не успел применить прошлый.
+func (s *Scheduler) SetInterval(interval time.Duration) {
+	for {
+		select {
+		case s.interval <- interval:
+			return
+		default:
+			// в буфере устаревшее значение - заменяем его
+			select {
+			case <-s.interval:
+			default:
+			}
+		}
 	}
 }
 
+// Start - запускает планировщик в фоне; первые проверки разнесены на jitter после загрузки подписок
 func (s *Scheduler) Start(ctx context.Context) {
-	log.Printf("Starting scheduler")
+	slog.InfoContext(ctx, "starting scheduler", "interval", s.defaultEvery, "sync_interval", s.syncInterval, "jitter", s.jitter)
 
-	// Выполняем сразу при старте
-	s.service.CheckNewArticlesForAllSubscriptions(ctx)
+	ctx, s.cancel = context.WithCancel(ctx)
+	go s.run(ctx)
+}
 
-	go func() {
-		for {
-			select {
-			case <-s.ticker.C:
-				s.service.CheckNewArticlesForAllSubscriptions(ctx)
-			case <-s.done:
-				return
-			case <-ctx.Done():
-				s.Stop()
-				return
+func (s *Scheduler) run(ctx context.Context) {
+	defer close(s.stopped)
+
+	s.sync(ctx)
+
+	syncTicker := time.NewTicker(s.syncInterval)
+	defer syncTicker.Stop()
+	timer := time.NewTimer(s.untilNext())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			s.runDue(ctx)
+		case <-syncTicker.C:
+			s.sync(ctx)
+		case interval := <-s.interval:
+			s.setInterval(interval)
+			slog.InfoContext(ctx, "scheduler interval changed", "interval", interval)
+		case <-ctx.Done():
+			return
+		}
+		timer.Reset(s.untilNext())
+	}
+}
+
+// untilNext - время до ближайшего запуска; с пустой очередью ждём следующей синхронизации
+func (s *Scheduler) untilNext() time.Duration {
+	if len(s.queue) == 0 {
+		return s.syncInterval
+	}
+	return max(time.Until(s.queue[0].next), 0)
+}
+
+// sync - сверяет очередь с подписками и сохранёнными поисками в save service; если один
+// из списков не загрузился, его задачи остаются как были
+func (s *Scheduler) sync(ctx context.Context) {
+	ctx = logging.WithRequestID(ctx, logging.NewRequestID())
+	ctx, span := tracing.Tracer("gonews/notify_service/scheduler").Start(ctx, "scheduler sync")
+	defer span.End()
+
+	now := time.Now()
+
+	subscriptions, err := s.service.listSubscriptions(ctx, 0)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list subscriptions", logging.Err(err))
+	} else {
+		s.reconcile(jobSubscription, s.subscriptionJobs(ctx, subscriptions, now), now)
+	}
+
+	savedSearches, err := s.service.listScheduledSavedSearches(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list saved searches", logging.Err(err))
+	} else {
+		s.reconcile(jobSavedSearch, s.savedSearchJobs(savedSearches, now), now)
+	}
+}
+
+// subscriptionJobs - первая проверка новой подписки вскоре после появления или по её расписанию
+func (s *Scheduler) subscriptionJobs(ctx context.Context, subscriptions []*pb.Subscription, now time.Time) []*job {
+	jobs := make([]*job, 0, len(subscriptions))
+	for _, sub := range subscriptions {
+		sched, err := schedule.Parse(sub.Schedule)
+		if err != nil {
+			// save service проверяет расписание при сохранении; сюда попадают только старые записи
+			slog.WarnContext(ctx, "invalid subscription schedule, using default interval",
+				"subscription_id", sub.Id, "schedule", sub.Schedule, logging.Err(err))
+		}
+
+		j := &job{key: jobKey{jobSubscription, sub.Id}, sub: sub, spec: sub.Schedule, schedule: sched, next: now}
+		if sched != nil {
+			j.next = sched.Next(now)
+		}
+		j.next = j.next.Add(s.randomJitter())
+		jobs = append(jobs, j)
+	}
+	return jobs
+}
+
+// savedSearchJobs - поиск обновляется через refresh_interval_minutes после прошлого запуска;
+// не запускавшийся или просроченный - вскоре после загрузки
+func (s *Scheduler) savedSearchJobs(savedSearches []*pb.SavedSearch, now time.Time) []*job {
+	jobs := make([]*job, 0, len(savedSearches))
+	for _, savedSearch := range savedSearches {
+		every := time.Duration(savedSearch.RefreshIntervalMinutes) * time.Minute
+		j := &job{
+			key:      jobKey{jobSavedSearch, savedSearch.Id},
+			search:   savedSearch,
+			spec:     strconv.Itoa(int(savedSearch.RefreshIntervalMinutes)),
+			schedule: cron.Every(every),
+			next:     now,
+		}
+		if lastRun, err := time.Parse(time.RFC3339, savedSearch.LastRunAt); err == nil && lastRun.Add(every).After(now) {
+			j.next = lastRun.Add(every)
+		}
+		j.next = j.next.Add(s.randomJitter())
+		jobs = append(jobs, j)
+	}
+	return jobs
+}
+
+// reconcile - задачи вида kind приводятся к loaded: новые ставятся в очередь, исчезнувшие убираются,
+// при смене расписания время следующего запуска пересчитывается
+func (s *Scheduler) reconcile(kind string, loaded []*job, now time.Time) {
+	seen := make(map[jobKey]bool, len(loaded))
+	for _, fresh := range loaded {
+		seen[fresh.key] = true
+
+		j, ok := s.jobs[fresh.key]
+		if !ok {
+			s.jobs[fresh.key] = fresh
+			heap.Push(&s.queue, fresh)
+			continue
+		}
+
+		j.sub, j.search = fresh.sub, fresh.search
+		if j.spec != fresh.spec {
+			j.spec, j.schedule = fresh.spec, fresh.schedule
+			j.next = s.nextRun(j, now)
+			heap.Fix(&s.queue, j.index)
+		}
+	}
+
+	count := 0
+	for key, j := range s.jobs {
+		if key.kind != kind {
+			continue
+		}
+		if !seen[key] {
+			heap.Remove(&s.queue, j.index)
+			delete(s.jobs, key)
+			if kind == jobSubscription {
+				s.service.checks.forget(key.id)
 			}
+			continue
 		}
-	}()
+		count++
+	}
+
+	scheduledJobs.WithLabelValues(kind).Set(float64(count))
+}
+
+// runDue - запускает задачи, время которых подошло, и ставит их на следующий запуск
+func (s *Scheduler) runDue(ctx context.Context) {
+	now := time.Now()
+	if len(s.queue) == 0 || s.queue[0].next.After(now) {
+		return
+	}
+
+	ctx = logging.WithRequestID(ctx, logging.NewRequestID())
+	ctx, span := tracing.Tracer("gonews/notify_service/scheduler").Start(ctx, "scheduler run")
+	defer span.End()
+
+	timer := prometheus.NewTimer(schedulerTickDuration)
+	defer timer.ObserveDuration()
+
+	var due []*job
+	for len(s.queue) > 0 && !s.queue[0].next.After(now) {
+		due = append(due, heap.Pop(&s.queue).(*job))
+	}
+
+	for _, j := range due {
+		if ctx.Err() != nil {
+			break
+		}
+		j.lastRun = time.Now()
+		s.runJob(ctx, j)
+	}
+
+	now = time.Now()
+	for _, j := range due {
+		j.next = s.nextRun(j, now)
+		heap.Push(&s.queue, j)
+	}
+
+	slog.DebugContext(ctx, "scheduled jobs completed", "jobs", len(due))
+}
+
+func (s *Scheduler) runJob(ctx context.Context, j *job) {
+	switch j.key.kind {
+	case jobSubscription:
+		_, err := s.service.CheckSubscription(ctx, j.sub)
+		if err != nil && !errors.Is(err, ErrCheckInProgress) {
+			slog.ErrorContext(ctx, "subscription check failed", "subscription_id", j.sub.Id, "user_id", j.sub.UserId, "keyword", j.sub.Keyword, logging.Err(err))
+		}
+
+	case jobSavedSearch:
+		savedSearch, err := s.service.refreshSavedSearch(ctx, j.search)
+		if err != nil {
+			slog.ErrorContext(ctx, "saved search refresh failed", "saved_search_id", j.search.Id, "user_id", j.search.UserId, logging.Err(err))
+			return
+		}
+		// с last_run_at следующий запуск уже уведомляет о новых результатах, не дожидаясь синхронизации
+		j.search = savedSearch
+	}
+}
+
+// setInterval - подписки без своего расписания переносятся на новый интервал от прошлой проверки:
+// прошедшее время засчитывается, а просроченные при уменьшении интервала проверяются сразу.
+// Ещё не запускавшиеся задачи и так стоят на ближайший запуск.
+func (s *Scheduler) setInterval(interval time.Duration) {
+	if interval == s.defaultEvery {
+		return
+	}
+	s.defaultEvery = interval
+
+	now := time.Now()
+	for _, j := range s.queue {
+		if j.schedule != nil || j.lastRun.IsZero() {
+			continue
+		}
+		j.next = j.lastRun.Add(interval)
+		if j.next.Before(now) {
+			j.next = now
+		}
+		j.next = j.next.Add(s.randomJitter())
+	}
+	heap.Init(&s.queue)
+}
+
+func (s *Scheduler) nextRun(j *job, now time.Time) time.Time {
+	if j.schedule == nil {
+		return now.Add(s.defaultEvery + s.randomJitter())
+	}
+	return j.schedule.Next(now).Add(s.randomJitter())
+}
+
+// randomJitter - случайная задержка, чтобы задачи с одинаковым расписанием не шли в search service разом
+func (s *Scheduler) randomJitter() time.Duration {
+	if s.jitter <= 0 {
+		return 0
+	}
+	return rand.N(s.jitter)
 }
 
 func (s *Scheduler) Stop() {
-	log.Println("Stopping scheduler...")
-	s.ticker.Stop()
-	s.done <- true
+	slog.Info("stopping scheduler")
+	if s.cancel != nil {
+		s.cancel()
+		<-s.stopped
+	}
 	s.service.Close()
 }
@@ -0,0 +1,104 @@
+package notifyService
+
+import (
+	"container/heap"
+	"context"
+	"gonews/protos/pb"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestScheduler() *Scheduler {
+	return NewScheduler(&NotifyService{checks: newCheckState()}, time.Hour, time.Minute, 0)
+}
+
+func TestSchedulerReconcileSubscriptions(t *testing.T) {
+	s := newTestScheduler()
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 10, 30, 0, 0, time.UTC)
+	s.service.checks.last[3] = now
+
+	s.reconcile(jobSubscription, s.subscriptionJobs(ctx, []*pb.Subscription{
+		{Id: 1, Keyword: "go"},
+		{Id: 2, Keyword: "rust", Schedule: "0 12 * * *"},
+		{Id: 3, Keyword: "zig", Schedule: "not a schedule"},
+	}, now), now)
+
+	require.Len(t, s.queue, 3)
+	// без расписания - сразу, с cron - по расписанию
+	assert.Equal(t, now, s.jobs[jobKey{jobSubscription, 1}].next)
+	assert.Equal(t, time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC), s.jobs[jobKey{jobSubscription, 2}].next)
+	assert.Nil(t, s.jobs[jobKey{jobSubscription, 3}].schedule, "invalid schedule falls back to the default interval")
+
+	later := now.Add(time.Minute)
+	s.reconcile(jobSubscription, s.subscriptionJobs(ctx, []*pb.Subscription{
+		{Id: 1, Keyword: "go", Schedule: "@every 2h"},
+		{Id: 2, Keyword: "rust", Schedule: "0 12 * * *"},
+	}, later), later)
+
+	require.Len(t, s.queue, 2)
+	assert.NotContains(t, s.jobs, jobKey{jobSubscription, 3})
+	assert.NotContains(t, s.service.checks.last, uint64(3), "deleted subscription is forgotten")
+	assert.Equal(t, later.Add(2*time.Hour), s.jobs[jobKey{jobSubscription, 1}].next)
+	assert.Equal(t, uint64(2), s.queue[0].sub.Id)
+}
+
+func TestSchedulerReconcileSavedSearches(t *testing.T) {
+	s := newTestScheduler()
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 10, 30, 0, 0, time.UTC)
+
+	s.reconcile(jobSubscription, s.subscriptionJobs(ctx, []*pb.Subscription{{Id: 1, Keyword: "go"}}, now), now)
+	s.reconcile(jobSavedSearch, s.savedSearchJobs([]*pb.SavedSearch{
+		{Id: 1, RefreshIntervalMinutes: 15, LastRunAt: now.Add(-5 * time.Minute).Format(time.RFC3339)},
+		{Id: 2, RefreshIntervalMinutes: 60, LastRunAt: now.Add(-2 * time.Hour).Format(time.RFC3339)},
+		{Id: 3, RefreshIntervalMinutes: 5},
+	}, now), now)
+
+	require.Len(t, s.queue, 4)
+	// по своему интервалу от прошлого запуска, независимо от подписок и синхронизации
+	assert.Equal(t, now.Add(10*time.Minute), s.jobs[jobKey{jobSavedSearch, 1}].next)
+	assert.Equal(t, now, s.jobs[jobKey{jobSavedSearch, 2}].next, "overdue search runs right away")
+	assert.Equal(t, now, s.jobs[jobKey{jobSavedSearch, 3}].next, "never run search runs right away")
+
+	j := s.jobs[jobKey{jobSavedSearch, 3}]
+	assert.Equal(t, now.Add(5*time.Minute), s.nextRun(j, now))
+
+	// пустой список поисков не трогает подписки: id у видов задач свои
+	s.reconcile(jobSavedSearch, nil, now)
+	require.Len(t, s.queue, 1)
+	assert.Contains(t, s.jobs, jobKey{jobSubscription, 1})
+}
+
+func TestSchedulerSetInterval(t *testing.T) {
+	s := newTestScheduler()
+	now := time.Now()
+	s.reconcile(jobSubscription, s.subscriptionJobs(context.Background(), []*pb.Subscription{
+		{Id: 1, Keyword: "go"},
+		{Id: 2, Keyword: "rust", Schedule: "@every 10m"},
+	}, now), now)
+	cronNext := s.jobs[jobKey{jobSubscription, 2}].next
+
+	// проверена 3 минуты назад и стоит на общий интервал в час
+	checked := s.jobs[jobKey{jobSubscription, 1}]
+	checked.lastRun = now.Add(-3 * time.Minute)
+	checked.next = checked.lastRun.Add(time.Hour)
+	heap.Fix(&s.queue, checked.index)
+
+	s.setInterval(5 * time.Minute)
+
+	// прошедшие 3 минуты засчитываются, а не откладывают проверку на полный интервал
+	assert.Equal(t, now.Add(2*time.Minute), checked.next)
+	assert.Equal(t, cronNext, s.jobs[jobKey{jobSubscription, 2}].next)
+	assert.Equal(t, uint64(1), s.queue[0].sub.Id)
+
+	// повторная перезагрузка с тем же интервалом ничего не сдвигает
+	s.setInterval(5 * time.Minute)
+	assert.Equal(t, now.Add(2*time.Minute), checked.next)
+
+	s.setInterval(2 * time.Minute)
+	assert.WithinDuration(t, time.Now(), checked.next, time.Second, "overdue check runs right away")
+}
@@ -0,0 +1,152 @@
+// Package breaker - circuit breaker'ы исходящих вызовов gonews (NewsAPI, gRPC сервисы) на sony/gobreaker.
+// После max_failures неудач подряд breaker размыкается и open_timeout_seconds сразу отказывает,
+// не дожидаясь таймаутов зависимости; затем пропускает half_open_requests пробных вызовов.
+package breaker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/sony/gobreaker"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrOpen - вызов отклонён разомкнутым breaker-ом, зависимость не вызывалась
+var ErrOpen = errors.New("circuit breaker is open")
+
+// StateDisabled - состояние выключенного breaker-а
+const StateDisabled = "disabled"
+
+// Config - пороги breaker-ов сервиса
+type Config struct {
+	Enabled bool `yaml:"enabled" default:"true"`
+	// MaxFailures - неудач подряд до размыкания
+	MaxFailures uint32 `yaml:"max_failures" default:"5" validate:"min=1"`
+	// OpenTimeoutSeconds - сколько breaker разомкнут до пробных вызовов
+	OpenTimeoutSeconds int `yaml:"open_timeout_seconds" default:"30" validate:"min=1"`
+	// HalfOpenRequests - пробные вызовы; все успешны - breaker замыкается
+	HalfOpenRequests uint32 `yaml:"half_open_requests" default:"1" validate:"min=1"`
+}
+
+// Breaker - circuit breaker одной зависимости
+type Breaker struct {
+	name string
+	cb   *gobreaker.TwoStepCircuitBreaker
+	// isFailure - какие ошибки говорят о проблеме зависимости, а не запроса
+	isFailure func(error) bool
+}
+
+// New - breaker зависимости name; при выключенном конфиге вызовы проходят без проверок
+func New(name string, cfg Config, isFailure func(error) bool) *Breaker {
+	b := &Breaker{name: name, isFailure: isFailure}
+	if !cfg.Enabled {
+		return b
+	}
+
+	b.cb = gobreaker.NewTwoStepCircuitBreaker(gobreaker.Settings{
+		Name:        name,
+		MaxRequests: cfg.HalfOpenRequests,
+		Timeout:     time.Duration(cfg.OpenTimeoutSeconds) * time.Second,
+		ReadyToTrip: func(counts gobreaker.Counts) bool {
+			return counts.ConsecutiveFailures >= cfg.MaxFailures
+		},
+		OnStateChange: func(name string, from, to gobreaker.State) {
+			slog.Warn("Circuit breaker state changed", "breaker", name, "from", from.String(), "to", to.String())
+			observeState(name, to)
+		},
+	})
+	observeState(name, gobreaker.StateClosed)
+	return b
+}
+
+func (b *Breaker) Name() string {
+	return b.name
+}
+
+// State - closed, half-open, open или disabled
+func (b *Breaker) State() string {
+	if b.cb == nil {
+		return StateDisabled
+	}
+	return b.cb.State().String()
+}
+
+// Do - вызывает fn, если breaker пропускает; иначе сразу ErrOpen
+func (b *Breaker) Do(fn func() error) error {
+	if b.cb == nil {
+		return fn()
+	}
+
+	done, err := b.cb.Allow()
+	if err != nil {
+		rejected.WithLabelValues(b.name).Inc()
+		return fmt.Errorf("%s: %w", b.name, ErrOpen)
+	}
+
+	err = fn()
+	done(err == nil || !b.isFailure(err))
+	return err
+}
+
+// healthMethods - grpc.health.v1 идёт мимо breaker-а: readiness должна видеть сам сервис,
+// а пробы не должны занимать пробные вызовы half-open
+const healthMethods = "/grpc.health.v1.Health/"
+
+// DialOption - breaker на unary вызовы соединения, кроме health check; отказ breaker-а - codes.Unavailable
+func (b *Breaker) DialOption() grpc.DialOption {
+	return grpc.WithChainUnaryInterceptor(func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if strings.HasPrefix(method, healthMethods) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		err := b.Do(func() error {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		})
+		if errors.Is(err, ErrOpen) {
+			return status.Error(codes.Unavailable, err.Error())
+		}
+		return err
+	})
+}
+
+// GRPCFailure - сервис недоступен или не ответил вовремя. Internal и Unknown не считаются:
+// сервисы возвращают их и на ошибки отдельного запроса, один плохой запрос не должен отключать всех.
+// Не считаются и ResourceExhausted, и ошибки внешней зависимости сервиса (UpstreamError):
+// квота или отказ провайдера - не отказ самого сервиса
+func GRPCFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return !isUpstream(err)
+	}
+	return false
+}
+
+// upstreamDomain - ErrorInfo.Domain ошибок, которые пришли от внешней зависимости вызванного сервиса
+const upstreamDomain = "upstream.gonews"
+
+// UpstreamError - статус ошибки внешней зависимости (например, провайдера новостей) с пометкой
+// в ErrorInfo, чтобы breaker вызывающей стороны отличал её от отказа самого сервиса
+func UpstreamError(code codes.Code, reason, msg string) error {
+	st := status.New(code, msg)
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{Reason: reason, Domain: upstreamDomain})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+func isUpstream(err error) bool {
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.Domain == upstreamDomain {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+package breaker
+
+import (
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gotest.tools/v3/assert"
+)
+
+var errDown = errors.New("dependency is down")
+
+func TestBreakerOpensAfterConsecutiveFailures(t *testing.T) {
+	b := New("test", Config{Enabled: true, MaxFailures: 2, OpenTimeoutSeconds: 60, HalfOpenRequests: 1},
+		func(err error) bool { return errors.Is(err, errDown) })
+
+	// ошибки запроса breaker не размыкают
+	for range 3 {
+		assert.Error(t, b.Do(func() error { return errors.New("bad request") }), "bad request")
+	}
+	assert.Equal(t, "closed", b.State())
+
+	for range 2 {
+		assert.ErrorIs(t, b.Do(func() error { return errDown }), errDown)
+	}
+	assert.Equal(t, "open", b.State())
+
+	called := false
+	err := b.Do(func() error { called = true; return nil })
+	assert.ErrorIs(t, err, ErrOpen)
+	assert.Assert(t, !called)
+}
+
+func TestDisabledBreakerPassesThrough(t *testing.T) {
+	b := New("test", Config{Enabled: false}, func(error) bool { return true })
+
+	for range 10 {
+		assert.ErrorIs(t, b.Do(func() error { return errDown }), errDown)
+	}
+	assert.Equal(t, StateDisabled, b.State())
+}
+
+func TestGRPCFailure(t *testing.T) {
+	for _, code := range []codes.Code{codes.Unavailable, codes.DeadlineExceeded} {
+		assert.Assert(t, GRPCFailure(status.Error(code, "down")), code)
+		// отказ провайдера за сервисом - не отказ сервиса
+		assert.Assert(t, !GRPCFailure(UpstreamError(code, "PROVIDER_UNAVAILABLE", "provider down")), code)
+	}
+	// ошибки отдельного запроса, в том числе Internal от хранилища, и исчерпанная квота
+	for _, code := range []codes.Code{codes.Internal, codes.Unknown, codes.InvalidArgument, codes.NotFound, codes.ResourceExhausted} {
+		assert.Assert(t, !GRPCFailure(status.Error(code, "bad request")), code)
+	}
+}
@@ -0,0 +1,36 @@
+package breaker
+
+import (
+	"gonews/pkg/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sony/gobreaker"
+)
+
+var (
+	state = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "breaker",
+		Name:      "state",
+		Help:      "Circuit breaker state by breaker: 0 closed, 1 half-open, 2 open.",
+	}, []string{"breaker"})
+
+	rejected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "breaker",
+		Name:      "rejected_total",
+		Help:      "Calls failed fast by an open circuit breaker, without calling the dependency.",
+	}, []string{"breaker"})
+)
+
+func observeState(name string, s gobreaker.State) {
+	var value float64
+	switch s {
+	case gobreaker.StateHalfOpen:
+		value = 1
+	case gobreaker.StateOpen:
+		value = 2
+	}
+	state.WithLabelValues(name).Set(value)
+}
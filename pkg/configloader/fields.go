@@ -0,0 +1,143 @@
+package configloader
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// field - поле конфига: путь из yaml имён через точку (redis.port) и значение, которое можно менять
+type field struct {
+	path  string
+	value reflect.Value
+	tag   reflect.StructTag
+	// leaf - не структура: значение задаётся строкой из default, env или флага
+	leaf bool
+}
+
+// walk - обходит конфиг в глубину: сначала поля структуры, потом саму структуру.
+// Записи map со структурами обходятся по существующим ключам (rate_limit.groups.search.burst).
+func walk(v reflect.Value, path string, tag reflect.StructTag, visit func(field) error) error {
+	switch {
+	case v.Kind() == reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			name := yamlName(sf)
+			if name == "" {
+				continue
+			}
+			if err := walk(v.Field(i), join(path, name), sf.Tag, visit); err != nil {
+				return err
+			}
+		}
+		return visit(field{path: path, value: v, tag: tag})
+
+	case v.Kind() == reflect.Map && v.Type().Key().Kind() == reflect.String && v.Type().Elem().Kind() == reflect.Struct:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+		for _, key := range keys {
+			// значения map неадресуемы: меняем копию и кладём обратно, только если она изменилась,
+			// чтобы проверка и Redact не писали в map, которую читает сервис
+			original := v.MapIndex(key)
+			entry := reflect.New(v.Type().Elem()).Elem()
+			entry.Set(original)
+			if err := walk(entry, join(path, key.String()), "", visit); err != nil {
+				return err
+			}
+			if !reflect.DeepEqual(entry.Interface(), original.Interface()) {
+				v.SetMapIndex(key, entry)
+			}
+		}
+		return nil
+
+	case v.Kind() == reflect.Map || v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface:
+		return nil
+	}
+
+	return visit(field{path: path, value: v, tag: tag, leaf: true})
+}
+
+// yamlName - имя поля так же, как его видит yaml.v3; пусто - поле пропускается
+func yamlName(sf reflect.StructField) string {
+	if !sf.IsExported() {
+		return ""
+	}
+	name, _, _ := strings.Cut(sf.Tag.Get("yaml"), ",")
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return strings.ToLower(sf.Name)
+	}
+	return name
+}
+
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// envName - переменная окружения поля: тег env или GONEWS_ и путь в верхнем регистре
+func envName(f field) string {
+	if name := f.tag.Get("env"); name != "" {
+		return name
+	}
+	return envPrefix + strings.ToUpper(strings.NewReplacer(".", "_", "-", "_").Replace(f.path))
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// set - значение поля из строки; списки строк - через запятую
+func set(v reflect.Value, s string) error {
+	switch {
+	case v.Type() == durationType:
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+	case v.Kind() == reflect.String:
+		v.SetString(s)
+	case v.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case v.CanInt():
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case v.CanUint():
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case v.CanFloat():
+		n, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(n)
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.String:
+		var items []string
+		for _, item := range strings.Split(s, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		v.Set(reflect.ValueOf(items).Convert(v.Type()))
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+// Package configloader - общая загрузка конфигов сервисов gonews. Значения накладываются по порядку:
+// тег default, YAML файл, переменные окружения, флаги командной строки; затем конфиг проверяется.
+//
+// Теги полей:
+//
+//	default:"8080"             - значение по умолчанию
+//	env:"NEWS_API_KEY"         - имя переменной вместо GONEWS_<ПУТЬ>, например GONEWS_REDIS_PORT
+//	validate:"required,port"   - правила проверки, см. validate.go
+//	secret:"true"              - значение скрывается в Redact
+//	reload:"true"              - Watch применяет изменение без перезапуска
+//
+// Любое поле можно прочитать из файла через <ПЕРЕМЕННАЯ>_FILE (Docker secrets),
+// флаг поля - его путь: -redis.port=6380.
+package configloader
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// envPrefix - префикс переменных окружения полей без тега env
+const envPrefix = "GONEWS_"
+
+// pathEnv - переменная с путём к YAML, её задаёт docker-compose.yaml
+const pathEnv = "configPath"
+
+// Options - откуда читать конфиг
+type Options struct {
+	// DefaultPath - YAML, если путь не задан ни флагом -config, ни переменной configPath.
+	// Отсутствие файла по умолчанию - не ошибка: всё может прийти из окружения.
+	DefaultPath string
+	// Args - аргументы командной строки без имени программы
+	Args []string
+}
+
+// Load - заполняет cfg (указатель на структуру) и проверяет его
+func Load(cfg any, opts Options) error {
+	root := reflect.ValueOf(cfg)
+	if root.Kind() != reflect.Pointer || root.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("config: expected pointer to struct, got %T", cfg)
+	}
+	root = root.Elem()
+
+	fs, flagPath, flags := newFlagSet(root, opts)
+	// -h печатает все флаги и завершает процесс, как у flag.CommandLine
+	_ = fs.Parse(opts.Args)
+
+	if err := walk(root, "", "", applyDefault); err != nil {
+		return err
+	}
+	path, explicit := resolvePath(*flagPath, opts)
+	if err := loadYAML(cfg, path, explicit); err != nil {
+		return err
+	}
+	if err := walk(root, "", "", applyEnv); err != nil {
+		return err
+	}
+	if err := walk(root, "", "", func(f field) error { return applyFlag(f, flags) }); err != nil {
+		return err
+	}
+
+	return Validate(cfg)
+}
+
+// newFlagSet - флаг -config и флаг на каждое поле. Набор полей берётся из defaults:
+// записи map, которых ещё нет (появятся из YAML), флагами не задаются.
+func newFlagSet(root reflect.Value, opts Options) (*flag.FlagSet, *string, map[string]string) {
+	name := "app"
+	if len(os.Args) > 0 {
+		name = os.Args[0]
+	}
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+
+	path := fs.String("config", "", fmt.Sprintf("path to YAML config (env %s, default %s)", pathEnv, opts.DefaultPath))
+
+	flags := make(map[string]string)
+	_ = walk(root, "", "", func(f field) error {
+		if !f.leaf {
+			return nil
+		}
+		fs.Func(f.path, "env "+envName(f), func(s string) error {
+			if err := set(reflect.New(f.value.Type()).Elem(), s); err != nil {
+				return err
+			}
+			flags[f.path] = s
+			return nil
+		})
+		return nil
+	})
+
+	return fs, path, flags
+}
+
+// resolvePath - путь к YAML: флаг -config, переменная configPath или DefaultPath.
+// explicit - путь задан явно, и отсутствие файла - ошибка.
+func resolvePath(flagPath string, opts Options) (path string, explicit bool) {
+	if flagPath != "" {
+		return flagPath, true
+	}
+	if path := os.Getenv(pathEnv); path != "" {
+		return path, true
+	}
+	return opts.DefaultPath, false
+}
+
+func loadYAML(cfg any, path string, explicit bool) error {
+	if path == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) && !explicit {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return fmt.Errorf("failed to unmarshal yaml: %w", err)
+	}
+	return nil
+}
+
+func applyDefault(f field) error {
+	def, ok := f.tag.Lookup("default")
+	if !ok || !f.leaf {
+		return nil
+	}
+	if err := set(f.value, def); err != nil {
+		return fmt.Errorf("config: %s: bad default %q: %w", f.path, def, err)
+	}
+	return nil
+}
+
+// applyEnv - значение из переменной или из файла, путь к которому в <ПЕРЕМЕННАЯ>_FILE
+func applyEnv(f field) error {
+	if !f.leaf {
+		return nil
+	}
+
+	name := envName(f)
+	value, ok := os.LookupEnv(name)
+
+	if file, fromFile := os.LookupEnv(name + "_FILE"); fromFile {
+		if ok {
+			return fmt.Errorf("config: both %s and %s_FILE are set", name, name)
+		}
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("config: %s_FILE: %w", name, err)
+		}
+		value, ok = strings.TrimSpace(string(data)), true
+	}
+
+	if !ok {
+		return nil
+	}
+	if err := set(f.value, value); err != nil {
+		return fmt.Errorf("config: %s: %w", name, err)
+	}
+	return nil
+}
+
+func applyFlag(f field, flags map[string]string) error {
+	value, ok := flags[f.path]
+	if !ok || !f.leaf {
+		return nil
+	}
+	return set(f.value, value)
+}
@@ -0,0 +1,146 @@
+package configloader
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+type serverConfig struct {
+	Host string `yaml:"host" default:"localhost" validate:"required"`
+	Port int    `yaml:"port" default:"8080" validate:"port"`
+}
+
+type limitConfig struct {
+	Burst int `yaml:"burst" validate:"min=1"`
+}
+
+type testConfig struct {
+	Server serverConfig           `yaml:"server"`
+	Level  string                 `yaml:"level" default:"info" validate:"oneof=debug info"`
+	APIKey string                 `yaml:"api_key" env:"TEST_API_KEY" secret:"true"`
+	Limits map[string]limitConfig `yaml:"limits"`
+	Window windowConfig           `yaml:"window"`
+}
+
+type windowConfig struct {
+	Min int `yaml:"min"`
+	Max int `yaml:"max"`
+}
+
+func (c windowConfig) Validate() error {
+	if c.Min > c.Max {
+		return errors.New("min: must not exceed max")
+	}
+	return nil
+}
+
+func writeYAML(t *testing.T, data string) string {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	assert.NilError(t, os.WriteFile(path, []byte(data), 0o600))
+	return path
+}
+
+func TestLoadLayersDefaultsYAMLEnvAndFlags(t *testing.T) {
+	path := writeYAML(t, `
+server:
+  port: 9000
+level: debug
+limits:
+  search:
+    burst: 10
+`)
+	keyFile := filepath.Join(t.TempDir(), "api_key")
+	assert.NilError(t, os.WriteFile(keyFile, []byte("s3cret\n"), 0o600))
+
+	t.Setenv("configPath", path)
+	t.Setenv("GONEWS_SERVER_HOST", "from-env")
+	t.Setenv("GONEWS_SERVER_PORT", "9100")
+	t.Setenv("GONEWS_LIMITS_SEARCH_BURST", "5")
+	t.Setenv("TEST_API_KEY_FILE", keyFile)
+
+	var cfg testConfig
+	assert.NilError(t, Load(&cfg, Options{Args: []string{"-server.port=9200"}}))
+
+	assert.Equal(t, "from-env", cfg.Server.Host) // env поверх default
+	assert.Equal(t, 9200, cfg.Server.Port)       // флаг поверх env и YAML
+	assert.Equal(t, "debug", cfg.Level)          // YAML поверх default
+	assert.Equal(t, "s3cret", cfg.APIKey)        // секрет из файла
+	assert.Equal(t, 5, cfg.Limits["search"].Burst)
+
+	redacted := Redact(&cfg)
+	assert.Equal(t, "***", redacted["api_key"])
+	assert.Equal(t, 9200, redacted["server"].(map[string]any)["port"])
+}
+
+func TestLoadReportsAllValidationErrors(t *testing.T) {
+	path := writeYAML(t, `
+server:
+  host: ""
+  port: 70000
+level: trace
+limits:
+  search:
+    burst: 0
+window:
+  min: 5
+  max: 1
+`)
+
+	var cfg testConfig
+	err := Load(&cfg, Options{Args: []string{"-config", path}})
+	assert.Error(t, err, `invalid config:
+server.host: is required
+server.port: must be a port between 1 and 65535, got 70000
+level: must be one of debug, info, got "trace"
+limits.search.burst: must be at least 1, got 0
+window.min: must not exceed max`)
+}
+
+func TestLoadRejectsValueAndFileTogether(t *testing.T) {
+	t.Setenv("TEST_API_KEY", "a")
+	t.Setenv("TEST_API_KEY_FILE", "/run/secrets/api_key")
+
+	var cfg testConfig
+	err := Load(&cfg, Options{DefaultPath: filepath.Join(t.TempDir(), "missing.yaml")})
+	assert.ErrorContains(t, err, "both TEST_API_KEY and TEST_API_KEY_FILE are set")
+}
+
+type reloadConfig struct {
+	Port  int    `yaml:"port" default:"8080"`
+	Level string `yaml:"level" default:"info" reload:"true"`
+}
+
+func TestWatchAppliesOnlyReloadableFields(t *testing.T) {
+	defer func(interval time.Duration) { pollInterval = interval }(pollInterval)
+	pollInterval = 10 * time.Millisecond
+
+	path := writeYAML(t, "port: 8080\nlevel: info\n")
+	opts := Options{Args: []string{"-config", path}}
+
+	var cfg reloadConfig
+	assert.NilError(t, Load(&cfg, opts))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	applied := make(chan *reloadConfig, 1)
+	Watch(ctx, &cfg, opts, func(next *reloadConfig) { applied <- next })
+
+	// время файла сдвигаем явно: запись в ту же секунду может не поменять mtime
+	assert.NilError(t, os.WriteFile(path, []byte("port: 9090\nlevel: debug\n"), 0o600))
+	later := time.Now().Add(time.Minute)
+	assert.NilError(t, os.Chtimes(path, later, later))
+
+	select {
+	case next := <-applied:
+		assert.Equal(t, "debug", next.Level)
+		assert.Equal(t, 8080, next.Port) // порт меняется только с перезапуском
+	case <-time.After(5 * time.Second):
+		t.Fatal("config was not reloaded")
+	}
+}
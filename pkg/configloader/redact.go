@@ -0,0 +1,45 @@
+package configloader
+
+import (
+	"reflect"
+	"strings"
+)
+
+// redacted - чем заменяются непустые секреты
+const redacted = "***"
+
+// Redact - итоговый конфиг для лога при старте: вложенные map по yaml именам, секреты скрыты
+func Redact(cfg any) map[string]any {
+	out := make(map[string]any)
+
+	v := reflect.ValueOf(cfg)
+	if v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+
+	_ = walk(v, "", "", func(f field) error {
+		if !f.leaf {
+			return nil
+		}
+
+		value := f.value.Interface()
+		if f.tag.Get("secret") == "true" && !f.value.IsZero() {
+			value = redacted
+		}
+
+		node := out
+		parts := strings.Split(f.path, ".")
+		for _, part := range parts[:len(parts)-1] {
+			child, ok := node[part].(map[string]any)
+			if !ok {
+				child = make(map[string]any)
+				node[part] = child
+			}
+			node = child
+		}
+		node[parts[len(parts)-1]] = value
+		return nil
+	})
+
+	return out
+}
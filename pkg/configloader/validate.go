@@ -0,0 +1,113 @@
+package configloader
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Validator - проверки, которые не выразить тегами (одно поле зависит от другого).
+// Ошибки дополняются путём структуры в конфиге.
+type Validator interface {
+	Validate() error
+}
+
+// Validate - проверяет теги validate и методы Validate всех вложенных структур, возвращает все ошибки сразу.
+//
+// Правила тега validate через запятую:
+//
+//	required        - не нулевое значение
+//	port            - от 1 до 65535
+//	min=N, max=N    - границы числа
+//	oneof=a b c     - одно из значений через пробел
+func Validate(cfg any) error {
+	var errs []error
+	_ = walk(reflect.ValueOf(cfg).Elem(), "", "", func(f field) error {
+		if !f.leaf {
+			if v, ok := f.value.Addr().Interface().(Validator); ok {
+				if err := v.Validate(); err != nil {
+					errs = append(errs, prefixed(f.path, err))
+				}
+			}
+			return nil
+		}
+
+		rules := f.tag.Get("validate")
+		if rules == "" {
+			return nil
+		}
+		for _, rule := range strings.Split(rules, ",") {
+			if err := check(f.value, rule); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", f.path, err))
+			}
+		}
+		return nil
+	})
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid config:\n%w", errors.Join(errs...))
+	}
+	return nil
+}
+
+func prefixed(path string, err error) error {
+	if path == "" {
+		return err
+	}
+	return fmt.Errorf("%s.%w", path, err)
+}
+
+func check(v reflect.Value, rule string) error {
+	name, arg, _ := strings.Cut(rule, "=")
+
+	switch name {
+	case "required":
+		if v.IsZero() {
+			return errors.New("is required")
+		}
+	case "port":
+		if n, ok := number(v); ok && (n < 1 || n > 65535) {
+			return fmt.Errorf("must be a port between 1 and 65535, got %v", n)
+		}
+	case "min", "max":
+		limit, err := strconv.ParseFloat(arg, 64)
+		if err != nil {
+			return fmt.Errorf("bad rule %q", rule)
+		}
+		n, ok := number(v)
+		if !ok {
+			return fmt.Errorf("rule %q needs a number", rule)
+		}
+		if name == "min" && n < limit {
+			return fmt.Errorf("must be at least %v, got %v", limit, n)
+		}
+		if name == "max" && n > limit {
+			return fmt.Errorf("must be at most %v, got %v", limit, n)
+		}
+	case "oneof":
+		allowed := strings.Fields(arg)
+		for _, a := range allowed {
+			if v.String() == a {
+				return nil
+			}
+		}
+		return fmt.Errorf("must be one of %s, got %q", strings.Join(allowed, ", "), v.String())
+	default:
+		return fmt.Errorf("unknown rule %q", rule)
+	}
+	return nil
+}
+
+func number(v reflect.Value) (float64, bool) {
+	switch {
+	case v.CanInt():
+		return float64(v.Int()), true
+	case v.CanUint():
+		return float64(v.Uint()), true
+	case v.CanFloat():
+		return v.Float(), true
+	}
+	return 0, false
+}
@@ -0,0 +1,122 @@
+package configloader
+
+import (
+	"context"
+	"gonews/pkg/logging"
+	"log/slog"
+	"os"
+	"os/signal"
+	"reflect"
+	"syscall"
+	"time"
+)
+
+// pollInterval - как часто сверяем время изменения YAML файла
+var pollInterval = 5 * time.Second
+
+// Watch - перечитывает конфиг по SIGHUP и при изменении YAML файла, пока не отменён ctx.
+// В apply приходит новый конфиг, где изменились только поля с тегом reload:"true": остальные
+// изменения требуют перезапуска, поэтому остаются прежними с предупреждением в логе.
+// Конфиг с ошибками не применяется совсем.
+func Watch[T any](ctx context.Context, current *T, opts Options, apply func(*T)) {
+	fs, flagPath, _ := newFlagSet(reflect.ValueOf(current).Elem(), opts)
+	_ = fs.Parse(opts.Args)
+	path, _ := resolvePath(*flagPath, opts)
+
+	modTime := fileModTime(path)
+
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+
+	ticker := time.NewTicker(pollInterval)
+
+	go func() {
+		defer signal.Stop(hup)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-hup:
+				slog.Info("Config reload requested by SIGHUP")
+			case <-ticker.C:
+				m := fileModTime(path)
+				if m.Equal(modTime) {
+					continue
+				}
+				modTime = m
+				slog.Info("Config file changed, reloading", "path", path)
+			}
+
+			if next := reload(current, opts); next != nil {
+				current = next
+				apply(next)
+			}
+		}
+	}()
+}
+
+// reload - новый конфиг или nil, если он не загрузился или применять нечего
+func reload[T any](current *T, opts Options) *T {
+	next := new(T)
+	if err := Load(next, opts); err != nil {
+		slog.Warn("Config reload failed, keeping current config", logging.Err(err))
+		return nil
+	}
+
+	changed := keepRestartOnly(current, next)
+	if len(changed) == 0 {
+		slog.Info("Config reloaded, nothing to apply")
+		return nil
+	}
+
+	slog.Info("Config reloaded", "changed", changed)
+	return next
+}
+
+// keepRestartOnly - возвращает в next текущие значения полей без reload:"true"
+// и отдаёт пути изменившихся полей, которые можно применить на лету
+func keepRestartOnly(current, next any) []string {
+	old := make(map[string]reflect.Value)
+	_ = walk(reflect.ValueOf(current).Elem(), "", "", func(f field) error {
+		if f.leaf {
+			old[f.path] = f.value
+		}
+		return nil
+	})
+
+	var changed []string
+	_ = walk(reflect.ValueOf(next).Elem(), "", "", func(f field) error {
+		if !f.leaf {
+			return nil
+		}
+		prev, existed := old[f.path]
+		if existed && reflect.DeepEqual(prev.Interface(), f.value.Interface()) {
+			return nil
+		}
+
+		if f.tag.Get("reload") != "true" {
+			slog.Warn("Config change requires restart, keeping current value", "field", f.path)
+			if existed {
+				f.value.Set(prev)
+			} else {
+				f.value.Set(reflect.Zero(f.value.Type()))
+			}
+			return nil
+		}
+
+		changed = append(changed, f.path)
+		return nil
+	})
+
+	return changed
+}
+
+func fileModTime(path string) time.Time {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
@@ -0,0 +1,61 @@
+// Package grpcserver - общий gRPC сервер сервисов gonews: TLS, трейсы, request_id, лог вызовов,
+// восстановление после паники, дедлайн по умолчанию, метрики и проверка запросов по правилам
+// (buf.validate) из news_service.proto.
+package grpcserver
+
+import (
+	"fmt"
+	"gonews/pkg/logging"
+	"gonews/pkg/metrics"
+	"gonews/pkg/tlsconfig"
+	"gonews/pkg/tracing"
+	"time"
+
+	"buf.build/go/protovalidate"
+	"google.golang.org/grpc"
+)
+
+// defaultDeadline - дедлайн вызова, если ни клиент, ни конфиг его не задали
+const defaultDeadline = 30 * time.Second
+
+// Config - настройки цепочки перехватчиков
+type Config struct {
+	// DefaultDeadline - дедлайн вызовов, пришедших без него; 0 - defaultDeadline
+	DefaultDeadline time.Duration
+	// TLS - сертификат сервера и mTLS; выключен - соединения без шифрования
+	TLS tlsconfig.Config
+}
+
+// New - gRPC сервер с общей цепочкой перехватчиков. Порядок важен: request_id нужен логу,
+// лог видит код ответа после восстановления паники, правила проверяются уже под дедлайном и в метриках.
+func New(cfg Config, opts ...grpc.ServerOption) (*grpc.Server, error) {
+	validator, err := protovalidate.New()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request validator: %w", err)
+	}
+
+	creds, err := tlsconfig.ServerOption(cfg.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := cfg.DefaultDeadline
+	if deadline <= 0 {
+		deadline = defaultDeadline
+	}
+
+	serverOpts := []grpc.ServerOption{
+		creds,
+		tracing.ServerOption(),
+		logging.ServerOption(),
+		grpc.ChainUnaryInterceptor(
+			logCall,
+			recoverPanic(),
+			withDefaultDeadline(deadline),
+		),
+		metrics.ServerOption(),
+		grpc.ChainUnaryInterceptor(validate(validator)),
+	}
+
+	return grpc.NewServer(append(serverOpts, opts...)...), nil
+}
@@ -0,0 +1,69 @@
+package grpcserver
+
+import (
+	"context"
+	"gonews/protos/pb"
+	"testing"
+	"time"
+
+	"buf.build/go/protovalidate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gotest.tools/v3/assert"
+)
+
+var info = &grpc.UnaryServerInfo{FullMethod: "/news.SaveService/Subscribe"}
+
+func ok(context.Context, any) (any, error) { return "ok", nil }
+
+func TestValidateRejectsRequestsBreakingProtoRules(t *testing.T) {
+	validator, err := protovalidate.New()
+	assert.NilError(t, err)
+	interceptor := validate(validator)
+
+	_, err = interceptor(context.Background(), &pb.SubscribeRequest{}, info, ok)
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, "user_id: value is required; keyword: value is required", st.Message())
+
+	assert.Equal(t, 1, len(st.Details()))
+	badRequest := st.Details()[0].(*errdetails.BadRequest)
+	assert.Equal(t, "user_id", badRequest.FieldViolations[0].Field)
+
+	// правило уровня сообщения: ровно один из user_id и handle
+	_, err = interceptor(context.Background(), &pb.GetUserRequest{UserId: 1, Handle: "alice"}, info, ok)
+	assert.Equal(t, "exactly one of user_id and handle is required", status.Convert(err).Message())
+
+	resp, err := interceptor(context.Background(), &pb.SubscribeRequest{UserId: 1, Keyword: "go"}, info, ok)
+	assert.NilError(t, err)
+	assert.Equal(t, "ok", resp)
+}
+
+func TestRecoverPanicReturnsInternal(t *testing.T) {
+	_, err := recoverPanic()(context.Background(), nil, info, func(context.Context, any) (any, error) {
+		panic("nil map")
+	})
+
+	st := status.Convert(err)
+	assert.Equal(t, codes.Internal, st.Code())
+	assert.Equal(t, "internal error", st.Message())
+}
+
+func TestDefaultDeadlineKeepsClientDeadline(t *testing.T) {
+	interceptor := withDefaultDeadline(time.Second)
+	remaining := func(ctx context.Context, _ any) (any, error) {
+		deadline, ok := ctx.Deadline()
+		assert.Assert(t, ok)
+		return time.Until(deadline), nil
+	}
+
+	got, _ := interceptor(context.Background(), nil, info, remaining)
+	assert.Assert(t, got.(time.Duration) <= time.Second)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	got, _ = interceptor(ctx, nil, info, remaining)
+	assert.Assert(t, got.(time.Duration) > time.Second)
+}
@@ -0,0 +1,126 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"gonews/pkg/logging"
+	"log/slog"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"buf.build/go/protovalidate"
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// healthPrefix - пробы health check пишем только в debug
+const healthPrefix = "/grpc.health.v1.Health/"
+
+// logCall - одна запись на вызов: метод, код ответа и длительность
+func logCall(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+
+	code := status.Code(err)
+	attrs := []slog.Attr{
+		slog.String("method", info.FullMethod),
+		slog.String("code", code.String()),
+		slog.Int64("duration_ms", time.Since(start).Milliseconds()),
+	}
+	if err != nil {
+		attrs = append(attrs, logging.Err(err))
+	}
+	slog.LogAttrs(ctx, callLevel(info.FullMethod, code), "grpc call", attrs...)
+
+	return resp, err
+}
+
+// callLevel - ошибки клиента - warn, ошибки сервера - error
+func callLevel(method string, code codes.Code) slog.Level {
+	switch code {
+	case codes.OK:
+		if strings.HasPrefix(method, healthPrefix) {
+			return slog.LevelDebug
+		}
+		return slog.LevelInfo
+	case codes.InvalidArgument, codes.NotFound, codes.AlreadyExists, codes.PermissionDenied,
+		codes.Unauthenticated, codes.FailedPrecondition, codes.OutOfRange, codes.Canceled,
+		codes.ResourceExhausted, codes.Aborted:
+		return slog.LevelWarn
+	default:
+		return slog.LevelError
+	}
+}
+
+// recoverPanic - паника обработчика становится codes.Internal, стек остаётся только в логе
+func recoverPanic() grpc.UnaryServerInterceptor {
+	return recovery.UnaryServerInterceptor(recovery.WithRecoveryHandlerContext(func(ctx context.Context, p any) error {
+		slog.ErrorContext(ctx, "panic in grpc handler", "panic", p, "stack", string(debug.Stack()))
+		return status.Error(codes.Internal, "internal error")
+	}))
+}
+
+// withDefaultDeadline - вызов без дедлайна клиента получает дедлайн сервера
+func withDefaultDeadline(timeout time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := ctx.Deadline(); !ok {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return handler(ctx, req)
+	}
+}
+
+// validate - проверка запроса по правилам buf.validate; нарушения - codes.InvalidArgument с BadRequest в деталях
+func validate(validator protovalidate.Validator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		msg, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		err := validator.Validate(msg)
+		var validationErr *protovalidate.ValidationError
+		switch {
+		case err == nil:
+			return handler(ctx, req)
+		case errors.As(err, &validationErr):
+			return nil, invalidArgument(validationErr)
+		default:
+			// Ошибка компиляции правил - ошибка сервера, а не клиента
+			slog.ErrorContext(ctx, "request validation failed", logging.Err(err))
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+}
+
+// invalidArgument - все нарушения одной строкой: "user_id: value is required; name: ..."
+func invalidArgument(err *protovalidate.ValidationError) error {
+	messages := make([]string, 0, len(err.Violations))
+	badRequest := &errdetails.BadRequest{}
+	for _, v := range err.Violations {
+		field := protovalidate.FieldPathString(v.Proto.GetField())
+		message := v.Proto.GetMessage()
+		if field != "" {
+			messages = append(messages, field+": "+message)
+		} else {
+			messages = append(messages, message)
+		}
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: message,
+		})
+	}
+
+	st := status.New(codes.InvalidArgument, strings.Join(messages, "; "))
+	if withDetails, detailsErr := st.WithDetails(badRequest); detailsErr == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
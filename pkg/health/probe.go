@@ -0,0 +1,111 @@
+package health
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+)
+
+// DependencyReport - состояние одной зависимости сервиса
+type DependencyReport struct {
+	Name      string  `json:"name"`
+	Status    string  `json:"status"`
+	LatencyMs float64 `json:"latency_ms"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// StatusDegraded - статус недоступной необязательной зависимости: сервис работает, но не в полную силу
+const StatusDegraded = "DEGRADED"
+
+// ServiceReport - состояние сервиса по ответу grpc.health.v1 List
+type ServiceReport struct {
+	Status       string             `json:"status"`
+	LatencyMs    float64            `json:"latency_ms"` // время ответа самого health-запроса
+	Error        string             `json:"error,omitempty"`
+	Dependencies []DependencyReport `json:"dependencies,omitempty"`
+	// Breakers - состояние circuit breaker-ов исходящих вызовов сервиса: closed, half-open, open
+	Breakers map[string]string `json:"breakers,omitempty"`
+}
+
+// Ready - сервис и все его зависимости доступны
+func (r ServiceReport) Ready() bool {
+	return r.Status == healthpb.HealthCheckResponse_SERVING.String()
+}
+
+// StatusSummary - только названия статусов сервиса, его зависимостей и breaker-ов; без текстов ошибок
+// и задержек, чтобы отдавать без аутентификации
+type StatusSummary struct {
+	Status       string            `json:"status"`
+	Dependencies map[string]string `json:"dependencies,omitempty"`
+	Breakers     map[string]string `json:"breakers,omitempty"`
+}
+
+// Summary - отчёт, сокращённый до названий статусов
+func (r ServiceReport) Summary() StatusSummary {
+	summary := StatusSummary{Status: r.Status, Breakers: r.Breakers}
+	if len(r.Dependencies) > 0 {
+		summary.Dependencies = make(map[string]string, len(r.Dependencies))
+		for _, dependency := range r.Dependencies {
+			summary.Dependencies[dependency.Name] = dependency.Status
+		}
+	}
+	return summary
+}
+
+// Probe - запрашивает статусы сервиса и его зависимостей, ничего не меняя
+func Probe(ctx context.Context, conn grpc.ClientConnInterface) ServiceReport {
+	var header metadata.MD
+	start := time.Now()
+	resp, err := healthpb.NewHealthClient(conn).List(ctx, &healthpb.HealthListRequest{}, grpc.Header(&header))
+	report := ServiceReport{LatencyMs: milliseconds(time.Since(start))}
+	if err != nil {
+		report.Status = healthpb.HealthCheckResponse_UNKNOWN.String()
+		report.Error = err.Error()
+		return report
+	}
+
+	report.Status = healthpb.HealthCheckResponse_NOT_SERVING.String()
+	for name, status := range resp.Statuses {
+		if name == "" {
+			report.Status = status.Status.String()
+			continue
+		}
+
+		dependency := DependencyReport{Name: name, Status: status.Status.String()}
+		if values := header.Get(latencyHeaderPrefix + name); len(values) > 0 {
+			if us, err := strconv.ParseInt(values[0], 10, 64); err == nil {
+				dependency.LatencyMs = milliseconds(time.Duration(us) * time.Microsecond)
+			}
+		}
+		if values := header.Get(errorHeaderPrefix + name); len(values) > 0 {
+			dependency.Error = strings.Join(values, "; ")
+		}
+		if len(header.Get(optionalHeaderPrefix+name)) > 0 && status.Status != healthpb.HealthCheckResponse_SERVING {
+			dependency.Status = StatusDegraded
+		}
+		report.Dependencies = append(report.Dependencies, dependency)
+	}
+	for key, values := range header {
+		if name, ok := strings.CutPrefix(key, breakerHeaderPrefix); ok && len(values) > 0 {
+			if report.Breakers == nil {
+				report.Breakers = make(map[string]string)
+			}
+			report.Breakers[name] = values[0]
+		}
+	}
+	sort.Slice(report.Dependencies, func(i, j int) bool {
+		return report.Dependencies[i].Name < report.Dependencies[j].Name
+	})
+
+	return report
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
@@ -0,0 +1,231 @@
+// Package health - стандартный grpc.health.v1 с фоновыми проверками зависимостей сервиса.
+package health
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+)
+
+// Заголовки ответа List с подробностями последней проверки каждой зависимости
+const (
+	latencyHeaderPrefix  = "health-latency-us-"
+	errorHeaderPrefix    = "health-error-"
+	breakerHeaderPrefix  = "health-breaker-"
+	optionalHeaderPrefix = "health-optional-"
+)
+
+// Значения по умолчанию, если в конфиге интервал или таймаут не заданы
+const (
+	DefaultInterval = 10 * time.Second
+	DefaultTimeout  = 2 * time.Second
+)
+
+// Check - проверка одной зависимости, ошибка - зависимость недоступна
+type Check func(ctx context.Context) error
+
+// Result - результат последней проверки зависимости
+type Result struct {
+	Serving bool
+	Latency time.Duration
+	Error   string
+}
+
+// Breaker - circuit breaker исходящих вызовов. Его состояние видно в List, но на SERVING не влияет:
+// разомкнутый breaker - это деградация, а не повод выводить сервис из балансировки.
+type Breaker interface {
+	Name() string
+	State() string
+}
+
+// Server - health-сервер, статусы которого обновляются проверками зависимостей.
+// Общий статус ("") - SERVING, только если доступны все обязательные зависимости.
+type Server struct {
+	*health.Server
+	interval time.Duration
+	timeout  time.Duration
+
+	names    []string
+	checks   map[string]Check
+	optional map[string]bool
+	breakers []Breaker
+
+	mu      sync.RWMutex
+	results map[string]Result
+
+	stop chan struct{}
+	once sync.Once
+}
+
+func NewServer(interval, timeout time.Duration) *Server {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	s := &Server{
+		Server:   health.NewServer(),
+		interval: interval,
+		timeout:  timeout,
+		checks:   map[string]Check{},
+		optional: map[string]bool{},
+		results:  map[string]Result{},
+		stop:     make(chan struct{}),
+	}
+	// До первой проверки сервис не готов
+	s.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	return s
+}
+
+// AddCheck - добавляет зависимость; вызывать до Start
+func (s *Server) AddCheck(name string, check Check) {
+	s.names = append(s.names, name)
+	s.checks[name] = check
+	s.SetServingStatus(name, healthpb.HealthCheckResponse_NOT_SERVING)
+}
+
+// AddOptionalCheck - зависимость, без которой сервис работает в деградированном режиме:
+// её недоступность видна в List, но общий статус не меняет; вызывать до Start
+func (s *Server) AddOptionalCheck(name string, check Check) {
+	s.AddCheck(name, check)
+	s.optional[name] = true
+}
+
+// AddBreaker - показывать состояние breaker-а в List; вызывать до Start
+func (s *Server) AddBreaker(b Breaker) {
+	s.breakers = append(s.breakers, b)
+}
+
+// Register - регистрирует grpc.health.v1.Health на gRPC сервере
+func (s *Server) Register(grpcServer *grpc.Server) {
+	healthpb.RegisterHealthServer(grpcServer, s)
+}
+
+// Start - проверяет зависимости сразу и затем с заданным интервалом
+func (s *Server) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			s.runChecks(ctx)
+
+			select {
+			case <-ticker.C:
+			case <-s.stop:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop - останавливает проверки и переводит все статусы в NOT_SERVING
+func (s *Server) Stop() {
+	s.once.Do(func() { close(s.stop) })
+	s.Shutdown()
+}
+
+// Results - результаты последних проверок
+func (s *Server) Results() map[string]Result {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	results := make(map[string]Result, len(s.results))
+	for name, result := range s.results {
+		results[name] = result
+	}
+	return results
+}
+
+// runChecks - проверяет все зависимости параллельно и обновляет статусы
+func (s *Server) runChecks(ctx context.Context) {
+	results := make(map[string]Result, len(s.names))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for _, name := range s.names {
+		wg.Add(1)
+		go func(name string, check Check) {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, s.timeout)
+			defer cancel()
+
+			start := time.Now()
+			err := check(checkCtx)
+			result := Result{Serving: err == nil, Latency: time.Since(start)}
+			if err != nil {
+				result.Error = err.Error()
+			}
+
+			mu.Lock()
+			results[name] = result
+			mu.Unlock()
+		}(name, s.checks[name])
+	}
+	wg.Wait()
+
+	s.mu.Lock()
+	s.results = results
+	s.mu.Unlock()
+
+	overall := healthpb.HealthCheckResponse_SERVING
+	for name, result := range results {
+		status := healthpb.HealthCheckResponse_SERVING
+		if !result.Serving {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+			if !s.optional[name] {
+				overall = healthpb.HealthCheckResponse_NOT_SERVING
+			}
+		}
+		s.SetServingStatus(name, status)
+	}
+	s.SetServingStatus("", overall)
+}
+
+// List - статусы всех зависимостей; задержка и ошибка последней проверки и состояние breaker-ов
+// передаются в заголовках
+func (s *Server) List(ctx context.Context, req *healthpb.HealthListRequest) (*healthpb.HealthListResponse, error) {
+	resp, err := s.Server.List(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	md := metadata.MD{}
+	for name, result := range s.Results() {
+		md.Set(latencyHeaderPrefix+name, strconv.FormatInt(result.Latency.Microseconds(), 10))
+		if result.Error != "" {
+			md.Set(errorHeaderPrefix+name, headerValue(result.Error))
+		}
+		if s.optional[name] {
+			md.Set(optionalHeaderPrefix+name, "true")
+		}
+	}
+	for _, b := range s.breakers {
+		md.Set(breakerHeaderPrefix+b.Name(), b.State())
+	}
+	_ = grpc.SetHeader(ctx, md)
+
+	return resp, nil
+}
+
+// headerValue - значение заголовка gRPC должно состоять из печатных ASCII-символов
+func headerValue(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || r > 0x7e {
+			return '?'
+		}
+		return r
+	}, s)
+}
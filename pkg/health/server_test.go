@@ -0,0 +1,84 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"gotest.tools/v3/assert"
+)
+
+type openBreaker struct{}
+
+func (openBreaker) Name() string  { return "newsapi" }
+func (openBreaker) State() string { return "open" }
+
+func TestProbeReportsDependencies(t *testing.T) {
+	listener := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+
+	healthServer := NewServer(time.Hour, time.Second)
+	healthServer.AddCheck("postgres", func(ctx context.Context) error { return nil })
+	healthServer.AddCheck("redis", func(ctx context.Context) error { return errors.New("connection refused") })
+	healthServer.AddBreaker(openBreaker{})
+	healthServer.Register(grpcServer)
+	healthServer.runChecks(context.Background())
+
+	go grpcServer.Serve(listener)
+	defer grpcServer.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NilError(t, err)
+	defer conn.Close()
+
+	report := Probe(context.Background(), conn)
+
+	assert.Equal(t, "NOT_SERVING", report.Status)
+	assert.Assert(t, !report.Ready())
+	assert.Equal(t, 2, len(report.Dependencies))
+	assert.Equal(t, "postgres", report.Dependencies[0].Name)
+	assert.Equal(t, "SERVING", report.Dependencies[0].Status)
+	assert.Equal(t, "redis", report.Dependencies[1].Name)
+	assert.Equal(t, "NOT_SERVING", report.Dependencies[1].Status)
+	assert.Equal(t, "connection refused", report.Dependencies[1].Error)
+	// разомкнутый breaker виден, но сам по себе сервис не выводит
+	assert.Equal(t, "open", report.Breakers["newsapi"])
+}
+
+func TestOptionalCheckDegrades(t *testing.T) {
+	listener := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	healthServer := NewServer(time.Hour, time.Second)
+	healthServer.AddCheck("redis", func(ctx context.Context) error { return nil })
+	healthServer.AddOptionalCheck("newsapi", func(ctx context.Context) error { return errors.New("dial tcp: i/o timeout") })
+	healthServer.Register(grpcServer)
+	healthServer.runChecks(context.Background())
+
+	go grpcServer.Serve(listener)
+	defer grpcServer.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NilError(t, err)
+	defer conn.Close()
+
+	report := Probe(context.Background(), conn)
+	// недоступный провайдер не выводит сервис из балансировки
+	assert.Assert(t, report.Ready())
+	assert.Equal(t, StatusDegraded, report.Dependencies[0].Status)
+	assert.Equal(t, "dial tcp: i/o timeout", report.Dependencies[0].Error)
+
+	summary := report.Summary()
+	assert.Equal(t, "SERVING", summary.Status)
+	assert.DeepEqual(t, map[string]string{"newsapi": StatusDegraded, "redis": "SERVING"}, summary.Dependencies)
+}
@@ -0,0 +1,102 @@
+// Package logging - структурные логи сервисов gonews на log/slog: JSON в stdout, уровень из конфига,
+// request_id и trace_id из контекста в каждой записи, передача X-Request-ID через gRPC и Kafka.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Форматы вывода
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+// Config - настройки логов, одинаковые для всех сервисов
+type Config struct {
+	Level  string `yaml:"level" default:"info" validate:"oneof=debug info warn error" reload:"true"` // debug, info (по умолчанию), warn, error
+	Format string `yaml:"format" default:"json" validate:"oneof=json text"`                          // json (по умолчанию) или text для локальной отладки
+}
+
+// level - общий уровень всех логгеров процесса; меняется без пересоздания обработчика
+var level = new(slog.LevelVar)
+
+// Init - логгер по умолчанию для slog и стандартного log; записи log.Printf тоже идут в JSON с уровнем info
+func Init(serviceName string, cfg Config) error {
+	return initWriter(os.Stdout, serviceName, cfg)
+}
+
+func initWriter(w io.Writer, serviceName string, cfg Config) error {
+	if err := SetLevel(cfg.Level); err != nil {
+		return err
+	}
+
+	opts := &slog.HandlerOptions{Level: level}
+	var handler slog.Handler
+	switch cfg.Format {
+	case "", FormatJSON:
+		handler = slog.NewJSONHandler(w, opts)
+	case FormatText:
+		handler = slog.NewTextHandler(w, opts)
+	default:
+		return fmt.Errorf("unknown log format %q", cfg.Format)
+	}
+
+	logger := slog.New(contextHandler{handler}).With(slog.String("service", serviceName))
+	slog.SetDefault(logger)
+
+	return nil
+}
+
+// SetLevel - уровень логов по имени; пустое имя - info
+func SetLevel(name string) error {
+	if name == "" {
+		level.Set(slog.LevelInfo)
+		return nil
+	}
+
+	var l slog.Level
+	if err := l.UnmarshalText([]byte(strings.ToUpper(name))); err != nil {
+		return fmt.Errorf("invalid log level %q: %w", name, err)
+	}
+	level.Set(l)
+	return nil
+}
+
+// Err - атрибут ошибки с единым ключом
+func Err(err error) slog.Attr {
+	return slog.Any("error", err)
+}
+
+// contextHandler - добавляет к записи request_id и идентификаторы трейса из контекста вызова
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if id := RequestID(ctx); id != "" {
+		record.AddAttrs(slog.String("request_id", id))
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		record.AddAttrs(
+			slog.String("trace_id", sc.TraceID().String()),
+			slog.String("span_id", sc.SpanID().String()),
+		)
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,79 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log"
+	"log/slog"
+	"testing"
+
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"gotest.tools/v3/assert"
+)
+
+func TestRecordsCarryRequestIDAndLevel(t *testing.T) {
+	defer slog.SetDefault(slog.Default())
+
+	var buf bytes.Buffer
+	assert.NilError(t, initWriter(&buf, "save-service", Config{Level: "warn"}))
+
+	ctx := WithRequestID(context.Background(), "req-1")
+	slog.InfoContext(ctx, "dropped")
+	slog.WarnContext(ctx, "kept", "user_id", 7)
+	// стандартный log идёт на уровне info и тоже отсекается
+	log.Printf("legacy")
+
+	var record map[string]any
+	assert.NilError(t, json.Unmarshal(buf.Bytes(), &record))
+	assert.Equal(t, "kept", record["msg"])
+	assert.Equal(t, "WARN", record["level"])
+	assert.Equal(t, "save-service", record["service"])
+	assert.Equal(t, "req-1", record["request_id"])
+	assert.Equal(t, float64(7), record["user_id"])
+
+	assert.ErrorContains(t, SetLevel("loud"), "invalid log level")
+	assert.NilError(t, SetLevel(""))
+}
+
+func TestRequestIDCrossesGRPC(t *testing.T) {
+	var outgoing metadata.MD
+	invoker := func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		outgoing, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+	ctx := WithRequestID(context.Background(), "req-2")
+	assert.NilError(t, unaryClientInterceptor(ctx, "/pb.SaveService/GetUser", nil, nil, nil, invoker))
+	assert.DeepEqual(t, []string{"req-2"}, outgoing.Get(MetadataKey))
+
+	var got string
+	handler := func(ctx context.Context, _ any) (any, error) {
+		got = RequestID(ctx)
+		return nil, nil
+	}
+	_, err := unaryServerInterceptor(metadata.NewIncomingContext(context.Background(), outgoing), nil, nil, handler)
+	assert.NilError(t, err)
+	assert.Equal(t, "req-2", got)
+
+	// без metadata сервер выдаёт свой идентификатор
+	_, err = unaryServerInterceptor(context.Background(), nil, nil, handler)
+	assert.NilError(t, err)
+	assert.Equal(t, 32, len(got))
+}
+
+func TestRequestIDCrossesKafka(t *testing.T) {
+	msg := kafka.Message{Headers: []kafka.Header{{Key: MetadataKey, Value: []byte("stale")}}}
+	InjectKafka(WithRequestID(context.Background(), "req-3"), &msg)
+
+	assert.Equal(t, 1, len(msg.Headers))
+	assert.Equal(t, "req-3", RequestID(ExtractKafka(context.Background(), &msg)))
+}
+
+func TestValidRequestID(t *testing.T) {
+	assert.Assert(t, ValidRequestID("0af7651916cd43dd8448eb211c80319c"))
+	assert.Assert(t, !ValidRequestID(""))
+	assert.Assert(t, !ValidRequestID("id with spaces"))
+	assert.Assert(t, !ValidRequestID(string(bytes.Repeat([]byte("a"), 129))))
+}
@@ -0,0 +1,111 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// Header - заголовок HTTP запроса и ответа шлюза
+	Header = "X-Request-ID"
+	// MetadataKey - ключ gRPC metadata и заголовка сообщения Kafka
+	MetadataKey = "x-request-id"
+	// maxRequestIDLength - чужие идентификаторы длиннее не принимаем
+	maxRequestIDLength = 128
+)
+
+type requestIDKey struct{}
+
+// WithRequestID - контекст с идентификатором запроса
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID - идентификатор запроса из контекста или пустая строка
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// NewRequestID - случайный идентификатор из 32 hex символов
+func NewRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// ValidRequestID - идентификатор от клиента можно взять как есть: не длиннее 128 печатных ASCII символов
+func ValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+// ServerOption - request_id из входящей metadata в контекст обработчика; без него генерируем новый
+func ServerOption() grpc.ServerOption {
+	return grpc.ChainUnaryInterceptor(unaryServerInterceptor)
+}
+
+func unaryServerInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	return handler(WithRequestID(ctx, incomingRequestID(ctx)), req)
+}
+
+func incomingRequestID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(MetadataKey); len(values) > 0 && ValidRequestID(values[0]) {
+			return values[0]
+		}
+	}
+	return NewRequestID()
+}
+
+// DialOption - request_id из контекста в исходящую metadata
+func DialOption() grpc.DialOption {
+	return grpc.WithChainUnaryInterceptor(unaryClientInterceptor)
+}
+
+func unaryClientInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if id := RequestID(ctx); id != "" {
+		if md, _ := metadata.FromOutgoingContext(ctx); len(md.Get(MetadataKey)) == 0 {
+			ctx = metadata.AppendToOutgoingContext(ctx, MetadataKey, id)
+		}
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// InjectKafka - request_id в заголовки сообщения; старое значение заменяется
+func InjectKafka(ctx context.Context, msg *kafka.Message) {
+	id := RequestID(ctx)
+	if id == "" {
+		return
+	}
+
+	for i, h := range msg.Headers {
+		if h.Key == MetadataKey {
+			msg.Headers[i].Value = []byte(id)
+			return
+		}
+	}
+	msg.Headers = append(msg.Headers, kafka.Header{Key: MetadataKey, Value: []byte(id)})
+}
+
+// ExtractKafka - контекст с request_id из заголовков сообщения; без заголовка генерируем новый
+func ExtractKafka(ctx context.Context, msg *kafka.Message) context.Context {
+	for _, h := range msg.Headers {
+		if h.Key == MetadataKey && ValidRequestID(string(h.Value)) {
+			return WithRequestID(ctx, string(h.Value))
+		}
+	}
+	return WithRequestID(ctx, NewRequestID())
+}
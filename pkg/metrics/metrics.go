@@ -0,0 +1,88 @@
+// Package metrics - Prometheus для сервисов gonews: метрики gRPC вызовов и эндпоинт /metrics.
+//
+// Соглашения об именах собственных метрик: gonews_<подсистема>_<что>_<единица>,
+// подсистема - сервис или компонент (search, notify, kafka, db), счётчики заканчиваются на _total,
+// длительности в секундах на _seconds. Результат вызова - метка outcome (ok или вид ошибки).
+// Метрики gRPC - стандартные grpc_server_* и grpc_client_* из go-grpc-middleware.
+package metrics
+
+import (
+	"errors"
+	"fmt"
+	"gonews/pkg/logging"
+	"log/slog"
+	"net/http"
+	"time"
+
+	grpcprom "github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+)
+
+// Namespace - общий префикс собственных метрик
+const Namespace = "gonews"
+
+// Значения метки outcome
+const (
+	OutcomeOK    = "ok"
+	OutcomeError = "error"
+)
+
+var (
+	serverMetrics = grpcprom.NewServerMetrics(grpcprom.WithServerHandlingTimeHistogram())
+	clientMetrics = grpcprom.NewClientMetrics(grpcprom.WithClientHandlingTimeHistogram())
+)
+
+func init() {
+	prometheus.MustRegister(serverMetrics, clientMetrics)
+}
+
+// ServerOption - счётчики и гистограмма времени обработки входящих gRPC вызовов
+func ServerOption() grpc.ServerOption {
+	return grpc.ChainUnaryInterceptor(serverMetrics.UnaryServerInterceptor())
+}
+
+// InitServer - нулевые серии для всех методов сервера; вызывать после регистрации сервисов
+func InitServer(server *grpc.Server) {
+	serverMetrics.InitializeMetrics(server)
+}
+
+// DialOption - метрики исходящих gRPC вызовов
+func DialOption() grpc.DialOption {
+	return grpc.WithChainUnaryInterceptor(clientMetrics.UnaryClientInterceptor())
+}
+
+// Handler - /metrics в формате Prometheus
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// Serve - отдельный HTTP сервер с /metrics для процессов без HTTP API
+func Serve(port int) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Handler())
+
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", port),
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	go func() {
+		slog.Info("Metrics listening", "port", port)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("Metrics server error", logging.Err(err))
+		}
+	}()
+
+	return server
+}
+
+// Outcome - метка outcome по ошибке вызова
+func Outcome(err error) string {
+	if err != nil {
+		return OutcomeError
+	}
+	return OutcomeOK
+}
@@ -0,0 +1,31 @@
+// Package schedule - расписания проверок подписок: cron выражение из пяти полей ("0 */2 * * *"),
+// дескриптор (@hourly, @daily) или интервал (@every 30m). Пустое расписание - интервал сервиса по умолчанию.
+package schedule
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// MinInterval - чаще проверять подписку нельзя: каждая проверка - запрос к NewsAPI
+const MinInterval = time.Minute
+
+// Parse - разбирает расписание; для пустой строки - nil без ошибки
+func Parse(spec string) (cron.Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return nil, nil
+	}
+
+	s, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, fmt.Errorf("schedule %q: %w", spec, err)
+	}
+	if every, ok := s.(cron.ConstantDelaySchedule); ok && every.Delay < MinInterval {
+		return nil, fmt.Errorf("schedule %q: interval must be at least %s", spec, MinInterval)
+	}
+	return s, nil
+}
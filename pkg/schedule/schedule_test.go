@@ -0,0 +1,31 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestParse(t *testing.T) {
+	from := time.Date(2026, 10, 19, 10, 7, 0, 0, time.UTC)
+
+	for spec, next := range map[string]time.Time{
+		"*/15 * * * *": time.Date(2026, 10, 19, 10, 15, 0, 0, time.UTC),
+		"@hourly":      time.Date(2026, 10, 19, 11, 0, 0, 0, time.UTC),
+		"@every 30m":   from.Add(30 * time.Minute),
+	} {
+		s, err := Parse(spec)
+		assert.NilError(t, err, spec)
+		assert.Equal(t, next, s.Next(from), spec)
+	}
+
+	s, err := Parse("  ")
+	assert.NilError(t, err)
+	assert.Assert(t, s == nil)
+
+	_, err = Parse("@every 10s")
+	assert.ErrorContains(t, err, "interval must be at least 1m0s")
+	_, err = Parse("every hour")
+	assert.ErrorContains(t, err, `schedule "every hour"`)
+}
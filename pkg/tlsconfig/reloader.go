@@ -0,0 +1,115 @@
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"gonews/pkg/logging"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+// checkInterval - как часто при handshake смотрим, не поменялись ли файлы
+var checkInterval = 5 * time.Second
+
+// state - загруженные сертификат и CA
+type state struct {
+	cert  *tls.Certificate
+	roots *x509.CertPool
+}
+
+// reloader - перечитывает сертификат, ключ и CA, когда у файлов меняется время изменения.
+// Если новые файлы не читаются (например, записаны наполовину), продолжает работать со старыми.
+type reloader struct {
+	cfg Config
+
+	mu        sync.Mutex
+	state     state
+	modTimes  map[string]time.Time
+	checkedAt time.Time
+}
+
+func newReloader(cfg Config) (*reloader, error) {
+	if cfg.CertFile == "" || cfg.KeyFile == "" {
+		return nil, errors.New("tls: cert_file and key_file are required")
+	}
+
+	r := &reloader{cfg: cfg}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	r.checkedAt = time.Now()
+	return r, nil
+}
+
+// current - актуальное состояние; раз в checkInterval сверяет время изменения файлов
+func (r *reloader) current() state {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if time.Since(r.checkedAt) >= checkInterval {
+		r.checkedAt = time.Now()
+		if r.changed() {
+			if err := r.load(); err != nil {
+				slog.Warn("tls: keeping previous certificates", "cert_file", r.cfg.CertFile, logging.Err(err))
+			} else {
+				slog.Info("tls: certificates reloaded", "cert_file", r.cfg.CertFile)
+			}
+		}
+	}
+
+	return r.state
+}
+
+func (r *reloader) files() []string {
+	files := []string{r.cfg.CertFile, r.cfg.KeyFile}
+	if r.cfg.CAFile != "" {
+		files = append(files, r.cfg.CAFile)
+	}
+	return files
+}
+
+func (r *reloader) changed() bool {
+	for _, file := range r.files() {
+		info, err := os.Stat(file)
+		if err != nil || !info.ModTime().Equal(r.modTimes[file]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *reloader) load() error {
+	modTimes := make(map[string]time.Time, 3)
+	for _, file := range r.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			return fmt.Errorf("tls: %w", err)
+		}
+		modTimes[file] = info.ModTime()
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.cfg.CertFile, r.cfg.KeyFile)
+	if err != nil {
+		return fmt.Errorf("tls: failed to load key pair: %w", err)
+	}
+
+	var roots *x509.CertPool
+	if r.cfg.CAFile != "" {
+		pem, err := os.ReadFile(r.cfg.CAFile)
+		if err != nil {
+			return fmt.Errorf("tls: failed to read CA: %w", err)
+		}
+		roots = x509.NewCertPool()
+		if !roots.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("tls: no certificates in %s", r.cfg.CAFile)
+		}
+	}
+
+	r.state = state{cert: &cert, roots: roots}
+	r.modTimes = modTimes
+	return nil
+}
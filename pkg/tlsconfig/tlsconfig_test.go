@@ -0,0 +1,192 @@
+package tlsconfig
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+	"gotest.tools/v3/assert"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T, name string) testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NilError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	assert.NilError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NilError(t, err)
+	return testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// writeService - сертификат сервиса для serverAuth и clientAuth, как делает scripts/gencerts
+func (ca testCA) writeService(t *testing.T, dir, name string) Config {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NilError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	if ip := net.ParseIP(name); ip != nil {
+		tmpl.IPAddresses = []net.IP{ip}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	assert.NilError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	assert.NilError(t, err)
+
+	cfg := Config{
+		Enabled:    true,
+		CertFile:   filepath.Join(dir, name+".crt"),
+		KeyFile:    filepath.Join(dir, name+".key"),
+		CAFile:     filepath.Join(dir, name+"-ca.crt"),
+		ClientAuth: true,
+	}
+	assert.NilError(t, os.WriteFile(cfg.CertFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	assert.NilError(t, os.WriteFile(cfg.KeyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+	assert.NilError(t, os.WriteFile(cfg.CAFile, ca.pem, 0o600))
+	return cfg
+}
+
+// handshake - TLS рукопожатие клиента и сервера через net.Pipe; сначала ошибка клиента, потом сервера
+func handshake(serverCfg, clientCfg *tls.Config) error {
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	defer serverConn.Close()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		serverErr <- tls.Server(serverConn, serverCfg).Handshake()
+		serverConn.Close()
+	}()
+
+	clientErr := tls.Client(clientConn, clientCfg).Handshake()
+	clientConn.Close()
+	if err := <-serverErr; clientErr == nil {
+		return err
+	}
+	return clientErr
+}
+
+func TestMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t, "gonews-ca")
+	serverCfg := ca.writeService(t, dir, "save-service")
+	clientCfg := ca.writeService(t, dir, "search-service")
+
+	server, err := ServerTLS(serverCfg)
+	assert.NilError(t, err)
+	client, err := ClientTLS(clientCfg)
+	assert.NilError(t, err)
+
+	client.ServerName = "save-service"
+	assert.NilError(t, handshake(server, client))
+
+	// сертификат выписан на другое имя
+	client.ServerName = "notify-service"
+	assert.ErrorContains(t, handshake(server, client), "certificate is valid for save-service")
+
+	// клиент без сертификата при client_auth не проходит
+	anonymous := &tls.Config{ServerName: "save-service", RootCAs: x509.NewCertPool()}
+	anonymous.RootCAs.AddCert(ca.cert)
+	assert.Assert(t, handshake(server, anonymous) != nil)
+}
+
+// TestDialVerifiesServerIP - при Dial по IP в SNI ничего нет, но сертификат всё равно сверяется с адресом
+func TestDialVerifiesServerIP(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t, "gonews-ca")
+	serverCfg := ca.writeService(t, dir, "127.0.0.1")
+	clientCfg := ca.writeService(t, dir, "search-service")
+
+	server, err := ServerTLS(serverCfg)
+	assert.NilError(t, err)
+	r, err := newReloader(clientCfg)
+	assert.NilError(t, err)
+	creds := &clientCredentials{reloader: r}
+
+	dial := func(authority string) error {
+		clientConn, serverConn := net.Pipe()
+		defer clientConn.Close()
+		go func() {
+			// сторона сервера как у grpc.Creds: там же согласуется ALPN h2
+			conn, _, err := credentials.NewTLS(server).ServerHandshake(serverConn)
+			if err == nil {
+				conn.Close()
+			}
+			serverConn.Close()
+		}()
+		_, _, err := creds.ClientHandshake(context.Background(), authority, clientConn)
+		return err
+	}
+
+	assert.NilError(t, dial("127.0.0.1:50051"))
+	assert.ErrorContains(t, dial("10.0.0.7:50051"), "certificate is valid for 127.0.0.1")
+}
+
+func TestCertificatesReloadFromDisk(t *testing.T) {
+	defer func(interval time.Duration) { checkInterval = interval }(checkInterval)
+	checkInterval = 0
+
+	dir := t.TempDir()
+	oldCA := newTestCA(t, "old-ca")
+	serverCfg := oldCA.writeService(t, dir, "save-service")
+
+	server, err := ServerTLS(serverCfg)
+	assert.NilError(t, err)
+
+	// клиент уже на новом CA, сервер ещё на старом
+	newCA := newTestCA(t, "new-ca")
+	clientCfg := newCA.writeService(t, dir, "search-service")
+	client, err := ClientTLS(clientCfg)
+	assert.NilError(t, err)
+	client.ServerName = "save-service"
+	assert.Assert(t, handshake(server, client) != nil)
+
+	// ротация сертификатов сервера без перезапуска; время файла сдвигаем явно
+	newCA.writeService(t, dir, "save-service")
+	later := time.Now().Add(time.Minute)
+	for _, file := range []string{serverCfg.CertFile, serverCfg.KeyFile, serverCfg.CAFile} {
+		assert.NilError(t, os.Chtimes(file, later, later))
+	}
+	assert.NilError(t, handshake(server, client))
+}
+
+func TestDisabledTLSIsInsecure(t *testing.T) {
+	_, err := DialOption(Config{})
+	assert.NilError(t, err)
+
+	_, err = ServerTLS(Config{Enabled: true, CertFile: "a.crt", KeyFile: "a.key", ClientAuth: true})
+	assert.ErrorContains(t, err, "client_auth requires ca_file")
+}
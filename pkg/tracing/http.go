@@ -0,0 +1,19 @@
+package tracing
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// HTTPTransport - спаны исходящих HTTP запросов к внешнему API peer.
+// Контекст трейса наружу не передаём: внешнему сервису наши trace id ни к чему.
+func HTTPTransport(base http.RoundTripper, peer string) http.RoundTripper {
+	return otelhttp.NewTransport(base,
+		otelhttp.WithPropagators(propagation.NewCompositeTextMapPropagator()),
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return peer + " " + r.Method + " " + r.URL.Path
+		}),
+	)
+}
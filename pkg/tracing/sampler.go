@@ -0,0 +1,33 @@
+package tracing
+
+import (
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// backgroundSampler - не начинает трейс с исходящего вызова: такие корневые спаны дают
+// фоновые health checks (ping Redis, NewsAPI, Kafka) каждые несколько секунд.
+// Остальное решает ParentBased(TraceIDRatioBased).
+type backgroundSampler struct {
+	next sdktrace.Sampler
+}
+
+func newSampler(ratio float64) sdktrace.Sampler {
+	root := sdktrace.AlwaysSample()
+	if ratio > 0 && ratio < 1 {
+		root = sdktrace.TraceIDRatioBased(ratio)
+	}
+	return backgroundSampler{next: sdktrace.ParentBased(root)}
+}
+
+func (s backgroundSampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	parent := trace.SpanContextFromContext(p.ParentContext)
+	if !parent.IsValid() && (p.Kind == trace.SpanKindClient || p.Kind == trace.SpanKindProducer) {
+		return sdktrace.SamplingResult{Decision: sdktrace.Drop, Tracestate: parent.TraceState()}
+	}
+	return s.next.ShouldSample(p)
+}
+
+func (s backgroundSampler) Description() string {
+	return "BackgroundSampler{" + s.next.Description() + "}"
+}
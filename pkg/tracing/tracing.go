@@ -0,0 +1,125 @@
+// Package tracing - OpenTelemetry для сервисов gonews: провайдер трейсов с экспортом в OTLP или stdout,
+// инструментирование gRPC, HTTP клиентов, Redis и Kafka.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc/filters"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+)
+
+// Экспортёры трейсов
+const (
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+)
+
+// Config - настройки трейсинга, одинаковые для всех сервисов
+type Config struct {
+	Enabled     bool    `yaml:"enabled"`
+	Exporter    string  `yaml:"exporter" default:"otlp" validate:"oneof=otlp stdout"` // otlp (по умолчанию) или stdout для локальной отладки
+	Endpoint    string  `yaml:"endpoint"`                                             // host:port OTLP/gRPC коллектора
+	Insecure    bool    `yaml:"insecure"`                                             // без TLS до коллектора
+	SampleRatio float64 `yaml:"sample_ratio" validate:"min=0,max=1"`                  // доля новых трейсов, 0 - все
+}
+
+// Validate - экспорт в OTLP без адреса коллектора
+func (c Config) Validate() error {
+	if c.Enabled && c.Exporter == ExporterOTLP && c.Endpoint == "" {
+		return errors.New("endpoint: is required for the otlp exporter")
+	}
+	return nil
+}
+
+// Shutdown - отправляет накопленные спаны и останавливает провайдер
+type Shutdown func(ctx context.Context) error
+
+// Init - глобальный провайдер трейсов и W3C propagator. При выключенном трейсинге
+// спаны не пишутся, но контекст трейса всё равно передаётся дальше по цепочке.
+func Init(ctx context.Context, serviceName string, cfg Config) (Shutdown, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	if !cfg.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := newExporter(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build tracing resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(newSampler(cfg.SampleRatio)),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+func newExporter(ctx context.Context, cfg Config) (sdktrace.SpanExporter, error) {
+	switch cfg.Exporter {
+	case "", ExporterOTLP:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err := otlptracegrpc.New(ctx, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OTLP exporter: %w", err)
+		}
+		return exporter, nil
+	case ExporterStdout:
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(os.Stdout), stdouttrace.WithPrettyPrint())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create stdout exporter: %w", err)
+		}
+		return exporter, nil
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q", cfg.Exporter)
+	}
+}
+
+// ServerOption - спаны входящих gRPC вызовов; health checks не трейсим
+func ServerOption() grpc.ServerOption {
+	return grpc.StatsHandler(otelgrpc.NewServerHandler(
+		otelgrpc.WithFilter(filters.Not(filters.HealthCheck())),
+	))
+}
+
+// DialOption - спаны исходящих gRPC вызовов и передача контекста трейса в metadata
+func DialOption() grpc.DialOption {
+	return grpc.WithStatsHandler(otelgrpc.NewClientHandler(
+		otelgrpc.WithFilter(filters.Not(filters.HealthCheck())),
+	))
+}
+
+// Tracer - трейсер для ручных спанов пакета name
+func Tracer(name string) trace.Tracer {
+	return otel.Tracer(name)
+}
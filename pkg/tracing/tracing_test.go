@@ -0,0 +1,57 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"gotest.tools/v3/assert"
+)
+
+func TestKafkaHeadersCarryTraceContext(t *testing.T) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	provider := sdktrace.NewTracerProvider()
+
+	ctx, span := provider.Tracer("test").Start(context.Background(), "publish")
+	defer span.End()
+
+	msg := kafka.Message{Headers: []kafka.Header{{Key: "traceparent", Value: []byte("stale")}}}
+	InjectKafka(ctx, &msg)
+	InjectKafka(ctx, &msg)
+
+	assert.Equal(t, 1, len(msg.Headers))
+
+	extracted := trace.SpanContextFromContext(ExtractKafka(context.Background(), &msg))
+	assert.Equal(t, span.SpanContext().TraceID(), extracted.TraceID())
+	assert.Equal(t, span.SpanContext().SpanID(), extracted.SpanID())
+	assert.Assert(t, extracted.IsRemote())
+}
+
+func TestSamplerDropsBackgroundRootClientSpans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithSampler(newSampler(0)),
+		sdktrace.WithSpanProcessor(recorder),
+	)
+	tracer := provider.Tracer("test")
+
+	// ping Redis из health check - корневой клиентский спан
+	_, ping := tracer.Start(context.Background(), "redis ping", trace.WithSpanKind(trace.SpanKindClient))
+	ping.End()
+
+	ctx, request := tracer.Start(context.Background(), "GET /api/search/news", trace.WithSpanKind(trace.SpanKindServer))
+	_, get := tracer.Start(ctx, "redis get", trace.WithSpanKind(trace.SpanKindClient))
+	get.End()
+	request.End()
+
+	var names []string
+	for _, span := range recorder.Ended() {
+		names = append(names, span.Name())
+	}
+	assert.DeepEqual(t, []string{"redis get", "GET /api/search/news"}, names)
+}
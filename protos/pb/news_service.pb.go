@@ -7,6 +7,8 @@
 package pb
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -23,15 +25,17 @@ const (
 
 // Common Messages
 type News struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
-	Author        string                 `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
-	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Url           string                 `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	PublishedAt   string                 `protobuf:"bytes,8,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Source      string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Author      string                 `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Title       string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Url         string                 `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	ImageUrl    string                 `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	PublishedAt string                 `protobuf:"bytes,8,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	// Текст статьи; смещения выделений отсчитываются в символах от его начала.
+	Content       string `protobuf:"bytes,9,opt,name=content,proto3" json:"content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -122,27 +126,45 @@ func (x *News) GetPublishedAt() string {
 	return ""
 }
 
-type CreateUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *News) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
 }
 
-func (x *CreateUserRequest) Reset() {
-	*x = CreateUserRequest{}
+// Профиль пользователя. default_filters подставляются в SearchNews/GetTopHeadlines,
+// если в запросе соответствующее поле не задано.
+type User struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Handle         string                 `protobuf:"bytes,2,opt,name=handle,proto3" json:"handle,omitempty"`
+	Email          string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	DisplayName    string                 `protobuf:"bytes,4,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Language       string                 `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
+	Country        string                 `protobuf:"bytes,6,opt,name=country,proto3" json:"country,omitempty"`
+	Timezone       string                 `protobuf:"bytes,7,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	DefaultFilters *SearchFilters         `protobuf:"bytes,8,opt,name=default_filters,json=defaultFilters,proto3" json:"default_filters,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
 	mi := &file_news_service_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUserRequest) String() string {
+func (x *User) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserRequest) ProtoMessage() {}
+func (*User) ProtoMessage() {}
 
-func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+func (x *User) ProtoReflect() protoreflect.Message {
 	mi := &file_news_service_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -154,39 +176,109 @@ func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
-func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
 	return file_news_service_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *CreateUserRequest) GetName() string {
+func (x *User) GetId() uint64 {
 	if x != nil {
-		return x.Name
+		return x.Id
+	}
+	return 0
+}
+
+func (x *User) GetHandle() string {
+	if x != nil {
+		return x.Handle
 	}
 	return ""
 }
 
-type CreateUserResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
 }
 
-func (x *CreateUserResponse) Reset() {
-	*x = CreateUserResponse{}
+func (x *User) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *User) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *User) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *User) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *User) GetDefaultFilters() *SearchFilters {
+	if x != nil {
+		return x.DefaultFilters
+	}
+	return nil
+}
+
+func (x *User) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *User) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type CreateUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Отображаемое имя; если handle не задан, он строится из имени.
+	Name           string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Handle         string         `protobuf:"bytes,2,opt,name=handle,proto3" json:"handle,omitempty"`
+	Email          string         `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Language       string         `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`
+	Country        string         `protobuf:"bytes,5,opt,name=country,proto3" json:"country,omitempty"`
+	Timezone       string         `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	DefaultFilters *SearchFilters `protobuf:"bytes,7,opt,name=default_filters,json=defaultFilters,proto3" json:"default_filters,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateUserRequest) Reset() {
+	*x = CreateUserRequest{}
 	mi := &file_news_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUserResponse) String() string {
+func (x *CreateUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserResponse) ProtoMessage() {}
+func (*CreateUserRequest) ProtoMessage() {}
 
-func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_news_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -198,39 +290,82 @@ func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
-func (*CreateUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
 	return file_news_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CreateUserResponse) GetUserId() uint64 {
+func (x *CreateUserRequest) GetName() string {
 	if x != nil {
-		return x.UserId
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-type SaveNewsRequest struct {
+func (x *CreateUserRequest) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetDefaultFilters() *SearchFilters {
+	if x != nil {
+		return x.DefaultFilters
+	}
+	return nil
+}
+
+type CreateUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	News          []*News                `protobuf:"bytes,1,rep,name=news,proto3" json:"news,omitempty"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SaveNewsRequest) Reset() {
-	*x = SaveNewsRequest{}
+func (x *CreateUserResponse) Reset() {
+	*x = CreateUserResponse{}
 	mi := &file_news_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveNewsRequest) String() string {
+func (x *CreateUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveNewsRequest) ProtoMessage() {}
+func (*CreateUserResponse) ProtoMessage() {}
 
-func (x *SaveNewsRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_news_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -242,39 +377,48 @@ func (x *SaveNewsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveNewsRequest.ProtoReflect.Descriptor instead.
-func (*SaveNewsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
+func (*CreateUserResponse) Descriptor() ([]byte, []int) {
 	return file_news_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *SaveNewsRequest) GetNews() []*News {
+func (x *CreateUserResponse) GetUserId() uint64 {
 	if x != nil {
-		return x.News
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
 	}
 	return nil
 }
 
-type SaveNewsResponse struct {
+// Пользователь по id или по handle.
+type GetUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Handle        string                 `protobuf:"bytes,2,opt,name=handle,proto3" json:"handle,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SaveNewsResponse) Reset() {
-	*x = SaveNewsResponse{}
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
 	mi := &file_news_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveNewsResponse) String() string {
+func (x *GetUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveNewsResponse) ProtoMessage() {}
+func (*GetUserRequest) ProtoMessage() {}
 
-func (x *SaveNewsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_news_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -286,39 +430,56 @@ func (x *SaveNewsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveNewsResponse.ProtoReflect.Descriptor instead.
-func (*SaveNewsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
 	return file_news_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *SaveNewsResponse) GetSuccess() bool {
+func (x *GetUserRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.Success
+		return x.UserId
 	}
-	return false
+	return 0
 }
 
-type GetNewsByIDsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ids           []uint64               `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetUserRequest) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
 }
 
-func (x *GetNewsByIDsRequest) Reset() {
-	*x = GetNewsByIDsRequest{}
+// Частичное изменение профиля: меняются только заданные поля,
+// пустая строка очищает email, язык, страну и часовой пояс.
+type UpdateUserRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	UserId      uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Handle      *string                `protobuf:"bytes,2,opt,name=handle,proto3,oneof" json:"handle,omitempty"`
+	Email       *string                `protobuf:"bytes,3,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	DisplayName *string                `protobuf:"bytes,4,opt,name=display_name,json=displayName,proto3,oneof" json:"display_name,omitempty"`
+	Language    *string                `protobuf:"bytes,5,opt,name=language,proto3,oneof" json:"language,omitempty"`
+	Country     *string                `protobuf:"bytes,6,opt,name=country,proto3,oneof" json:"country,omitempty"`
+	Timezone    *string                `protobuf:"bytes,7,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
+	// Если задан - заменяет фильтры по умолчанию целиком.
+	DefaultFilters *SearchFilters `protobuf:"bytes,8,opt,name=default_filters,json=defaultFilters,proto3" json:"default_filters,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateUserRequest) Reset() {
+	*x = UpdateUserRequest{}
 	mi := &file_news_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNewsByIDsRequest) String() string {
+func (x *UpdateUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNewsByIDsRequest) ProtoMessage() {}
+func (*UpdateUserRequest) ProtoMessage() {}
 
-func (x *GetNewsByIDsRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_news_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -330,39 +491,88 @@ func (x *GetNewsByIDsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNewsByIDsRequest.ProtoReflect.Descriptor instead.
-func (*GetNewsByIDsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
 	return file_news_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetNewsByIDsRequest) GetIds() []uint64 {
+func (x *UpdateUserRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.Ids
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateUserRequest) GetHandle() string {
+	if x != nil && x.Handle != nil {
+		return *x.Handle
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetDisplayName() string {
+	if x != nil && x.DisplayName != nil {
+		return *x.DisplayName
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetLanguage() string {
+	if x != nil && x.Language != nil {
+		return *x.Language
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetCountry() string {
+	if x != nil && x.Country != nil {
+		return *x.Country
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetDefaultFilters() *SearchFilters {
+	if x != nil {
+		return x.DefaultFilters
 	}
 	return nil
 }
 
-type GetNewsByIDsResponse struct {
+type UserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	News          []*News                `protobuf:"bytes,1,rep,name=news,proto3" json:"news,omitempty"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNewsByIDsResponse) Reset() {
-	*x = GetNewsByIDsResponse{}
+func (x *UserResponse) Reset() {
+	*x = UserResponse{}
 	mi := &file_news_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNewsByIDsResponse) String() string {
+func (x *UserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNewsByIDsResponse) ProtoMessage() {}
+func (*UserResponse) ProtoMessage() {}
 
-func (x *GetNewsByIDsResponse) ProtoReflect() protoreflect.Message {
+func (x *UserResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_news_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -374,40 +584,39 @@ func (x *GetNewsByIDsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNewsByIDsResponse.ProtoReflect.Descriptor instead.
-func (*GetNewsByIDsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserResponse.ProtoReflect.Descriptor instead.
+func (*UserResponse) Descriptor() ([]byte, []int) {
 	return file_news_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetNewsByIDsResponse) GetNews() []*News {
+func (x *UserResponse) GetUser() *User {
 	if x != nil {
-		return x.News
+		return x.User
 	}
 	return nil
 }
 
-type AddFavouriteRequest struct {
+type SaveNewsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	NewsId        uint64                 `protobuf:"varint,2,opt,name=news_id,json=newsId,proto3" json:"news_id,omitempty"`
+	News          []*News                `protobuf:"bytes,1,rep,name=news,proto3" json:"news,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddFavouriteRequest) Reset() {
-	*x = AddFavouriteRequest{}
+func (x *SaveNewsRequest) Reset() {
+	*x = SaveNewsRequest{}
 	mi := &file_news_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddFavouriteRequest) String() string {
+func (x *SaveNewsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddFavouriteRequest) ProtoMessage() {}
+func (*SaveNewsRequest) ProtoMessage() {}
 
-func (x *AddFavouriteRequest) ProtoReflect() protoreflect.Message {
+func (x *SaveNewsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_news_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -419,46 +628,41 @@ func (x *AddFavouriteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddFavouriteRequest.ProtoReflect.Descriptor instead.
-func (*AddFavouriteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SaveNewsRequest.ProtoReflect.Descriptor instead.
+func (*SaveNewsRequest) Descriptor() ([]byte, []int) {
 	return file_news_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *AddFavouriteRequest) GetUserId() uint64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *AddFavouriteRequest) GetNewsId() uint64 {
+func (x *SaveNewsRequest) GetNews() []*News {
 	if x != nil {
-		return x.NewsId
+		return x.News
 	}
-	return 0
+	return nil
 }
 
-type AddFavouriteResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+type SaveNewsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// ID сохранённых новостей в порядке запроса.
+	Ids           []uint64 `protobuf:"varint,2,rep,packed,name=ids,proto3" json:"ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddFavouriteResponse) Reset() {
-	*x = AddFavouriteResponse{}
+func (x *SaveNewsResponse) Reset() {
+	*x = SaveNewsResponse{}
 	mi := &file_news_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddFavouriteResponse) String() string {
+func (x *SaveNewsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddFavouriteResponse) ProtoMessage() {}
+func (*SaveNewsResponse) ProtoMessage() {}
 
-func (x *AddFavouriteResponse) ProtoReflect() protoreflect.Message {
+func (x *SaveNewsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_news_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -470,39 +674,46 @@ func (x *AddFavouriteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddFavouriteResponse.ProtoReflect.Descriptor instead.
-func (*AddFavouriteResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SaveNewsResponse.ProtoReflect.Descriptor instead.
+func (*SaveNewsResponse) Descriptor() ([]byte, []int) {
 	return file_news_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *AddFavouriteResponse) GetSuccess() bool {
+func (x *SaveNewsResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-type GetFavouritesRequest struct {
+func (x *SaveNewsResponse) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type GetNewsByIDsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Ids           []uint64               `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFavouritesRequest) Reset() {
-	*x = GetFavouritesRequest{}
+func (x *GetNewsByIDsRequest) Reset() {
+	*x = GetNewsByIDsRequest{}
 	mi := &file_news_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFavouritesRequest) String() string {
+func (x *GetNewsByIDsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFavouritesRequest) ProtoMessage() {}
+func (*GetNewsByIDsRequest) ProtoMessage() {}
 
-func (x *GetFavouritesRequest) ProtoReflect() protoreflect.Message {
+func (x *GetNewsByIDsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_news_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -514,39 +725,39 @@ func (x *GetFavouritesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFavouritesRequest.ProtoReflect.Descriptor instead.
-func (*GetFavouritesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetNewsByIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetNewsByIDsRequest) Descriptor() ([]byte, []int) {
 	return file_news_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetFavouritesRequest) GetUserId() uint64 {
+func (x *GetNewsByIDsRequest) GetIds() []uint64 {
 	if x != nil {
-		return x.UserId
+		return x.Ids
 	}
-	return 0
+	return nil
 }
 
-type GetFavouritesResponse struct {
+type GetNewsByIDsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	News          []*News                `protobuf:"bytes,1,rep,name=news,proto3" json:"news,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFavouritesResponse) Reset() {
-	*x = GetFavouritesResponse{}
+func (x *GetNewsByIDsResponse) Reset() {
+	*x = GetNewsByIDsResponse{}
 	mi := &file_news_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFavouritesResponse) String() string {
+func (x *GetNewsByIDsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFavouritesResponse) ProtoMessage() {}
+func (*GetNewsByIDsResponse) ProtoMessage() {}
 
-func (x *GetFavouritesResponse) ProtoReflect() protoreflect.Message {
+func (x *GetNewsByIDsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_news_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -558,41 +769,40 @@ func (x *GetFavouritesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFavouritesResponse.ProtoReflect.Descriptor instead.
-func (*GetFavouritesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetNewsByIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetNewsByIDsResponse) Descriptor() ([]byte, []int) {
 	return file_news_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetFavouritesResponse) GetNews() []*News {
+func (x *GetNewsByIDsResponse) GetNews() []*News {
 	if x != nil {
 		return x.News
 	}
 	return nil
 }
 
-type AddToSearchHistoryRequest struct {
+type AddFavouriteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
-	Results       []uint64               `protobuf:"varint,3,rep,packed,name=results,proto3" json:"results,omitempty"`
+	NewsId        uint64                 `protobuf:"varint,2,opt,name=news_id,json=newsId,proto3" json:"news_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddToSearchHistoryRequest) Reset() {
-	*x = AddToSearchHistoryRequest{}
+func (x *AddFavouriteRequest) Reset() {
+	*x = AddFavouriteRequest{}
 	mi := &file_news_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddToSearchHistoryRequest) String() string {
+func (x *AddFavouriteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddToSearchHistoryRequest) ProtoMessage() {}
+func (*AddFavouriteRequest) ProtoMessage() {}
 
-func (x *AddToSearchHistoryRequest) ProtoReflect() protoreflect.Message {
+func (x *AddFavouriteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_news_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -604,53 +814,46 @@ func (x *AddToSearchHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddToSearchHistoryRequest.ProtoReflect.Descriptor instead.
-func (*AddToSearchHistoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddFavouriteRequest.ProtoReflect.Descriptor instead.
+func (*AddFavouriteRequest) Descriptor() ([]byte, []int) {
 	return file_news_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *AddToSearchHistoryRequest) GetUserId() uint64 {
+func (x *AddFavouriteRequest) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *AddToSearchHistoryRequest) GetQuery() string {
-	if x != nil {
-		return x.Query
-	}
-	return ""
-}
-
-func (x *AddToSearchHistoryRequest) GetResults() []uint64 {
+func (x *AddFavouriteRequest) GetNewsId() uint64 {
 	if x != nil {
-		return x.Results
+		return x.NewsId
 	}
-	return nil
+	return 0
 }
 
-type AddToSearchHistoryResponse struct {
+type AddFavouriteResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddToSearchHistoryResponse) Reset() {
-	*x = AddToSearchHistoryResponse{}
+func (x *AddFavouriteResponse) Reset() {
+	*x = AddFavouriteResponse{}
 	mi := &file_news_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddToSearchHistoryResponse) String() string {
+func (x *AddFavouriteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddToSearchHistoryResponse) ProtoMessage() {}
+func (*AddFavouriteResponse) ProtoMessage() {}
 
-func (x *AddToSearchHistoryResponse) ProtoReflect() protoreflect.Message {
+func (x *AddFavouriteResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_news_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -662,39 +865,45 @@ func (x *AddToSearchHistoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddToSearchHistoryResponse.ProtoReflect.Descriptor instead.
-func (*AddToSearchHistoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddFavouriteResponse.ProtoReflect.Descriptor instead.
+func (*AddFavouriteResponse) Descriptor() ([]byte, []int) {
 	return file_news_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *AddToSearchHistoryResponse) GetSuccess() bool {
+func (x *AddFavouriteResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-type GetSearchHistoryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+type GetFavouritesRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Курсор из next_page_token предыдущего ответа; пусто - первая страница.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// 0 - размер страницы по умолчанию; больше максимума - урезается до максимума.
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Пропускать новости, которые пользователь уже просмотрел.
+	UnseenOnly    bool `protobuf:"varint,4,opt,name=unseen_only,json=unseenOnly,proto3" json:"unseen_only,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSearchHistoryRequest) Reset() {
-	*x = GetSearchHistoryRequest{}
+func (x *GetFavouritesRequest) Reset() {
+	*x = GetFavouritesRequest{}
 	mi := &file_news_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSearchHistoryRequest) String() string {
+func (x *GetFavouritesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSearchHistoryRequest) ProtoMessage() {}
+func (*GetFavouritesRequest) ProtoMessage() {}
 
-func (x *GetSearchHistoryRequest) ProtoReflect() protoreflect.Message {
+func (x *GetFavouritesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_news_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -706,39 +915,62 @@ func (x *GetSearchHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSearchHistoryRequest.ProtoReflect.Descriptor instead.
-func (*GetSearchHistoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetFavouritesRequest.ProtoReflect.Descriptor instead.
+func (*GetFavouritesRequest) Descriptor() ([]byte, []int) {
 	return file_news_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetSearchHistoryRequest) GetUserId() uint64 {
+func (x *GetFavouritesRequest) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-type GetSearchHistoryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Queries       []string               `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+func (x *GetFavouritesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetFavouritesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetFavouritesRequest) GetUnseenOnly() bool {
+	if x != nil {
+		return x.UnseenOnly
+	}
+	return false
+}
+
+type GetFavouritesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	News  []*News                `protobuf:"bytes,1,rep,name=news,proto3" json:"news,omitempty"`
+	// Пусто, если страниц больше нет.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSearchHistoryResponse) Reset() {
-	*x = GetSearchHistoryResponse{}
+func (x *GetFavouritesResponse) Reset() {
+	*x = GetFavouritesResponse{}
 	mi := &file_news_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSearchHistoryResponse) String() string {
+func (x *GetFavouritesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSearchHistoryResponse) ProtoMessage() {}
+func (*GetFavouritesResponse) ProtoMessage() {}
 
-func (x *GetSearchHistoryResponse) ProtoReflect() protoreflect.Message {
+func (x *GetFavouritesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_news_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -750,41 +982,4841 @@ func (x *GetSearchHistoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSearchHistoryResponse.ProtoReflect.Descriptor instead.
-func (*GetSearchHistoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetFavouritesResponse.ProtoReflect.Descriptor instead.
+func (*GetFavouritesResponse) Descriptor() ([]byte, []int) {
 	return file_news_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetSearchHistoryResponse) GetQueries() []string {
+func (x *GetFavouritesResponse) GetNews() []*News {
+	if x != nil {
+		return x.News
+	}
+	return nil
+}
+
+func (x *GetFavouritesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type AddToSearchHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Results       []uint64               `protobuf:"varint,3,rep,packed,name=results,proto3" json:"results,omitempty"`
+	Filters       *SearchFilters         `protobuf:"bytes,4,opt,name=filters,proto3" json:"filters,omitempty"`
+	TotalResults  int32                  `protobuf:"varint,5,opt,name=total_results,json=totalResults,proto3" json:"total_results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddToSearchHistoryRequest) Reset() {
+	*x = AddToSearchHistoryRequest{}
+	mi := &file_news_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddToSearchHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToSearchHistoryRequest) ProtoMessage() {}
+
+func (x *AddToSearchHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToSearchHistoryRequest.ProtoReflect.Descriptor instead.
+func (*AddToSearchHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AddToSearchHistoryRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AddToSearchHistoryRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *AddToSearchHistoryRequest) GetResults() []uint64 {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *AddToSearchHistoryRequest) GetFilters() *SearchFilters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *AddToSearchHistoryRequest) GetTotalResults() int32 {
+	if x != nil {
+		return x.TotalResults
+	}
+	return 0
+}
+
+// Параметры поиска помимо самого запроса - те же, что в SearchNewsRequest.
+type SearchFilters struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sources       *string                `protobuf:"bytes,1,opt,name=sources,proto3,oneof" json:"sources,omitempty"`
+	Domains       *string                `protobuf:"bytes,2,opt,name=domains,proto3,oneof" json:"domains,omitempty"`
+	From          *string                `protobuf:"bytes,3,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To            *string                `protobuf:"bytes,4,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	Language      *string                `protobuf:"bytes,5,opt,name=language,proto3,oneof" json:"language,omitempty"`
+	SortBy        *string                `protobuf:"bytes,6,opt,name=sort_by,json=sortBy,proto3,oneof" json:"sort_by,omitempty"`
+	PageSize      *int32                 `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	Page          *int32                 `protobuf:"varint,8,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchFilters) Reset() {
+	*x = SearchFilters{}
+	mi := &file_news_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchFilters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFilters) ProtoMessage() {}
+
+func (x *SearchFilters) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFilters.ProtoReflect.Descriptor instead.
+func (*SearchFilters) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SearchFilters) GetSources() string {
+	if x != nil && x.Sources != nil {
+		return *x.Sources
+	}
+	return ""
+}
+
+func (x *SearchFilters) GetDomains() string {
+	if x != nil && x.Domains != nil {
+		return *x.Domains
+	}
+	return ""
+}
+
+func (x *SearchFilters) GetFrom() string {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return ""
+}
+
+func (x *SearchFilters) GetTo() string {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	return ""
+}
+
+func (x *SearchFilters) GetLanguage() string {
+	if x != nil && x.Language != nil {
+		return *x.Language
+	}
+	return ""
+}
+
+func (x *SearchFilters) GetSortBy() string {
+	if x != nil && x.SortBy != nil {
+		return *x.SortBy
+	}
+	return ""
+}
+
+func (x *SearchFilters) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchFilters) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+type SearchHistoryEntry struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Query        string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	SearchedAt   string                 `protobuf:"bytes,3,opt,name=searched_at,json=searchedAt,proto3" json:"searched_at,omitempty"`
+	Filters      *SearchFilters         `protobuf:"bytes,4,opt,name=filters,proto3" json:"filters,omitempty"`
+	TotalResults int32                  `protobuf:"varint,5,opt,name=total_results,json=totalResults,proto3" json:"total_results,omitempty"`
+	ResultIds    []uint64               `protobuf:"varint,6,rep,packed,name=result_ids,json=resultIds,proto3" json:"result_ids,omitempty"`
+	// Сколько раз выполнялся такой же поиск (только для unique_queries).
+	Occurrences   int32 `protobuf:"varint,7,opt,name=occurrences,proto3" json:"occurrences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHistoryEntry) Reset() {
+	*x = SearchHistoryEntry{}
+	mi := &file_news_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHistoryEntry) ProtoMessage() {}
+
+func (x *SearchHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHistoryEntry.ProtoReflect.Descriptor instead.
+func (*SearchHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SearchHistoryEntry) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SearchHistoryEntry) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchHistoryEntry) GetSearchedAt() string {
+	if x != nil {
+		return x.SearchedAt
+	}
+	return ""
+}
+
+func (x *SearchHistoryEntry) GetFilters() *SearchFilters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *SearchHistoryEntry) GetTotalResults() int32 {
+	if x != nil {
+		return x.TotalResults
+	}
+	return 0
+}
+
+func (x *SearchHistoryEntry) GetResultIds() []uint64 {
+	if x != nil {
+		return x.ResultIds
+	}
+	return nil
+}
+
+func (x *SearchHistoryEntry) GetOccurrences() int32 {
+	if x != nil {
+		return x.Occurrences
+	}
+	return 0
+}
+
+type AddToSearchHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddToSearchHistoryResponse) Reset() {
+	*x = AddToSearchHistoryResponse{}
+	mi := &file_news_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddToSearchHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToSearchHistoryResponse) ProtoMessage() {}
+
+func (x *AddToSearchHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToSearchHistoryResponse.ProtoReflect.Descriptor instead.
+func (*AddToSearchHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AddToSearchHistoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetSearchHistoryRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Схлопывать повторы одного и того же поиска (запрос + фильтры) в одну запись.
+	UniqueQueries bool `protobuf:"varint,4,opt,name=unique_queries,json=uniqueQueries,proto3" json:"unique_queries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSearchHistoryRequest) Reset() {
+	*x = GetSearchHistoryRequest{}
+	mi := &file_news_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSearchHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSearchHistoryRequest) ProtoMessage() {}
+
+func (x *GetSearchHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSearchHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetSearchHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetSearchHistoryRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetSearchHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetSearchHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetSearchHistoryRequest) GetUniqueQueries() bool {
+	if x != nil {
+		return x.UniqueQueries
+	}
+	return false
+}
+
+type GetSearchHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Устарело: только тексты запросов, см. entries.
+	Queries       []string              `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+	NextPageToken string                `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Entries       []*SearchHistoryEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSearchHistoryResponse) Reset() {
+	*x = GetSearchHistoryResponse{}
+	mi := &file_news_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSearchHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSearchHistoryResponse) ProtoMessage() {}
+
+func (x *GetSearchHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSearchHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetSearchHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetSearchHistoryResponse) GetQueries() []string {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+func (x *GetSearchHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetSearchHistoryResponse) GetEntries() []*SearchHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type GetSearchHistoryEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SearchId      uint64                 `protobuf:"varint,2,opt,name=search_id,json=searchId,proto3" json:"search_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSearchHistoryEntryRequest) Reset() {
+	*x = GetSearchHistoryEntryRequest{}
+	mi := &file_news_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSearchHistoryEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSearchHistoryEntryRequest) ProtoMessage() {}
+
+func (x *GetSearchHistoryEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSearchHistoryEntryRequest.ProtoReflect.Descriptor instead.
+func (*GetSearchHistoryEntryRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetSearchHistoryEntryRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetSearchHistoryEntryRequest) GetSearchId() uint64 {
+	if x != nil {
+		return x.SearchId
+	}
+	return 0
+}
+
+type GetSearchHistoryEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *SearchHistoryEntry    `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSearchHistoryEntryResponse) Reset() {
+	*x = GetSearchHistoryEntryResponse{}
+	mi := &file_news_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSearchHistoryEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSearchHistoryEntryResponse) ProtoMessage() {}
+
+func (x *GetSearchHistoryEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSearchHistoryEntryResponse.ProtoReflect.Descriptor instead.
+func (*GetSearchHistoryEntryResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetSearchHistoryEntryResponse) GetEntry() *SearchHistoryEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type SubscribeRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	UserId  uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Keyword string                 `protobuf:"bytes,2,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	// Расписание проверок: cron из пяти полей ("0 */2 * * *"), @hourly/@daily или @every 30m
+	// (не чаще раза в минуту); пусто - общий интервал notify service.
+	Schedule      string `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	mi := &file_news_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SubscribeRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SubscribeRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SubscribeRequest) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+type SubscribeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeResponse) Reset() {
+	*x = SubscribeResponse{}
+	mi := &file_news_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeResponse) ProtoMessage() {}
+
+func (x *SubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SubscribeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetSubscriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSubscriptionsRequest) Reset() {
+	*x = GetSubscriptionsRequest{}
+	mi := &file_news_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscriptionsRequest) ProtoMessage() {}
+
+func (x *GetSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetSubscriptionsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetSubscriptionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetSubscriptionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetSubscriptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscriptions []*Subscription        `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSubscriptionsResponse) Reset() {
+	*x = GetSubscriptionsResponse{}
+	mi := &file_news_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscriptionsResponse) ProtoMessage() {}
+
+func (x *GetSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetSubscriptionsResponse) GetSubscriptions() []*Subscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+func (x *GetSubscriptionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Подписки всех пользователей.
+type ListAllSubscriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageToken     string                 `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllSubscriptionsRequest) Reset() {
+	*x = ListAllSubscriptionsRequest{}
+	mi := &file_news_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListAllSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListAllSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListAllSubscriptionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAllSubscriptionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type Subscription struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Keyword       string                 `protobuf:"bytes,3,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	Schedule      string                 `protobuf:"bytes,4,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	mi := &file_news_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Subscription) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Subscription) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Subscription) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *Subscription) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+// Пустое расписание возвращает подписку к общему интервалу.
+type UpdateSubscriptionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SubscriptionId uint64                 `protobuf:"varint,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	// Расписание проверок: cron из пяти полей ("0 */2 * * *"), @hourly/@daily или @every 30m
+	// (не чаще раза в минуту); пусто - общий интервал notify service.
+	Schedule      string `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSubscriptionRequest) Reset() {
+	*x = UpdateSubscriptionRequest{}
+	mi := &file_news_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSubscriptionRequest) ProtoMessage() {}
+
+func (x *UpdateSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateSubscriptionRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateSubscriptionRequest) GetSubscriptionId() uint64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *UpdateSubscriptionRequest) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+type SubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *Subscription          `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscriptionResponse) Reset() {
+	*x = SubscriptionResponse{}
+	mi := &file_news_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionResponse) ProtoMessage() {}
+
+func (x *SubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*SubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SubscriptionResponse) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+// Сохранённый поиск: именованный SearchNewsRequest с необязательным расписанием обновления.
+type SavedSearch struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId  uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name    string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Query   string                 `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	Filters *SearchFilters         `protobuf:"bytes,5,opt,name=filters,proto3" json:"filters,omitempty"`
+	// 0 - без расписания, только ручной запуск.
+	RefreshIntervalMinutes int32 `protobuf:"varint,6,opt,name=refresh_interval_minutes,json=refreshIntervalMinutes,proto3" json:"refresh_interval_minutes,omitempty"`
+	// Отправлять уведомление о новых результатах после обновления по расписанию.
+	Notify bool `protobuf:"varint,7,opt,name=notify,proto3" json:"notify,omitempty"`
+	// Пусто, если поиск ещё не запускался.
+	LastRunAt     string   `protobuf:"bytes,8,opt,name=last_run_at,json=lastRunAt,proto3" json:"last_run_at,omitempty"`
+	LastResultIds []uint64 `protobuf:"varint,9,rep,packed,name=last_result_ids,json=lastResultIds,proto3" json:"last_result_ids,omitempty"`
+	// Результаты последнего запуска, которых не было в предыдущем.
+	NewResultIds  []uint64 `protobuf:"varint,10,rep,packed,name=new_result_ids,json=newResultIds,proto3" json:"new_result_ids,omitempty"`
+	CreatedAt     string   `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedSearch) Reset() {
+	*x = SavedSearch{}
+	mi := &file_news_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedSearch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearch) ProtoMessage() {}
+
+func (x *SavedSearch) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearch.ProtoReflect.Descriptor instead.
+func (*SavedSearch) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SavedSearch) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SavedSearch) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SavedSearch) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetFilters() *SearchFilters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *SavedSearch) GetRefreshIntervalMinutes() int32 {
+	if x != nil {
+		return x.RefreshIntervalMinutes
+	}
+	return 0
+}
+
+func (x *SavedSearch) GetNotify() bool {
+	if x != nil {
+		return x.Notify
+	}
+	return false
+}
+
+func (x *SavedSearch) GetLastRunAt() string {
+	if x != nil {
+		return x.LastRunAt
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetLastResultIds() []uint64 {
+	if x != nil {
+		return x.LastResultIds
+	}
+	return nil
+}
+
+func (x *SavedSearch) GetNewResultIds() []uint64 {
+	if x != nil {
+		return x.NewResultIds
+	}
+	return nil
+}
+
+func (x *SavedSearch) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateSavedSearchRequest struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	UserId                 uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name                   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Query                  string                 `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	Filters                *SearchFilters         `protobuf:"bytes,4,opt,name=filters,proto3" json:"filters,omitempty"`
+	RefreshIntervalMinutes int32                  `protobuf:"varint,5,opt,name=refresh_interval_minutes,json=refreshIntervalMinutes,proto3" json:"refresh_interval_minutes,omitempty"`
+	Notify                 bool                   `protobuf:"varint,6,opt,name=notify,proto3" json:"notify,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CreateSavedSearchRequest) Reset() {
+	*x = CreateSavedSearchRequest{}
+	mi := &file_news_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSavedSearchRequest) ProtoMessage() {}
+
+func (x *CreateSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*CreateSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateSavedSearchRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateSavedSearchRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSavedSearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *CreateSavedSearchRequest) GetFilters() *SearchFilters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *CreateSavedSearchRequest) GetRefreshIntervalMinutes() int32 {
+	if x != nil {
+		return x.RefreshIntervalMinutes
+	}
+	return 0
+}
+
+func (x *CreateSavedSearchRequest) GetNotify() bool {
+	if x != nil {
+		return x.Notify
+	}
+	return false
+}
+
+type GetSavedSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            uint64                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSavedSearchRequest) Reset() {
+	*x = GetSavedSearchRequest{}
+	mi := &file_news_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSavedSearchRequest) ProtoMessage() {}
+
+func (x *GetSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*GetSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetSavedSearchRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetSavedSearchRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListSavedSearchesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Только поиски с расписанием, которые пора обновить.
+	DueOnly       bool `protobuf:"varint,4,opt,name=due_only,json=dueOnly,proto3" json:"due_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedSearchesRequest) Reset() {
+	*x = ListSavedSearchesRequest{}
+	mi := &file_news_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedSearchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedSearchesRequest) ProtoMessage() {}
+
+func (x *ListSavedSearchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedSearchesRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedSearchesRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListSavedSearchesRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListSavedSearchesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListSavedSearchesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSavedSearchesRequest) GetDueOnly() bool {
+	if x != nil {
+		return x.DueOnly
+	}
+	return false
+}
+
+type ListSavedSearchesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SavedSearches []*SavedSearch         `protobuf:"bytes,1,rep,name=saved_searches,json=savedSearches,proto3" json:"saved_searches,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedSearchesResponse) Reset() {
+	*x = ListSavedSearchesResponse{}
+	mi := &file_news_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedSearchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedSearchesResponse) ProtoMessage() {}
+
+func (x *ListSavedSearchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedSearchesResponse.ProtoReflect.Descriptor instead.
+func (*ListSavedSearchesResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListSavedSearchesResponse) GetSavedSearches() []*SavedSearch {
+	if x != nil {
+		return x.SavedSearches
+	}
+	return nil
+}
+
+func (x *ListSavedSearchesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Сохранённые поиски всех пользователей.
+type ListAllSavedSearchesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageToken string                 `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Только поиски с расписанием, которые пора обновить.
+	DueOnly       bool `protobuf:"varint,3,opt,name=due_only,json=dueOnly,proto3" json:"due_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllSavedSearchesRequest) Reset() {
+	*x = ListAllSavedSearchesRequest{}
+	mi := &file_news_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllSavedSearchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllSavedSearchesRequest) ProtoMessage() {}
+
+func (x *ListAllSavedSearchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllSavedSearchesRequest.ProtoReflect.Descriptor instead.
+func (*ListAllSavedSearchesRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListAllSavedSearchesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAllSavedSearchesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAllSavedSearchesRequest) GetDueOnly() bool {
+	if x != nil {
+		return x.DueOnly
+	}
+	return false
+}
+
+// Незаданные поля не меняются; filters, если задан, заменяется целиком.
+type UpdateSavedSearchRequest struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	UserId                 uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id                     uint64                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name                   *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Query                  *string                `protobuf:"bytes,4,opt,name=query,proto3,oneof" json:"query,omitempty"`
+	Filters                *SearchFilters         `protobuf:"bytes,5,opt,name=filters,proto3" json:"filters,omitempty"`
+	RefreshIntervalMinutes *int32                 `protobuf:"varint,6,opt,name=refresh_interval_minutes,json=refreshIntervalMinutes,proto3,oneof" json:"refresh_interval_minutes,omitempty"`
+	Notify                 *bool                  `protobuf:"varint,7,opt,name=notify,proto3,oneof" json:"notify,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UpdateSavedSearchRequest) Reset() {
+	*x = UpdateSavedSearchRequest{}
+	mi := &file_news_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSavedSearchRequest) ProtoMessage() {}
+
+func (x *UpdateSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UpdateSavedSearchRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateSavedSearchRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateSavedSearchRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateSavedSearchRequest) GetQuery() string {
+	if x != nil && x.Query != nil {
+		return *x.Query
+	}
+	return ""
+}
+
+func (x *UpdateSavedSearchRequest) GetFilters() *SearchFilters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *UpdateSavedSearchRequest) GetRefreshIntervalMinutes() int32 {
+	if x != nil && x.RefreshIntervalMinutes != nil {
+		return *x.RefreshIntervalMinutes
+	}
+	return 0
+}
+
+func (x *UpdateSavedSearchRequest) GetNotify() bool {
+	if x != nil && x.Notify != nil {
+		return *x.Notify
+	}
+	return false
+}
+
+type DeleteSavedSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            uint64                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSavedSearchRequest) Reset() {
+	*x = DeleteSavedSearchRequest{}
+	mi := &file_news_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavedSearchRequest) ProtoMessage() {}
+
+func (x *DeleteSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DeleteSavedSearchRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteSavedSearchRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteSavedSearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSavedSearchResponse) Reset() {
+	*x = DeleteSavedSearchResponse{}
+	mi := &file_news_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSavedSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavedSearchResponse) ProtoMessage() {}
+
+func (x *DeleteSavedSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavedSearchResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSavedSearchResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DeleteSavedSearchResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RecordSavedSearchRunRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ResultIds     []uint64               `protobuf:"varint,2,rep,packed,name=result_ids,json=resultIds,proto3" json:"result_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordSavedSearchRunRequest) Reset() {
+	*x = RecordSavedSearchRunRequest{}
+	mi := &file_news_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordSavedSearchRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordSavedSearchRunRequest) ProtoMessage() {}
+
+func (x *RecordSavedSearchRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordSavedSearchRunRequest.ProtoReflect.Descriptor instead.
+func (*RecordSavedSearchRunRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RecordSavedSearchRunRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RecordSavedSearchRunRequest) GetResultIds() []uint64 {
+	if x != nil {
+		return x.ResultIds
+	}
+	return nil
+}
+
+type SavedSearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SavedSearch   *SavedSearch           `protobuf:"bytes,1,opt,name=saved_search,json=savedSearch,proto3" json:"saved_search,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedSearchResponse) Reset() {
+	*x = SavedSearchResponse{}
+	mi := &file_news_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearchResponse) ProtoMessage() {}
+
+func (x *SavedSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearchResponse.ProtoReflect.Descriptor instead.
+func (*SavedSearchResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SavedSearchResponse) GetSavedSearch() *SavedSearch {
+	if x != nil {
+		return x.SavedSearch
+	}
+	return nil
+}
+
+// Коллекции избранного. Избранное пользователя - коллекция по умолчанию.
+// Во всех запросах collection_id = 0 означает коллекцию по умолчанию.
+type Collection struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId    uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	IsDefault bool                   `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	// Пусто, если коллекция не опубликована.
+	ShareToken    string `protobuf:"bytes,5,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
+	ItemCount     int32  `protobuf:"varint,6,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
+	CreatedAt     string `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Collection) Reset() {
+	*x = Collection{}
+	mi := &file_news_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Collection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collection) ProtoMessage() {}
+
+func (x *Collection) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collection.ProtoReflect.Descriptor instead.
+func (*Collection) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Collection) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Collection) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Collection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Collection) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *Collection) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+func (x *Collection) GetItemCount() int32 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+func (x *Collection) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectionResponse) Reset() {
+	*x = CollectionResponse{}
+	mi := &file_news_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionResponse) ProtoMessage() {}
+
+func (x *CollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionResponse.ProtoReflect.Descriptor instead.
+func (*CollectionResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CollectionResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+type CreateCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCollectionRequest) Reset() {
+	*x = CreateCollectionRequest{}
+	mi := &file_news_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionRequest) ProtoMessage() {}
+
+func (x *CreateCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionRequest.ProtoReflect.Descriptor instead.
+func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateCollectionRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateCollectionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListCollectionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionsRequest) Reset() {
+	*x = ListCollectionsRequest{}
+	mi := &file_news_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionsRequest) ProtoMessage() {}
+
+func (x *ListCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListCollectionsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListCollectionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListCollectionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListCollectionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collections   []*Collection          `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionsResponse) Reset() {
+	*x = ListCollectionsResponse{}
+	mi := &file_news_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionsResponse) ProtoMessage() {}
+
+func (x *ListCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListCollectionsResponse) GetCollections() []*Collection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+func (x *ListCollectionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RenameCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CollectionId  uint64                 `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameCollectionRequest) Reset() {
+	*x = RenameCollectionRequest{}
+	mi := &file_news_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameCollectionRequest) ProtoMessage() {}
+
+func (x *RenameCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameCollectionRequest.ProtoReflect.Descriptor instead.
+func (*RenameCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RenameCollectionRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RenameCollectionRequest) GetCollectionId() uint64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *RenameCollectionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CollectionId  uint64                 `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCollectionRequest) Reset() {
+	*x = DeleteCollectionRequest{}
+	mi := &file_news_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionRequest) ProtoMessage() {}
+
+func (x *DeleteCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DeleteCollectionRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteCollectionRequest) GetCollectionId() uint64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+type DeleteCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCollectionResponse) Reset() {
+	*x = DeleteCollectionResponse{}
+	mi := &file_news_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionResponse) ProtoMessage() {}
+
+func (x *DeleteCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DeleteCollectionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetCollectionItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CollectionId  uint64                 `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollectionItemsRequest) Reset() {
+	*x = GetCollectionItemsRequest{}
+	mi := &file_news_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollectionItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionItemsRequest) ProtoMessage() {}
+
+func (x *GetCollectionItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionItemsRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionItemsRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetCollectionItemsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetCollectionItemsRequest) GetCollectionId() uint64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *GetCollectionItemsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetCollectionItemsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type CollectionItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	News          []*News                `protobuf:"bytes,2,rep,name=news,proto3" json:"news,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectionItemsResponse) Reset() {
+	*x = CollectionItemsResponse{}
+	mi := &file_news_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionItemsResponse) ProtoMessage() {}
+
+func (x *CollectionItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionItemsResponse.ProtoReflect.Descriptor instead.
+func (*CollectionItemsResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CollectionItemsResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+func (x *CollectionItemsResponse) GetNews() []*News {
+	if x != nil {
+		return x.News
+	}
+	return nil
+}
+
+func (x *CollectionItemsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type AddToCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CollectionId  uint64                 `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	NewsId        uint64                 `protobuf:"varint,3,opt,name=news_id,json=newsId,proto3" json:"news_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddToCollectionRequest) Reset() {
+	*x = AddToCollectionRequest{}
+	mi := &file_news_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddToCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToCollectionRequest) ProtoMessage() {}
+
+func (x *AddToCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToCollectionRequest.ProtoReflect.Descriptor instead.
+func (*AddToCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *AddToCollectionRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AddToCollectionRequest) GetCollectionId() uint64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *AddToCollectionRequest) GetNewsId() uint64 {
+	if x != nil {
+		return x.NewsId
+	}
+	return 0
+}
+
+type AddToCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddToCollectionResponse) Reset() {
+	*x = AddToCollectionResponse{}
+	mi := &file_news_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddToCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToCollectionResponse) ProtoMessage() {}
+
+func (x *AddToCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToCollectionResponse.ProtoReflect.Descriptor instead.
+func (*AddToCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AddToCollectionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RemoveFromCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CollectionId  uint64                 `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	NewsId        uint64                 `protobuf:"varint,3,opt,name=news_id,json=newsId,proto3" json:"news_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFromCollectionRequest) Reset() {
+	*x = RemoveFromCollectionRequest{}
+	mi := &file_news_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFromCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromCollectionRequest) ProtoMessage() {}
+
+func (x *RemoveFromCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromCollectionRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFromCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RemoveFromCollectionRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RemoveFromCollectionRequest) GetCollectionId() uint64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *RemoveFromCollectionRequest) GetNewsId() uint64 {
+	if x != nil {
+		return x.NewsId
+	}
+	return 0
+}
+
+type RemoveFromCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFromCollectionResponse) Reset() {
+	*x = RemoveFromCollectionResponse{}
+	mi := &file_news_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFromCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromCollectionResponse) ProtoMessage() {}
+
+func (x *RemoveFromCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromCollectionResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFromCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RemoveFromCollectionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Копирует (или переносит при move = true) новости в другую коллекцию пользователя.
+type CopyCollectionItemsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FromCollectionId uint64                 `protobuf:"varint,2,opt,name=from_collection_id,json=fromCollectionId,proto3" json:"from_collection_id,omitempty"`
+	ToCollectionId   uint64                 `protobuf:"varint,3,opt,name=to_collection_id,json=toCollectionId,proto3" json:"to_collection_id,omitempty"`
+	NewsIds          []uint64               `protobuf:"varint,4,rep,packed,name=news_ids,json=newsIds,proto3" json:"news_ids,omitempty"`
+	Move             bool                   `protobuf:"varint,5,opt,name=move,proto3" json:"move,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CopyCollectionItemsRequest) Reset() {
+	*x = CopyCollectionItemsRequest{}
+	mi := &file_news_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyCollectionItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyCollectionItemsRequest) ProtoMessage() {}
+
+func (x *CopyCollectionItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyCollectionItemsRequest.ProtoReflect.Descriptor instead.
+func (*CopyCollectionItemsRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CopyCollectionItemsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CopyCollectionItemsRequest) GetFromCollectionId() uint64 {
+	if x != nil {
+		return x.FromCollectionId
+	}
+	return 0
+}
+
+func (x *CopyCollectionItemsRequest) GetToCollectionId() uint64 {
+	if x != nil {
+		return x.ToCollectionId
+	}
+	return 0
+}
+
+func (x *CopyCollectionItemsRequest) GetNewsIds() []uint64 {
+	if x != nil {
+		return x.NewsIds
+	}
+	return nil
+}
+
+func (x *CopyCollectionItemsRequest) GetMove() bool {
+	if x != nil {
+		return x.Move
+	}
+	return false
+}
+
+type CopyCollectionItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CopyCollectionItemsResponse) Reset() {
+	*x = CopyCollectionItemsResponse{}
+	mi := &file_news_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyCollectionItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyCollectionItemsResponse) ProtoMessage() {}
+
+func (x *CopyCollectionItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyCollectionItemsResponse.ProtoReflect.Descriptor instead.
+func (*CopyCollectionItemsResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CopyCollectionItemsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Перечисленные новости встают в начало в заданном порядке, остальные - следом в прежнем порядке.
+type ReorderCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CollectionId  uint64                 `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	NewsIds       []uint64               `protobuf:"varint,3,rep,packed,name=news_ids,json=newsIds,proto3" json:"news_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderCollectionRequest) Reset() {
+	*x = ReorderCollectionRequest{}
+	mi := &file_news_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderCollectionRequest) ProtoMessage() {}
+
+func (x *ReorderCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderCollectionRequest.ProtoReflect.Descriptor instead.
+func (*ReorderCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ReorderCollectionRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReorderCollectionRequest) GetCollectionId() uint64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *ReorderCollectionRequest) GetNewsIds() []uint64 {
+	if x != nil {
+		return x.NewsIds
+	}
+	return nil
+}
+
+type ReorderCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderCollectionResponse) Reset() {
+	*x = ReorderCollectionResponse{}
+	mi := &file_news_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderCollectionResponse) ProtoMessage() {}
+
+func (x *ReorderCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderCollectionResponse.ProtoReflect.Descriptor instead.
+func (*ReorderCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ReorderCollectionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// shared = true выдаёт новый токен ссылки только для чтения, false - отзывает его.
+type ShareCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CollectionId  uint64                 `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Shared        bool                   `protobuf:"varint,3,opt,name=shared,proto3" json:"shared,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareCollectionRequest) Reset() {
+	*x = ShareCollectionRequest{}
+	mi := &file_news_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareCollectionRequest) ProtoMessage() {}
+
+func (x *ShareCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareCollectionRequest.ProtoReflect.Descriptor instead.
+func (*ShareCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ShareCollectionRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ShareCollectionRequest) GetCollectionId() uint64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *ShareCollectionRequest) GetShared() bool {
+	if x != nil {
+		return x.Shared
+	}
+	return false
+}
+
+type GetSharedCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShareToken    string                 `protobuf:"bytes,1,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedCollectionRequest) Reset() {
+	*x = GetSharedCollectionRequest{}
+	mi := &file_news_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedCollectionRequest) ProtoMessage() {}
+
+func (x *GetSharedCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedCollectionRequest.ProtoReflect.Descriptor instead.
+func (*GetSharedCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetSharedCollectionRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+func (x *GetSharedCollectionRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetSharedCollectionRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// Пометки пользователя к сохранённой статье (статья должна быть хотя бы в одной его коллекции).
+type Highlight struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	NewsId uint64                 `protobuf:"varint,2,opt,name=news_id,json=newsId,proto3" json:"news_id,omitempty"`
+	// Смещения в символах в News.content, end_offset не включается.
+	StartOffset int32 `protobuf:"varint,3,opt,name=start_offset,json=startOffset,proto3" json:"start_offset,omitempty"`
+	EndOffset   int32 `protobuf:"varint,4,opt,name=end_offset,json=endOffset,proto3" json:"end_offset,omitempty"`
+	// Выделенный текст на момент создания.
+	Text          string `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	Comment       string `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatedAt     string `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Highlight) Reset() {
+	*x = Highlight{}
+	mi := &file_news_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Highlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Highlight) ProtoMessage() {}
+
+func (x *Highlight) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Highlight.ProtoReflect.Descriptor instead.
+func (*Highlight) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *Highlight) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Highlight) GetNewsId() uint64 {
+	if x != nil {
+		return x.NewsId
+	}
+	return 0
+}
+
+func (x *Highlight) GetStartOffset() int32 {
+	if x != nil {
+		return x.StartOffset
+	}
+	return 0
+}
+
+func (x *Highlight) GetEndOffset() int32 {
+	if x != nil {
+		return x.EndOffset
+	}
+	return 0
+}
+
+func (x *Highlight) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Highlight) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *Highlight) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type FavouriteAnnotation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NewsId        uint64                 `protobuf:"varint,1,opt,name=news_id,json=newsId,proto3" json:"news_id,omitempty"`
+	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	Highlights    []*Highlight           `protobuf:"bytes,4,rep,name=highlights,proto3" json:"highlights,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FavouriteAnnotation) Reset() {
+	*x = FavouriteAnnotation{}
+	mi := &file_news_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FavouriteAnnotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavouriteAnnotation) ProtoMessage() {}
+
+func (x *FavouriteAnnotation) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavouriteAnnotation.ProtoReflect.Descriptor instead.
+func (*FavouriteAnnotation) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *FavouriteAnnotation) GetNewsId() uint64 {
+	if x != nil {
+		return x.NewsId
+	}
+	return 0
+}
+
+func (x *FavouriteAnnotation) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *FavouriteAnnotation) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *FavouriteAnnotation) GetHighlights() []*Highlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+type FavouriteAnnotationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Annotation    *FavouriteAnnotation   `protobuf:"bytes,1,opt,name=annotation,proto3" json:"annotation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FavouriteAnnotationResponse) Reset() {
+	*x = FavouriteAnnotationResponse{}
+	mi := &file_news_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FavouriteAnnotationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavouriteAnnotationResponse) ProtoMessage() {}
+
+func (x *FavouriteAnnotationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavouriteAnnotationResponse.ProtoReflect.Descriptor instead.
+func (*FavouriteAnnotationResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *FavouriteAnnotationResponse) GetAnnotation() *FavouriteAnnotation {
+	if x != nil {
+		return x.Annotation
+	}
+	return nil
+}
+
+type GetFavouriteAnnotationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NewsId        uint64                 `protobuf:"varint,2,opt,name=news_id,json=newsId,proto3" json:"news_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFavouriteAnnotationRequest) Reset() {
+	*x = GetFavouriteAnnotationRequest{}
+	mi := &file_news_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFavouriteAnnotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFavouriteAnnotationRequest) ProtoMessage() {}
+
+func (x *GetFavouriteAnnotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFavouriteAnnotationRequest.ProtoReflect.Descriptor instead.
+func (*GetFavouriteAnnotationRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetFavouriteAnnotationRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetFavouriteAnnotationRequest) GetNewsId() uint64 {
+	if x != nil {
+		return x.NewsId
+	}
+	return 0
+}
+
+// Заменяет набор тегов целиком; теги приводятся к нижнему регистру.
+type SetFavouriteTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NewsId        uint64                 `protobuf:"varint,2,opt,name=news_id,json=newsId,proto3" json:"news_id,omitempty"`
+	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFavouriteTagsRequest) Reset() {
+	*x = SetFavouriteTagsRequest{}
+	mi := &file_news_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFavouriteTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFavouriteTagsRequest) ProtoMessage() {}
+
+func (x *SetFavouriteTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFavouriteTagsRequest.ProtoReflect.Descriptor instead.
+func (*SetFavouriteTagsRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SetFavouriteTagsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetFavouriteTagsRequest) GetNewsId() uint64 {
+	if x != nil {
+		return x.NewsId
+	}
+	return 0
+}
+
+func (x *SetFavouriteTagsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// Пустая заметка удаляет её.
+type SetFavouriteNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NewsId        uint64                 `protobuf:"varint,2,opt,name=news_id,json=newsId,proto3" json:"news_id,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFavouriteNoteRequest) Reset() {
+	*x = SetFavouriteNoteRequest{}
+	mi := &file_news_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFavouriteNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFavouriteNoteRequest) ProtoMessage() {}
+
+func (x *SetFavouriteNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFavouriteNoteRequest.ProtoReflect.Descriptor instead.
+func (*SetFavouriteNoteRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SetFavouriteNoteRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetFavouriteNoteRequest) GetNewsId() uint64 {
+	if x != nil {
+		return x.NewsId
+	}
+	return 0
+}
+
+func (x *SetFavouriteNoteRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type AddHighlightRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NewsId        uint64                 `protobuf:"varint,2,opt,name=news_id,json=newsId,proto3" json:"news_id,omitempty"`
+	StartOffset   int32                  `protobuf:"varint,3,opt,name=start_offset,json=startOffset,proto3" json:"start_offset,omitempty"`
+	EndOffset     int32                  `protobuf:"varint,4,opt,name=end_offset,json=endOffset,proto3" json:"end_offset,omitempty"`
+	Comment       string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddHighlightRequest) Reset() {
+	*x = AddHighlightRequest{}
+	mi := &file_news_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddHighlightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddHighlightRequest) ProtoMessage() {}
+
+func (x *AddHighlightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddHighlightRequest.ProtoReflect.Descriptor instead.
+func (*AddHighlightRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *AddHighlightRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AddHighlightRequest) GetNewsId() uint64 {
+	if x != nil {
+		return x.NewsId
+	}
+	return 0
+}
+
+func (x *AddHighlightRequest) GetStartOffset() int32 {
+	if x != nil {
+		return x.StartOffset
+	}
+	return 0
+}
+
+func (x *AddHighlightRequest) GetEndOffset() int32 {
+	if x != nil {
+		return x.EndOffset
+	}
+	return 0
+}
+
+func (x *AddHighlightRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type HighlightResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Highlight     *Highlight             `protobuf:"bytes,1,opt,name=highlight,proto3" json:"highlight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HighlightResponse) Reset() {
+	*x = HighlightResponse{}
+	mi := &file_news_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HighlightResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HighlightResponse) ProtoMessage() {}
+
+func (x *HighlightResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HighlightResponse.ProtoReflect.Descriptor instead.
+func (*HighlightResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *HighlightResponse) GetHighlight() *Highlight {
+	if x != nil {
+		return x.Highlight
+	}
+	return nil
+}
+
+type DeleteHighlightRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NewsId        uint64                 `protobuf:"varint,2,opt,name=news_id,json=newsId,proto3" json:"news_id,omitempty"`
+	HighlightId   uint64                 `protobuf:"varint,3,opt,name=highlight_id,json=highlightId,proto3" json:"highlight_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHighlightRequest) Reset() {
+	*x = DeleteHighlightRequest{}
+	mi := &file_news_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHighlightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHighlightRequest) ProtoMessage() {}
+
+func (x *DeleteHighlightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHighlightRequest.ProtoReflect.Descriptor instead.
+func (*DeleteHighlightRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *DeleteHighlightRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteHighlightRequest) GetNewsId() uint64 {
+	if x != nil {
+		return x.NewsId
+	}
+	return 0
+}
+
+func (x *DeleteHighlightRequest) GetHighlightId() uint64 {
+	if x != nil {
+		return x.HighlightId
+	}
+	return 0
+}
+
+type DeleteHighlightResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHighlightResponse) Reset() {
+	*x = DeleteHighlightResponse{}
+	mi := &file_news_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHighlightResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHighlightResponse) ProtoMessage() {}
+
+func (x *DeleteHighlightResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHighlightResponse.ProtoReflect.Descriptor instead.
+func (*DeleteHighlightResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *DeleteHighlightResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SearchFavouritesRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Только статьи с этим тегом.
+	Tag *string `protobuf:"bytes,2,opt,name=tag,proto3,oneof" json:"tag,omitempty"`
+	// Полнотекстовый поиск по заметкам (синтаксис websearch_to_tsquery).
+	NoteQuery     *string `protobuf:"bytes,3,opt,name=note_query,json=noteQuery,proto3,oneof" json:"note_query,omitempty"`
+	PageToken     string  `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize      int32   `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchFavouritesRequest) Reset() {
+	*x = SearchFavouritesRequest{}
+	mi := &file_news_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchFavouritesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFavouritesRequest) ProtoMessage() {}
+
+func (x *SearchFavouritesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFavouritesRequest.ProtoReflect.Descriptor instead.
+func (*SearchFavouritesRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SearchFavouritesRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SearchFavouritesRequest) GetTag() string {
+	if x != nil && x.Tag != nil {
+		return *x.Tag
+	}
+	return ""
+}
+
+func (x *SearchFavouritesRequest) GetNoteQuery() string {
+	if x != nil && x.NoteQuery != nil {
+		return *x.NoteQuery
+	}
+	return ""
+}
+
+func (x *SearchFavouritesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *SearchFavouritesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type AnnotatedNews struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	News          *News                  `protobuf:"bytes,1,opt,name=news,proto3" json:"news,omitempty"`
+	Annotation    *FavouriteAnnotation   `protobuf:"bytes,2,opt,name=annotation,proto3" json:"annotation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnnotatedNews) Reset() {
+	*x = AnnotatedNews{}
+	mi := &file_news_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnnotatedNews) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnotatedNews) ProtoMessage() {}
+
+func (x *AnnotatedNews) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnotatedNews.ProtoReflect.Descriptor instead.
+func (*AnnotatedNews) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *AnnotatedNews) GetNews() *News {
+	if x != nil {
+		return x.News
+	}
+	return nil
+}
+
+func (x *AnnotatedNews) GetAnnotation() *FavouriteAnnotation {
+	if x != nil {
+		return x.Annotation
+	}
+	return nil
+}
+
+type SearchFavouritesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Favourites    []*AnnotatedNews       `protobuf:"bytes,1,rep,name=favourites,proto3" json:"favourites,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchFavouritesResponse) Reset() {
+	*x = SearchFavouritesResponse{}
+	mi := &file_news_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchFavouritesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFavouritesResponse) ProtoMessage() {}
+
+func (x *SearchFavouritesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFavouritesResponse.ProtoReflect.Descriptor instead.
+func (*SearchFavouritesResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SearchFavouritesResponse) GetFavourites() []*AnnotatedNews {
+	if x != nil {
+		return x.Favourites
+	}
+	return nil
+}
+
+func (x *SearchFavouritesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Отметки о просмотре новостей.
+type MarkSeenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NewsIds       []uint64               `protobuf:"varint,2,rep,packed,name=news_ids,json=newsIds,proto3" json:"news_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkSeenRequest) Reset() {
+	*x = MarkSeenRequest{}
+	mi := &file_news_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkSeenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkSeenRequest) ProtoMessage() {}
+
+func (x *MarkSeenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkSeenRequest.ProtoReflect.Descriptor instead.
+func (*MarkSeenRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *MarkSeenRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MarkSeenRequest) GetNewsIds() []uint64 {
+	if x != nil {
+		return x.NewsIds
+	}
+	return nil
+}
+
+type MarkSeenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkSeenResponse) Reset() {
+	*x = MarkSeenResponse{}
+	mi := &file_news_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkSeenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkSeenResponse) ProtoMessage() {}
+
+func (x *MarkSeenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkSeenResponse.ProtoReflect.Descriptor instead.
+func (*MarkSeenResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *MarkSeenResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type MarkUnseenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NewsIds       []uint64               `protobuf:"varint,2,rep,packed,name=news_ids,json=newsIds,proto3" json:"news_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkUnseenRequest) Reset() {
+	*x = MarkUnseenRequest{}
+	mi := &file_news_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkUnseenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkUnseenRequest) ProtoMessage() {}
+
+func (x *MarkUnseenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkUnseenRequest.ProtoReflect.Descriptor instead.
+func (*MarkUnseenRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *MarkUnseenRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MarkUnseenRequest) GetNewsIds() []uint64 {
+	if x != nil {
+		return x.NewsIds
+	}
+	return nil
+}
+
+type MarkUnseenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkUnseenResponse) Reset() {
+	*x = MarkUnseenResponse{}
+	mi := &file_news_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkUnseenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkUnseenResponse) ProtoMessage() {}
+
+func (x *MarkUnseenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkUnseenResponse.ProtoReflect.Descriptor instead.
+func (*MarkUnseenResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *MarkUnseenResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SeenNews struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NewsId        uint64                 `protobuf:"varint,1,opt,name=news_id,json=newsId,proto3" json:"news_id,omitempty"`
+	SeenAt        string                 `protobuf:"bytes,2,opt,name=seen_at,json=seenAt,proto3" json:"seen_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeenNews) Reset() {
+	*x = SeenNews{}
+	mi := &file_news_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeenNews) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeenNews) ProtoMessage() {}
+
+func (x *SeenNews) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeenNews.ProtoReflect.Descriptor instead.
+func (*SeenNews) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SeenNews) GetNewsId() uint64 {
+	if x != nil {
+		return x.NewsId
+	}
+	return 0
+}
+
+func (x *SeenNews) GetSeenAt() string {
+	if x != nil {
+		return x.SeenAt
+	}
+	return ""
+}
+
+// Если news_ids заданы, возвращаются только просмотренные из них, без пагинации;
+// иначе - все просмотренные, последние сначала.
+type GetSeenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NewsIds       []uint64               `protobuf:"varint,2,rep,packed,name=news_ids,json=newsIds,proto3" json:"news_ids,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeenRequest) Reset() {
+	*x = GetSeenRequest{}
+	mi := &file_news_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeenRequest) ProtoMessage() {}
+
+func (x *GetSeenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeenRequest.ProtoReflect.Descriptor instead.
+func (*GetSeenRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetSeenRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetSeenRequest) GetNewsIds() []uint64 {
+	if x != nil {
+		return x.NewsIds
+	}
+	return nil
+}
+
+func (x *GetSeenRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetSeenRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetSeenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seen          []*SeenNews            `protobuf:"bytes,1,rep,name=seen,proto3" json:"seen,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeenResponse) Reset() {
+	*x = GetSeenResponse{}
+	mi := &file_news_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeenResponse) ProtoMessage() {}
+
+func (x *GetSeenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeenResponse.ProtoReflect.Descriptor instead.
+func (*GetSeenResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetSeenResponse) GetSeen() []*SeenNews {
+	if x != nil {
+		return x.Seen
+	}
+	return nil
+}
+
+func (x *GetSeenResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Журнал отправленных пользователю уведомлений.
+type RecordNotificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Keyword       string                 `protobuf:"bytes,2,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	Article       *News                  `protobuf:"bytes,3,opt,name=article,proto3" json:"article,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordNotificationRequest) Reset() {
+	*x = RecordNotificationRequest{}
+	mi := &file_news_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordNotificationRequest) ProtoMessage() {}
+
+func (x *RecordNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordNotificationRequest.ProtoReflect.Descriptor instead.
+func (*RecordNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *RecordNotificationRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RecordNotificationRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *RecordNotificationRequest) GetArticle() *News {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+type RecordNotificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordNotificationResponse) Reset() {
+	*x = RecordNotificationResponse{}
+	mi := &file_news_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordNotificationResponse) ProtoMessage() {}
+
+func (x *RecordNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordNotificationResponse.ProtoReflect.Descriptor instead.
+func (*RecordNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *RecordNotificationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Выгрузка всех данных пользователя.
+type ExportUserDataRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// "json" (по умолчанию) - один JSON-документ, "zip" - архив с JSON-файлом на каждый раздел.
+	Format        string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataRequest) Reset() {
+	*x = ExportUserDataRequest{}
+	mi := &file_news_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataRequest) ProtoMessage() {}
+
+func (x *ExportUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ExportUserDataRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ExportUserDataRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ExportUserDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataResponse) Reset() {
+	*x = ExportUserDataResponse{}
+	mi := &file_news_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataResponse) ProtoMessage() {}
+
+func (x *ExportUserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportUserDataResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ExportUserDataResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportUserDataResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportUserDataResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+// Удаляет пользователя вместе со всеми его данными.
+type DeleteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserRequest) Reset() {
+	*x = DeleteUserRequest{}
+	mi := &file_news_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserRequest) ProtoMessage() {}
+
+func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *DeleteUserRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type DeleteUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserResponse) Reset() {
+	*x = DeleteUserResponse{}
+	mi := &file_news_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserResponse) ProtoMessage() {}
+
+func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *DeleteUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type APIKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Первые символы ключа, чтобы узнать его в списке; сам ключ не хранится.
+	Prefix string `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// read:search, read:user, write:favourites, admin.
+	Scopes    []string `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	CreatedAt string   `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Пусто, если событие не наступало.
+	RotatedAt  string `protobuf:"bytes,6,opt,name=rotated_at,json=rotatedAt,proto3" json:"rotated_at,omitempty"`
+	LastUsedAt string `protobuf:"bytes,7,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	RevokedAt  string `protobuf:"bytes,8,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	// Пользователь, которому выдан ключ; 0 - ключ сервиса. Запросы с ключом пользователя
+	// считаются в его лимитах запросов.
+	UserId        uint64 `protobuf:"varint,9,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APIKey) Reset() {
+	*x = APIKey{}
+	mi := &file_news_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKey) ProtoMessage() {}
+
+func (x *APIKey) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKey.ProtoReflect.Descriptor instead.
+func (*APIKey) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *APIKey) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *APIKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APIKey) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *APIKey) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *APIKey) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *APIKey) GetRotatedAt() string {
+	if x != nil {
+		return x.RotatedAt
+	}
+	return ""
+}
+
+func (x *APIKey) GetLastUsedAt() string {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return ""
+}
+
+func (x *APIKey) GetRevokedAt() string {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return ""
+}
+
+func (x *APIKey) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type CreateAPIKeyRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes []string               `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// 0 - ключ не привязан к пользователю.
+	UserId        uint64 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyRequest) Reset() {
+	*x = CreateAPIKeyRequest{}
+	mi := &file_news_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyRequest) ProtoMessage() {}
+
+func (x *CreateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *CreateAPIKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type APIKeySecretResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey *APIKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// Открытый ключ; показывается только при создании и ротации.
+	Key           string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APIKeySecretResponse) Reset() {
+	*x = APIKeySecretResponse{}
+	mi := &file_news_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIKeySecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKeySecretResponse) ProtoMessage() {}
+
+func (x *APIKeySecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKeySecretResponse.ProtoReflect.Descriptor instead.
+func (*APIKeySecretResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *APIKeySecretResponse) GetApiKey() *APIKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *APIKeySecretResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type ListAPIKeysRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PageToken      string                 `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	IncludeRevoked bool                   `protobuf:"varint,3,opt,name=include_revoked,json=includeRevoked,proto3" json:"include_revoked,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListAPIKeysRequest) Reset() {
+	*x = ListAPIKeysRequest{}
+	mi := &file_news_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPIKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysRequest) ProtoMessage() {}
+
+func (x *ListAPIKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ListAPIKeysRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAPIKeysRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAPIKeysRequest) GetIncludeRevoked() bool {
+	if x != nil {
+		return x.IncludeRevoked
+	}
+	return false
+}
+
+type ListAPIKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKeys       []*APIKey              `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAPIKeysResponse) Reset() {
+	*x = ListAPIKeysResponse{}
+	mi := &file_news_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPIKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysResponse) ProtoMessage() {}
+
+func (x *ListAPIKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ListAPIKeysResponse) GetApiKeys() []*APIKey {
+	if x != nil {
+		return x.ApiKeys
+	}
+	return nil
+}
+
+func (x *ListAPIKeysResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RotateAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateAPIKeyRequest) Reset() {
+	*x = RotateAPIKeyRequest{}
+	mi := &file_news_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateAPIKeyRequest) ProtoMessage() {}
+
+func (x *RotateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *RotateAPIKeyRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RevokeAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPIKeyRequest) Reset() {
+	*x = RevokeAPIKeyRequest{}
+	mi := &file_news_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyRequest) ProtoMessage() {}
+
+func (x *RevokeAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *RevokeAPIKeyRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RevokeAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPIKeyResponse) Reset() {
+	*x = RevokeAPIKeyResponse{}
+	mi := &file_news_service_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyResponse) ProtoMessage() {}
+
+func (x *RevokeAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *RevokeAPIKeyResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Queries
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type SubscribeRequest struct {
+type AuthenticateAPIKeyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Keyword       string                 `protobuf:"bytes,2,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubscribeRequest) Reset() {
-	*x = SubscribeRequest{}
-	mi := &file_news_service_proto_msgTypes[15]
+func (x *AuthenticateAPIKeyRequest) Reset() {
+	*x = AuthenticateAPIKeyRequest{}
+	mi := &file_news_service_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubscribeRequest) String() string {
+func (x *AuthenticateAPIKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeRequest) ProtoMessage() {}
+func (*AuthenticateAPIKeyRequest) ProtoMessage() {}
 
-func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_news_service_proto_msgTypes[15]
+func (x *AuthenticateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -795,47 +5827,40 @@ func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeRequest) Descriptor() ([]byte, []int) {
-	return file_news_service_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *SubscribeRequest) GetUserId() uint64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use AuthenticateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*AuthenticateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *SubscribeRequest) GetKeyword() string {
+func (x *AuthenticateAPIKeyRequest) GetKey() string {
 	if x != nil {
-		return x.Keyword
+		return x.Key
 	}
 	return ""
 }
 
-type SubscribeResponse struct {
+type APIKeyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ApiKey        *APIKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubscribeResponse) Reset() {
-	*x = SubscribeResponse{}
-	mi := &file_news_service_proto_msgTypes[16]
+func (x *APIKeyResponse) Reset() {
+	*x = APIKeyResponse{}
+	mi := &file_news_service_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubscribeResponse) String() string {
+func (x *APIKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeResponse) ProtoMessage() {}
+func (*APIKeyResponse) ProtoMessage() {}
 
-func (x *SubscribeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_news_service_proto_msgTypes[16]
+func (x *APIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -846,39 +5871,42 @@ func (x *SubscribeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeResponse.ProtoReflect.Descriptor instead.
-func (*SubscribeResponse) Descriptor() ([]byte, []int) {
-	return file_news_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use APIKeyResponse.ProtoReflect.Descriptor instead.
+func (*APIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *SubscribeResponse) GetSuccess() bool {
+func (x *APIKeyResponse) GetApiKey() *APIKey {
 	if x != nil {
-		return x.Success
+		return x.ApiKey
 	}
-	return false
+	return nil
 }
 
-type GetSubscriptionsRequest struct {
+// Все пользователи по возрастанию id.
+type ListUsersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageToken     string                 `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSubscriptionsRequest) Reset() {
-	*x = GetSubscriptionsRequest{}
-	mi := &file_news_service_proto_msgTypes[17]
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_news_service_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSubscriptionsRequest) String() string {
+func (x *ListUsersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSubscriptionsRequest) ProtoMessage() {}
+func (*ListUsersRequest) ProtoMessage() {}
 
-func (x *GetSubscriptionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_news_service_proto_msgTypes[17]
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -889,33 +5917,48 @@ func (x *GetSubscriptionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSubscriptionsRequest.ProtoReflect.Descriptor instead.
-func (*GetSubscriptionsRequest) Descriptor() ([]byte, []int) {
-	return file_news_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{98}
 }
 
-type GetSubscriptionsResponse struct {
+func (x *ListUsersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListUsersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Subscriptions []*Subscription        `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSubscriptionsResponse) Reset() {
-	*x = GetSubscriptionsResponse{}
-	mi := &file_news_service_proto_msgTypes[18]
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_news_service_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSubscriptionsResponse) String() string {
+func (x *ListUsersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSubscriptionsResponse) ProtoMessage() {}
+func (*ListUsersResponse) ProtoMessage() {}
 
-func (x *GetSubscriptionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_news_service_proto_msgTypes[18]
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -926,42 +5969,47 @@ func (x *GetSubscriptionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSubscriptionsResponse.ProtoReflect.Descriptor instead.
-func (*GetSubscriptionsResponse) Descriptor() ([]byte, []int) {
-	return file_news_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *GetSubscriptionsResponse) GetSubscriptions() []*Subscription {
+func (x *ListUsersResponse) GetUsers() []*User {
 	if x != nil {
-		return x.Subscriptions
+		return x.Users
 	}
 	return nil
 }
 
-type Subscription struct {
+func (x *ListUsersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Повторно применяет схему базы; уже применённые изменения пропускаются.
+type RunMigrationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Keyword       string                 `protobuf:"bytes,3,opt,name=keyword,proto3" json:"keyword,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Subscription) Reset() {
-	*x = Subscription{}
-	mi := &file_news_service_proto_msgTypes[19]
+func (x *RunMigrationsRequest) Reset() {
+	*x = RunMigrationsRequest{}
+	mi := &file_news_service_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Subscription) String() string {
+func (x *RunMigrationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Subscription) ProtoMessage() {}
+func (*RunMigrationsRequest) ProtoMessage() {}
 
-func (x *Subscription) ProtoReflect() protoreflect.Message {
-	mi := &file_news_service_proto_msgTypes[19]
+func (x *RunMigrationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -972,52 +6020,71 @@ func (x *Subscription) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
-func (*Subscription) Descriptor() ([]byte, []int) {
-	return file_news_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use RunMigrationsRequest.ProtoReflect.Descriptor instead.
+func (*RunMigrationsRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *Subscription) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+type RunMigrationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Subscription) GetUserId() uint64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+func (x *RunMigrationsResponse) Reset() {
+	*x = RunMigrationsResponse{}
+	mi := &file_news_service_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Subscription) GetKeyword() string {
+func (x *RunMigrationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunMigrationsResponse) ProtoMessage() {}
+
+func (x *RunMigrationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[101]
 	if x != nil {
-		return x.Keyword
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunMigrationsResponse.ProtoReflect.Descriptor instead.
+func (*RunMigrationsResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{101}
 }
 
 // Search Service Messages
 type SearchNewsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
-	Sources       *string                `protobuf:"bytes,3,opt,name=sources,proto3,oneof" json:"sources,omitempty"`
-	Domains       *string                `protobuf:"bytes,4,opt,name=domains,proto3,oneof" json:"domains,omitempty"`
-	From          *string                `protobuf:"bytes,5,opt,name=from,proto3,oneof" json:"from,omitempty"`
-	To            *string                `protobuf:"bytes,6,opt,name=to,proto3,oneof" json:"to,omitempty"`
-	Language      *string                `protobuf:"bytes,7,opt,name=language,proto3,oneof" json:"language,omitempty"`
-	SortBy        *string                `protobuf:"bytes,8,opt,name=sort_by,json=sortBy,proto3,oneof" json:"sort_by,omitempty"`
-	PageSize      *int32                 `protobuf:"varint,9,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
-	Page          *int32                 `protobuf:"varint,10,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Query    string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Sources  *string                `protobuf:"bytes,3,opt,name=sources,proto3,oneof" json:"sources,omitempty"`
+	Domains  *string                `protobuf:"bytes,4,opt,name=domains,proto3,oneof" json:"domains,omitempty"`
+	From     *string                `protobuf:"bytes,5,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To       *string                `protobuf:"bytes,6,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	Language *string                `protobuf:"bytes,7,opt,name=language,proto3,oneof" json:"language,omitempty"`
+	SortBy   *string                `protobuf:"bytes,8,opt,name=sort_by,json=sortBy,proto3,oneof" json:"sort_by,omitempty"`
+	PageSize *int32                 `protobuf:"varint,9,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	Page     *int32                 `protobuf:"varint,10,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	// Не записывать поиск в историю пользователя (обновление сохранённых поисков).
+	SkipHistory bool `protobuf:"varint,11,opt,name=skip_history,json=skipHistory,proto3" json:"skip_history,omitempty"`
+	// Убрать из страницы новости, которые пользователь уже просмотрел; total_results не меняется.
+	UnseenOnly    bool `protobuf:"varint,12,opt,name=unseen_only,json=unseenOnly,proto3" json:"unseen_only,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SearchNewsRequest) Reset() {
 	*x = SearchNewsRequest{}
-	mi := &file_news_service_proto_msgTypes[20]
+	mi := &file_news_service_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1029,7 +6096,7 @@ func (x *SearchNewsRequest) String() string {
 func (*SearchNewsRequest) ProtoMessage() {}
 
 func (x *SearchNewsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_news_service_proto_msgTypes[20]
+	mi := &file_news_service_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1042,7 +6109,7 @@ func (x *SearchNewsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchNewsRequest.ProtoReflect.Descriptor instead.
 func (*SearchNewsRequest) Descriptor() ([]byte, []int) {
-	return file_news_service_proto_rawDescGZIP(), []int{20}
+	return file_news_service_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *SearchNewsRequest) GetUserId() uint64 {
@@ -1115,6 +6182,20 @@ func (x *SearchNewsRequest) GetPage() int32 {
 	return 0
 }
 
+func (x *SearchNewsRequest) GetSkipHistory() bool {
+	if x != nil {
+		return x.SkipHistory
+	}
+	return false
+}
+
+func (x *SearchNewsRequest) GetUnseenOnly() bool {
+	if x != nil {
+		return x.UnseenOnly
+	}
+	return false
+}
+
 type SearchNewsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	News          []*News                `protobuf:"bytes,1,rep,name=news,proto3" json:"news,omitempty"`
@@ -1125,7 +6206,7 @@ type SearchNewsResponse struct {
 
 func (x *SearchNewsResponse) Reset() {
 	*x = SearchNewsResponse{}
-	mi := &file_news_service_proto_msgTypes[21]
+	mi := &file_news_service_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1137,7 +6218,7 @@ func (x *SearchNewsResponse) String() string {
 func (*SearchNewsResponse) ProtoMessage() {}
 
 func (x *SearchNewsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_news_service_proto_msgTypes[21]
+	mi := &file_news_service_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1150,7 +6231,7 @@ func (x *SearchNewsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchNewsResponse.ProtoReflect.Descriptor instead.
 func (*SearchNewsResponse) Descriptor() ([]byte, []int) {
-	return file_news_service_proto_rawDescGZIP(), []int{21}
+	return file_news_service_proto_rawDescGZIP(), []int{103}
 }
 
 func (x *SearchNewsResponse) GetNews() []*News {
@@ -1168,21 +6249,23 @@ func (x *SearchNewsResponse) GetTotalResults() int32 {
 }
 
 type GetTopHeadlinesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Country       *string                `protobuf:"bytes,2,opt,name=country,proto3,oneof" json:"country,omitempty"`
-	Category      *string                `protobuf:"bytes,3,opt,name=category,proto3,oneof" json:"category,omitempty"`
-	Sources       *string                `protobuf:"bytes,4,opt,name=sources,proto3,oneof" json:"sources,omitempty"`
-	Query         *string                `protobuf:"bytes,5,opt,name=query,proto3,oneof" json:"query,omitempty"`
-	PageSize      *int32                 `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
-	Page          *int32                 `protobuf:"varint,7,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Country  *string                `protobuf:"bytes,2,opt,name=country,proto3,oneof" json:"country,omitempty"`
+	Category *string                `protobuf:"bytes,3,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	Sources  *string                `protobuf:"bytes,4,opt,name=sources,proto3,oneof" json:"sources,omitempty"`
+	Query    *string                `protobuf:"bytes,5,opt,name=query,proto3,oneof" json:"query,omitempty"`
+	PageSize *int32                 `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	Page     *int32                 `protobuf:"varint,7,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	// Убрать из страницы новости, которые пользователь уже просмотрел; total_results не меняется.
+	UnseenOnly    bool `protobuf:"varint,8,opt,name=unseen_only,json=unseenOnly,proto3" json:"unseen_only,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetTopHeadlinesRequest) Reset() {
 	*x = GetTopHeadlinesRequest{}
-	mi := &file_news_service_proto_msgTypes[22]
+	mi := &file_news_service_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1194,7 +6277,7 @@ func (x *GetTopHeadlinesRequest) String() string {
 func (*GetTopHeadlinesRequest) ProtoMessage() {}
 
 func (x *GetTopHeadlinesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_news_service_proto_msgTypes[22]
+	mi := &file_news_service_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1207,7 +6290,7 @@ func (x *GetTopHeadlinesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTopHeadlinesRequest.ProtoReflect.Descriptor instead.
 func (*GetTopHeadlinesRequest) Descriptor() ([]byte, []int) {
-	return file_news_service_proto_rawDescGZIP(), []int{22}
+	return file_news_service_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *GetTopHeadlinesRequest) GetUserId() uint64 {
@@ -1259,6 +6342,13 @@ func (x *GetTopHeadlinesRequest) GetPage() int32 {
 	return 0
 }
 
+func (x *GetTopHeadlinesRequest) GetUnseenOnly() bool {
+	if x != nil {
+		return x.UnseenOnly
+	}
+	return false
+}
+
 type GetTopHeadlinesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	News          []*News                `protobuf:"bytes,1,rep,name=news,proto3" json:"news,omitempty"`
@@ -1269,7 +6359,7 @@ type GetTopHeadlinesResponse struct {
 
 func (x *GetTopHeadlinesResponse) Reset() {
 	*x = GetTopHeadlinesResponse{}
-	mi := &file_news_service_proto_msgTypes[23]
+	mi := &file_news_service_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1281,7 +6371,7 @@ func (x *GetTopHeadlinesResponse) String() string {
 func (*GetTopHeadlinesResponse) ProtoMessage() {}
 
 func (x *GetTopHeadlinesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_news_service_proto_msgTypes[23]
+	mi := &file_news_service_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1294,7 +6384,7 @@ func (x *GetTopHeadlinesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTopHeadlinesResponse.ProtoReflect.Descriptor instead.
 func (*GetTopHeadlinesResponse) Descriptor() ([]byte, []int) {
-	return file_news_service_proto_rawDescGZIP(), []int{23}
+	return file_news_service_proto_rawDescGZIP(), []int{105}
 }
 
 func (x *GetTopHeadlinesResponse) GetNews() []*News {
@@ -1304,36 +6394,141 @@ func (x *GetTopHeadlinesResponse) GetNews() []*News {
 	return nil
 }
 
-func (x *GetTopHeadlinesResponse) GetTotalResults() int32 {
+func (x *GetTopHeadlinesResponse) GetTotalResults() int32 {
+	if x != nil {
+		return x.TotalResults
+	}
+	return 0
+}
+
+type CheckNewArticlesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	LastCheckTime string                 `protobuf:"bytes,2,opt,name=last_check_time,json=lastCheckTime,proto3" json:"last_check_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckNewArticlesRequest) Reset() {
+	*x = CheckNewArticlesRequest{}
+	mi := &file_news_service_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckNewArticlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckNewArticlesRequest) ProtoMessage() {}
+
+func (x *CheckNewArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckNewArticlesRequest.ProtoReflect.Descriptor instead.
+func (*CheckNewArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *CheckNewArticlesRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *CheckNewArticlesRequest) GetLastCheckTime() string {
+	if x != nil {
+		return x.LastCheckTime
+	}
+	return ""
+}
+
+type CheckNewArticlesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NewArticles   []*News                `protobuf:"bytes,1,rep,name=new_articles,json=newArticles,proto3" json:"new_articles,omitempty"`
+	UserStats     []*UserArticleStats    `protobuf:"bytes,2,rep,name=user_stats,json=userStats,proto3" json:"user_stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckNewArticlesResponse) Reset() {
+	*x = CheckNewArticlesResponse{}
+	mi := &file_news_service_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckNewArticlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckNewArticlesResponse) ProtoMessage() {}
+
+func (x *CheckNewArticlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckNewArticlesResponse.ProtoReflect.Descriptor instead.
+func (*CheckNewArticlesResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *CheckNewArticlesResponse) GetNewArticles() []*News {
+	if x != nil {
+		return x.NewArticles
+	}
+	return nil
+}
+
+func (x *CheckNewArticlesResponse) GetUserStats() []*UserArticleStats {
 	if x != nil {
-		return x.TotalResults
+		return x.UserStats
 	}
-	return 0
+	return nil
 }
 
-type CheckNewArticlesRequest struct {
+// Удаляет ключи кэша поиска. pattern - glob Redis по ключу, например "search:*go*";
+// пусто - весь кэш. Ключи вне кэша поиска (лимиты запросов шлюза) не затрагиваются.
+type PurgeCacheRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`
-	LastCheckTime string                 `protobuf:"bytes,2,opt,name=last_check_time,json=lastCheckTime,proto3" json:"last_check_time,omitempty"`
+	Pattern       string                 `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckNewArticlesRequest) Reset() {
-	*x = CheckNewArticlesRequest{}
-	mi := &file_news_service_proto_msgTypes[24]
+func (x *PurgeCacheRequest) Reset() {
+	*x = PurgeCacheRequest{}
+	mi := &file_news_service_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckNewArticlesRequest) String() string {
+func (x *PurgeCacheRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckNewArticlesRequest) ProtoMessage() {}
+func (*PurgeCacheRequest) ProtoMessage() {}
 
-func (x *CheckNewArticlesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_news_service_proto_msgTypes[24]
+func (x *PurgeCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1344,48 +6539,40 @@ func (x *CheckNewArticlesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckNewArticlesRequest.ProtoReflect.Descriptor instead.
-func (*CheckNewArticlesRequest) Descriptor() ([]byte, []int) {
-	return file_news_service_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *CheckNewArticlesRequest) GetKeyword() string {
-	if x != nil {
-		return x.Keyword
-	}
-	return ""
+// Deprecated: Use PurgeCacheRequest.ProtoReflect.Descriptor instead.
+func (*PurgeCacheRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *CheckNewArticlesRequest) GetLastCheckTime() string {
+func (x *PurgeCacheRequest) GetPattern() string {
 	if x != nil {
-		return x.LastCheckTime
+		return x.Pattern
 	}
 	return ""
 }
 
-type CheckNewArticlesResponse struct {
+type PurgeCacheResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	NewArticles   []*News                `protobuf:"bytes,1,rep,name=new_articles,json=newArticles,proto3" json:"new_articles,omitempty"`
-	UserStats     []*UserArticleStats    `protobuf:"bytes,2,rep,name=user_stats,json=userStats,proto3" json:"user_stats,omitempty"`
+	Deleted       int64                  `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckNewArticlesResponse) Reset() {
-	*x = CheckNewArticlesResponse{}
-	mi := &file_news_service_proto_msgTypes[25]
+func (x *PurgeCacheResponse) Reset() {
+	*x = PurgeCacheResponse{}
+	mi := &file_news_service_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckNewArticlesResponse) String() string {
+func (x *PurgeCacheResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckNewArticlesResponse) ProtoMessage() {}
+func (*PurgeCacheResponse) ProtoMessage() {}
 
-func (x *CheckNewArticlesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_news_service_proto_msgTypes[25]
+func (x *PurgeCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1396,23 +6583,16 @@ func (x *CheckNewArticlesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckNewArticlesResponse.ProtoReflect.Descriptor instead.
-func (*CheckNewArticlesResponse) Descriptor() ([]byte, []int) {
-	return file_news_service_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *CheckNewArticlesResponse) GetNewArticles() []*News {
-	if x != nil {
-		return x.NewArticles
-	}
-	return nil
+// Deprecated: Use PurgeCacheResponse.ProtoReflect.Descriptor instead.
+func (*PurgeCacheResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *CheckNewArticlesResponse) GetUserStats() []*UserArticleStats {
+func (x *PurgeCacheResponse) GetDeleted() int64 {
 	if x != nil {
-		return x.UserStats
+		return x.Deleted
 	}
-	return nil
+	return 0
 }
 
 // Notification Service Messages
@@ -1427,7 +6607,7 @@ type SendNotificationRequest struct {
 
 func (x *SendNotificationRequest) Reset() {
 	*x = SendNotificationRequest{}
-	mi := &file_news_service_proto_msgTypes[26]
+	mi := &file_news_service_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1439,7 +6619,7 @@ func (x *SendNotificationRequest) String() string {
 func (*SendNotificationRequest) ProtoMessage() {}
 
 func (x *SendNotificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_news_service_proto_msgTypes[26]
+	mi := &file_news_service_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1452,7 +6632,7 @@ func (x *SendNotificationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendNotificationRequest.ProtoReflect.Descriptor instead.
 func (*SendNotificationRequest) Descriptor() ([]byte, []int) {
-	return file_news_service_proto_rawDescGZIP(), []int{26}
+	return file_news_service_proto_rawDescGZIP(), []int{110}
 }
 
 func (x *SendNotificationRequest) GetUserId() uint64 {
@@ -1487,7 +6667,7 @@ type UserArticleStats struct {
 
 func (x *UserArticleStats) Reset() {
 	*x = UserArticleStats{}
-	mi := &file_news_service_proto_msgTypes[27]
+	mi := &file_news_service_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1499,7 +6679,7 @@ func (x *UserArticleStats) String() string {
 func (*UserArticleStats) ProtoMessage() {}
 
 func (x *UserArticleStats) ProtoReflect() protoreflect.Message {
-	mi := &file_news_service_proto_msgTypes[27]
+	mi := &file_news_service_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1512,7 +6692,7 @@ func (x *UserArticleStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserArticleStats.ProtoReflect.Descriptor instead.
 func (*UserArticleStats) Descriptor() ([]byte, []int) {
-	return file_news_service_proto_rawDescGZIP(), []int{27}
+	return file_news_service_proto_rawDescGZIP(), []int{111}
 }
 
 func (x *UserArticleStats) GetUserId() uint64 {
@@ -1547,7 +6727,7 @@ type SendNotificationResponse struct {
 
 func (x *SendNotificationResponse) Reset() {
 	*x = SendNotificationResponse{}
-	mi := &file_news_service_proto_msgTypes[28]
+	mi := &file_news_service_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1559,7 +6739,7 @@ func (x *SendNotificationResponse) String() string {
 func (*SendNotificationResponse) ProtoMessage() {}
 
 func (x *SendNotificationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_news_service_proto_msgTypes[28]
+	mi := &file_news_service_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1572,7 +6752,7 @@ func (x *SendNotificationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendNotificationResponse.ProtoReflect.Descriptor instead.
 func (*SendNotificationResponse) Descriptor() ([]byte, []int) {
-	return file_news_service_proto_rawDescGZIP(), []int{28}
+	return file_news_service_proto_rawDescGZIP(), []int{112}
 }
 
 func (x *SendNotificationResponse) GetSuccess() bool {
@@ -1596,11 +6776,207 @@ func (x *SendNotificationResponse) GetMessage() string {
 	return ""
 }
 
+// Переотправляет сообщения, которые воркер не смог обработать, из dead letter топика
+// обратно в топик уведомлений. limit = 0 - все накопившиеся.
+type ReplayDeadLettersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayDeadLettersRequest) Reset() {
+	*x = ReplayDeadLettersRequest{}
+	mi := &file_news_service_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLettersRequest) ProtoMessage() {}
+
+func (x *ReplayDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ReplayDeadLettersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ReplayDeadLettersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Replayed      int32                  `protobuf:"varint,1,opt,name=replayed,proto3" json:"replayed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayDeadLettersResponse) Reset() {
+	*x = ReplayDeadLettersResponse{}
+	mi := &file_news_service_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLettersResponse) ProtoMessage() {}
+
+func (x *ReplayDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ReplayDeadLettersResponse) GetReplayed() int32 {
+	if x != nil {
+		return x.Replayed
+	}
+	return 0
+}
+
+// Фильтры складываются; без фильтров проверяются все подписки.
+type TriggerCheckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Keyword       string                 `protobuf:"bytes,2,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerCheckRequest) Reset() {
+	*x = TriggerCheckRequest{}
+	mi := &file_news_service_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerCheckRequest) ProtoMessage() {}
+
+func (x *TriggerCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerCheckRequest.ProtoReflect.Descriptor instead.
+func (*TriggerCheckRequest) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *TriggerCheckRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *TriggerCheckRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+type TriggerCheckResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Проверено подписок; подписки, которые проверяются прямо сейчас по расписанию, пропускаются.
+	Checked       int32 `protobuf:"varint,1,opt,name=checked,proto3" json:"checked,omitempty"`
+	NewArticles   int32 `protobuf:"varint,2,opt,name=new_articles,json=newArticles,proto3" json:"new_articles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerCheckResponse) Reset() {
+	*x = TriggerCheckResponse{}
+	mi := &file_news_service_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerCheckResponse) ProtoMessage() {}
+
+func (x *TriggerCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_service_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerCheckResponse.ProtoReflect.Descriptor instead.
+func (*TriggerCheckResponse) Descriptor() ([]byte, []int) {
+	return file_news_service_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *TriggerCheckResponse) GetChecked() int32 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *TriggerCheckResponse) GetNewArticles() int32 {
+	if x != nil {
+		return x.NewArticles
+	}
+	return 0
+}
+
 var File_news_service_proto protoreflect.FileDescriptor
 
 const file_news_service_proto_rawDesc = "" +
 	"\n" +
-	"\x12news_service.proto\x12\x04news\"\xd0\x01\n" +
+	"\x12news_service.proto\x12\x04news\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\"\xea\x01\n" +
 	"\x04News\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x16\n" +
 	"\x06source\x18\x02 \x01(\tR\x06source\x12\x16\n" +
@@ -1609,56 +6985,476 @@ const file_news_service_proto_rawDesc = "" +
 	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x10\n" +
 	"\x03url\x18\x06 \x01(\tR\x03url\x12\x1b\n" +
 	"\timage_url\x18\a \x01(\tR\bimageUrl\x12!\n" +
-	"\fpublished_at\x18\b \x01(\tR\vpublishedAt\"'\n" +
+	"\fpublished_at\x18\b \x01(\tR\vpublishedAt\x12\x18\n" +
+	"\acontent\x18\t \x01(\tR\acontent\"\xb5\x02\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x16\n" +
+	"\x06handle\x18\x02 \x01(\tR\x06handle\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12!\n" +
+	"\fdisplay_name\x18\x04 \x01(\tR\vdisplayName\x12\x1a\n" +
+	"\blanguage\x18\x05 \x01(\tR\blanguage\x12\x18\n" +
+	"\acountry\x18\x06 \x01(\tR\acountry\x12\x1a\n" +
+	"\btimezone\x18\a \x01(\tR\btimezone\x12<\n" +
+	"\x0fdefault_filters\x18\b \x01(\v2\x13.news.SearchFiltersR\x0edefaultFilters\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\tR\tupdatedAt\"\xbe\x02\n" +
 	"\x11CreateUserRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"-\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06handle\x18\x02 \x01(\tR\x06handle\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1a\n" +
+	"\blanguage\x18\x04 \x01(\tR\blanguage\x12\x18\n" +
+	"\acountry\x18\x05 \x01(\tR\acountry\x12\x1a\n" +
+	"\btimezone\x18\x06 \x01(\tR\btimezone\x12<\n" +
+	"\x0fdefault_filters\x18\a \x01(\v2\x13.news.SearchFiltersR\x0edefaultFilters:W\xbaHT\x1aR\n" +
+	"\x0ename_or_handle\x12\x1aname or handle is required\x1a$this.name != '' || this.handle != ''\"M\n" +
 	"\x12CreateUserResponse\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x04R\x06userId\"1\n" +
-	"\x0fSaveNewsRequest\x12\x1e\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1e\n" +
+	"\x04user\x18\x02 \x01(\v2\n" +
+	".news.UserR\x04user\"\xb7\x01\n" +
+	"\x0eGetUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x16\n" +
+	"\x06handle\x18\x02 \x01(\tR\x06handle:t\xbaHq\x1ao\n" +
+	"\x11user_id_or_handle\x12-exactly one of user_id and handle is required\x1a+(this.user_id == 0u) != (this.handle == '')\"\xff\x02\n" +
+	"\x11UpdateUserRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12\x1b\n" +
+	"\x06handle\x18\x02 \x01(\tH\x00R\x06handle\x88\x01\x01\x12\x19\n" +
+	"\x05email\x18\x03 \x01(\tH\x01R\x05email\x88\x01\x01\x12&\n" +
+	"\fdisplay_name\x18\x04 \x01(\tH\x02R\vdisplayName\x88\x01\x01\x12\x1f\n" +
+	"\blanguage\x18\x05 \x01(\tH\x03R\blanguage\x88\x01\x01\x12\x1d\n" +
+	"\acountry\x18\x06 \x01(\tH\x04R\acountry\x88\x01\x01\x12\x1f\n" +
+	"\btimezone\x18\a \x01(\tH\x05R\btimezone\x88\x01\x01\x12<\n" +
+	"\x0fdefault_filters\x18\b \x01(\v2\x13.news.SearchFiltersR\x0edefaultFiltersB\t\n" +
+	"\a_handleB\b\n" +
+	"\x06_emailB\x0f\n" +
+	"\r_display_nameB\v\n" +
+	"\t_languageB\n" +
+	"\n" +
+	"\b_countryB\v\n" +
+	"\t_timezone\".\n" +
+	"\fUserResponse\x12\x1e\n" +
+	"\x04user\x18\x01 \x01(\v2\n" +
+	".news.UserR\x04user\"9\n" +
+	"\x0fSaveNewsRequest\x12&\n" +
 	"\x04news\x18\x01 \x03(\v2\n" +
-	".news.NewsR\x04news\",\n" +
+	".news.NewsB\x06\xbaH\x03\xc8\x01\x01R\x04news\">\n" +
 	"\x10SaveNewsResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"'\n" +
-	"\x13GetNewsByIDsRequest\x12\x10\n" +
-	"\x03ids\x18\x01 \x03(\x04R\x03ids\"6\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x10\n" +
+	"\x03ids\x18\x02 \x03(\x04R\x03ids\"/\n" +
+	"\x13GetNewsByIDsRequest\x12\x18\n" +
+	"\x03ids\x18\x01 \x03(\x04B\x06\xbaH\x03\xc8\x01\x01R\x03ids\"6\n" +
 	"\x14GetNewsByIDsResponse\x12\x1e\n" +
 	"\x04news\x18\x01 \x03(\v2\n" +
-	".news.NewsR\x04news\"G\n" +
-	"\x13AddFavouriteRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x17\n" +
-	"\anews_id\x18\x02 \x01(\x04R\x06newsId\"0\n" +
+	".news.NewsR\x04news\"W\n" +
+	"\x13AddFavouriteRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12\x1f\n" +
+	"\anews_id\x18\x02 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06newsId\"0\n" +
 	"\x14AddFavouriteResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"/\n" +
-	"\x14GetFavouritesRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x04R\x06userId\"7\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x9d\x01\n" +
+	"\x14GetFavouritesRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12$\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bpageSize\x12\x1f\n" +
+	"\vunseen_only\x18\x04 \x01(\bR\n" +
+	"unseenOnly\"_\n" +
 	"\x15GetFavouritesResponse\x12\x1e\n" +
 	"\x04news\x18\x01 \x03(\v2\n" +
-	".news.NewsR\x04news\"d\n" +
-	"\x19AddToSearchHistoryRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x14\n" +
-	"\x05query\x18\x02 \x01(\tR\x05query\x12\x18\n" +
-	"\aresults\x18\x03 \x03(\x04R\aresults\"6\n" +
+	".news.NewsR\x04news\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xc8\x01\n" +
+	"\x19AddToSearchHistoryRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12\x1c\n" +
+	"\x05query\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x05query\x12\x18\n" +
+	"\aresults\x18\x03 \x03(\x04R\aresults\x12-\n" +
+	"\afilters\x18\x04 \x01(\v2\x13.news.SearchFiltersR\afilters\x12#\n" +
+	"\rtotal_results\x18\x05 \x01(\x05R\ftotalResults\"\xcd\x02\n" +
+	"\rSearchFilters\x12\x1d\n" +
+	"\asources\x18\x01 \x01(\tH\x00R\asources\x88\x01\x01\x12\x1d\n" +
+	"\adomains\x18\x02 \x01(\tH\x01R\adomains\x88\x01\x01\x12\x17\n" +
+	"\x04from\x18\x03 \x01(\tH\x02R\x04from\x88\x01\x01\x12\x13\n" +
+	"\x02to\x18\x04 \x01(\tH\x03R\x02to\x88\x01\x01\x12\x1f\n" +
+	"\blanguage\x18\x05 \x01(\tH\x04R\blanguage\x88\x01\x01\x12\x1c\n" +
+	"\asort_by\x18\x06 \x01(\tH\x05R\x06sortBy\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\a \x01(\x05H\x06R\bpageSize\x88\x01\x01\x12\x17\n" +
+	"\x04page\x18\b \x01(\x05H\aR\x04page\x88\x01\x01B\n" +
+	"\n" +
+	"\b_sourcesB\n" +
+	"\n" +
+	"\b_domainsB\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_toB\v\n" +
+	"\t_languageB\n" +
+	"\n" +
+	"\b_sort_byB\f\n" +
+	"\n" +
+	"_page_sizeB\a\n" +
+	"\x05_page\"\xf0\x01\n" +
+	"\x12SearchHistoryEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x1f\n" +
+	"\vsearched_at\x18\x03 \x01(\tR\n" +
+	"searchedAt\x12-\n" +
+	"\afilters\x18\x04 \x01(\v2\x13.news.SearchFiltersR\afilters\x12#\n" +
+	"\rtotal_results\x18\x05 \x01(\x05R\ftotalResults\x12\x1d\n" +
+	"\n" +
+	"result_ids\x18\x06 \x03(\x04R\tresultIds\x12 \n" +
+	"\voccurrences\x18\a \x01(\x05R\voccurrences\"6\n" +
 	"\x1aAddToSearchHistoryResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"2\n" +
-	"\x17GetSearchHistoryRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x04R\x06userId\"4\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xa6\x01\n" +
+	"\x17GetSearchHistoryRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12$\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bpageSize\x12%\n" +
+	"\x0eunique_queries\x18\x04 \x01(\bR\runiqueQueries\"\x90\x01\n" +
 	"\x18GetSearchHistoryResponse\x12\x18\n" +
-	"\aqueries\x18\x01 \x03(\tR\aqueries\"E\n" +
-	"\x10SubscribeRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x18\n" +
-	"\akeyword\x18\x02 \x01(\tR\akeyword\"-\n" +
+	"\aqueries\x18\x01 \x03(\tR\aqueries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x122\n" +
+	"\aentries\x18\x03 \x03(\v2\x18.news.SearchHistoryEntryR\aentries\"d\n" +
+	"\x1cGetSearchHistoryEntryRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12#\n" +
+	"\tsearch_id\x18\x02 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\bsearchId\"O\n" +
+	"\x1dGetSearchHistoryEntryResponse\x12.\n" +
+	"\x05entry\x18\x01 \x01(\v2\x18.news.SearchHistoryEntryR\x05entry\"q\n" +
+	"\x10SubscribeRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12 \n" +
+	"\akeyword\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\akeyword\x12\x1a\n" +
+	"\bschedule\x18\x03 \x01(\tR\bschedule\"-\n" +
 	"\x11SubscribeResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x19\n" +
-	"\x17GetSubscriptionsRequest\"T\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x80\x01\n" +
+	"\x17GetSubscriptionsRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\x06userId\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12$\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bpageSize\"|\n" +
 	"\x18GetSubscriptionsResponse\x128\n" +
-	"\rsubscriptions\x18\x01 \x03(\v2\x12.news.SubscriptionR\rsubscriptions\"Q\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2\x12.news.SubscriptionR\rsubscriptions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"b\n" +
+	"\x1bListAllSubscriptionsRequest\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x01 \x01(\tR\tpageToken\x12$\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bpageSize\"m\n" +
 	"\fSubscription\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x18\n" +
-	"\akeyword\x18\x03 \x01(\tR\akeyword\"\x80\x03\n" +
-	"\x11SearchNewsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x14\n" +
-	"\x05query\x18\x02 \x01(\tR\x05query\x12\x1d\n" +
+	"\akeyword\x18\x03 \x01(\tR\akeyword\x12\x1a\n" +
+	"\bschedule\x18\x04 \x01(\tR\bschedule\"\x89\x01\n" +
+	"\x19UpdateSubscriptionRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12/\n" +
+	"\x0fsubscription_id\x18\x02 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x0esubscriptionId\x12\x1a\n" +
+	"\bschedule\x18\x03 \x01(\tR\bschedule\"N\n" +
+	"\x14SubscriptionResponse\x126\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x12.news.SubscriptionR\fsubscription\"\xee\x02\n" +
+	"\vSavedSearch\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05query\x18\x04 \x01(\tR\x05query\x12-\n" +
+	"\afilters\x18\x05 \x01(\v2\x13.news.SearchFiltersR\afilters\x128\n" +
+	"\x18refresh_interval_minutes\x18\x06 \x01(\x05R\x16refreshIntervalMinutes\x12\x16\n" +
+	"\x06notify\x18\a \x01(\bR\x06notify\x12\x1e\n" +
+	"\vlast_run_at\x18\b \x01(\tR\tlastRunAt\x12&\n" +
+	"\x0flast_result_ids\x18\t \x03(\x04R\rlastResultIds\x12$\n" +
+	"\x0enew_result_ids\x18\n" +
+	" \x03(\x04R\fnewResultIds\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\"\xff\x01\n" +
+	"\x18CreateSavedSearchRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12\x1a\n" +
+	"\x04name\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12\x1c\n" +
+	"\x05query\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x05query\x12-\n" +
+	"\afilters\x18\x04 \x01(\v2\x13.news.SearchFiltersR\afilters\x12A\n" +
+	"\x18refresh_interval_minutes\x18\x05 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x16refreshIntervalMinutes\x12\x16\n" +
+	"\x06notify\x18\x06 \x01(\bR\x06notify\"P\n" +
+	"\x15GetSavedSearchRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12\x16\n" +
+	"\x02id\x18\x02 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x02id\"\x9c\x01\n" +
+	"\x18ListSavedSearchesRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\x06userId\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12$\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bpageSize\x12\x19\n" +
+	"\bdue_only\x18\x04 \x01(\bR\adueOnly\"}\n" +
+	"\x19ListSavedSearchesResponse\x128\n" +
+	"\x0esaved_searches\x18\x01 \x03(\v2\x11.news.SavedSearchR\rsavedSearches\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"}\n" +
+	"\x1bListAllSavedSearchesRequest\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x01 \x01(\tR\tpageToken\x12$\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bpageSize\x12\x19\n" +
+	"\bdue_only\x18\x03 \x01(\bR\adueOnly\"\xe8\x02\n" +
+	"\x18UpdateSavedSearchRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12\x16\n" +
+	"\x02id\x18\x02 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x02id\x12 \n" +
+	"\x04name\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x00R\x04name\x88\x01\x01\x12\"\n" +
+	"\x05query\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x01R\x05query\x88\x01\x01\x12-\n" +
+	"\afilters\x18\x05 \x01(\v2\x13.news.SearchFiltersR\afilters\x12F\n" +
+	"\x18refresh_interval_minutes\x18\x06 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00H\x02R\x16refreshIntervalMinutes\x88\x01\x01\x12\x1b\n" +
+	"\x06notify\x18\a \x01(\bH\x03R\x06notify\x88\x01\x01B\a\n" +
+	"\x05_nameB\b\n" +
+	"\x06_queryB\x1b\n" +
+	"\x19_refresh_interval_minutesB\t\n" +
+	"\a_notify\"S\n" +
+	"\x18DeleteSavedSearchRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12\x16\n" +
+	"\x02id\x18\x02 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x02id\"5\n" +
+	"\x19DeleteSavedSearchResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"T\n" +
+	"\x1bRecordSavedSearchRunRequest\x12\x16\n" +
+	"\x02id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x02id\x12\x1d\n" +
+	"\n" +
+	"result_ids\x18\x02 \x03(\x04R\tresultIds\"K\n" +
+	"\x13SavedSearchResponse\x124\n" +
+	"\fsaved_search\x18\x01 \x01(\v2\x11.news.SavedSearchR\vsavedSearch\"\xc7\x01\n" +
+	"\n" +
+	"Collection\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\x04 \x01(\bR\tisDefault\x12\x1f\n" +
+	"\vshare_token\x18\x05 \x01(\tR\n" +
+	"shareToken\x12\x1d\n" +
+	"\n" +
+	"item_count\x18\x06 \x01(\x05R\titemCount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\"F\n" +
+	"\x12CollectionResponse\x120\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x10.news.CollectionR\n" +
+	"collection\"V\n" +
+	"\x17CreateCollectionRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12\x1a\n" +
+	"\x04name\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\"~\n" +
+	"\x16ListCollectionsRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12$\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bpageSize\"u\n" +
+	"\x17ListCollectionsResponse\x122\n" +
+	"\vcollections\x18\x01 \x03(\v2\x10.news.CollectionR\vcollections\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"{\n" +
+	"\x17RenameCollectionRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12#\n" +
+	"\rcollection_id\x18\x02 \x01(\x04R\fcollectionId\x12\x1a\n" +
+	"\x04name\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\"_\n" +
+	"\x17DeleteCollectionRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12#\n" +
+	"\rcollection_id\x18\x02 \x01(\x04R\fcollectionId\"4\n" +
+	"\x18DeleteCollectionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xa6\x01\n" +
+	"\x19GetCollectionItemsRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12#\n" +
+	"\rcollection_id\x18\x02 \x01(\x04R\fcollectionId\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12$\n" +
+	"\tpage_size\x18\x04 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bpageSize\"\x93\x01\n" +
+	"\x17CollectionItemsResponse\x120\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x10.news.CollectionR\n" +
+	"collection\x12\x1e\n" +
+	"\x04news\x18\x02 \x03(\v2\n" +
+	".news.NewsR\x04news\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\x7f\n" +
+	"\x16AddToCollectionRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12#\n" +
+	"\rcollection_id\x18\x02 \x01(\x04R\fcollectionId\x12\x1f\n" +
+	"\anews_id\x18\x03 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06newsId\"3\n" +
+	"\x17AddToCollectionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x84\x01\n" +
+	"\x1bRemoveFromCollectionRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12#\n" +
+	"\rcollection_id\x18\x02 \x01(\x04R\fcollectionId\x12\x1f\n" +
+	"\anews_id\x18\x03 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06newsId\"8\n" +
+	"\x1cRemoveFromCollectionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xcc\x01\n" +
+	"\x1aCopyCollectionItemsRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12,\n" +
+	"\x12from_collection_id\x18\x02 \x01(\x04R\x10fromCollectionId\x12(\n" +
+	"\x10to_collection_id\x18\x03 \x01(\x04R\x0etoCollectionId\x12!\n" +
+	"\bnews_ids\x18\x04 \x03(\x04B\x06\xbaH\x03\xc8\x01\x01R\anewsIds\x12\x12\n" +
+	"\x04move\x18\x05 \x01(\bR\x04move\"7\n" +
+	"\x1bCopyCollectionItemsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x83\x01\n" +
+	"\x18ReorderCollectionRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12#\n" +
+	"\rcollection_id\x18\x02 \x01(\x04R\fcollectionId\x12!\n" +
+	"\bnews_ids\x18\x03 \x03(\x04B\x06\xbaH\x03\xc8\x01\x01R\anewsIds\"5\n" +
+	"\x19ReorderCollectionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"v\n" +
+	"\x16ShareCollectionRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12#\n" +
+	"\rcollection_id\x18\x02 \x01(\x04R\fcollectionId\x12\x16\n" +
+	"\x06shared\x18\x03 \x01(\bR\x06shared\"\x8a\x01\n" +
+	"\x1aGetSharedCollectionRequest\x12'\n" +
+	"\vshare_token\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\n" +
+	"shareToken\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12$\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bpageSize\"\xc3\x01\n" +
+	"\tHighlight\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
+	"\anews_id\x18\x02 \x01(\x04R\x06newsId\x12!\n" +
+	"\fstart_offset\x18\x03 \x01(\x05R\vstartOffset\x12\x1d\n" +
+	"\n" +
+	"end_offset\x18\x04 \x01(\x05R\tendOffset\x12\x12\n" +
+	"\x04text\x18\x05 \x01(\tR\x04text\x12\x18\n" +
+	"\acomment\x18\x06 \x01(\tR\acomment\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\"\x87\x01\n" +
+	"\x13FavouriteAnnotation\x12\x17\n" +
+	"\anews_id\x18\x01 \x01(\x04R\x06newsId\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\x12/\n" +
+	"\n" +
+	"highlights\x18\x04 \x03(\v2\x0f.news.HighlightR\n" +
+	"highlights\"X\n" +
+	"\x1bFavouriteAnnotationResponse\x129\n" +
+	"\n" +
+	"annotation\x18\x01 \x01(\v2\x19.news.FavouriteAnnotationR\n" +
+	"annotation\"a\n" +
+	"\x1dGetFavouriteAnnotationRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12\x1f\n" +
+	"\anews_id\x18\x02 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06newsId\"\x7f\n" +
+	"\x17SetFavouriteTagsRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12\x1f\n" +
+	"\anews_id\x18\x02 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06newsId\x12\"\n" +
+	"\x04tags\x18\x03 \x03(\tB\x0e\xbaH\v\x92\x01\b\x10\x14\"\x04r\x02\x18@R\x04tags\"y\n" +
+	"\x17SetFavouriteNoteRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12\x1f\n" +
+	"\anews_id\x18\x02 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06newsId\x12\x1c\n" +
+	"\x04note\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x90NR\x04note\"\xa7\x02\n" +
+	"\x13AddHighlightRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12\x1f\n" +
+	"\anews_id\x18\x02 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06newsId\x12*\n" +
+	"\fstart_offset\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\vstartOffset\x12\x1d\n" +
+	"\n" +
+	"end_offset\x18\x04 \x01(\x05R\tendOffset\x12\x18\n" +
+	"\acomment\x18\x05 \x01(\tR\acomment:i\xbaHf\x1ad\n" +
+	"\x0fhighlight_range\x12,end_offset must be greater than start_offset\x1a#this.end_offset > this.start_offset\"B\n" +
+	"\x11HighlightResponse\x12-\n" +
+	"\thighlight\x18\x01 \x01(\v2\x0f.news.HighlightR\thighlight\"\x85\x01\n" +
+	"\x16DeleteHighlightRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12\x1f\n" +
+	"\anews_id\x18\x02 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06newsId\x12)\n" +
+	"\fhighlight_id\x18\x03 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\vhighlightId\"3\n" +
+	"\x17DeleteHighlightResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xd1\x01\n" +
+	"\x17SearchFavouritesRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12\x15\n" +
+	"\x03tag\x18\x02 \x01(\tH\x00R\x03tag\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"note_query\x18\x03 \x01(\tH\x01R\tnoteQuery\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\x12$\n" +
+	"\tpage_size\x18\x05 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bpageSizeB\x06\n" +
+	"\x04_tagB\r\n" +
+	"\v_note_query\"j\n" +
+	"\rAnnotatedNews\x12\x1e\n" +
+	"\x04news\x18\x01 \x01(\v2\n" +
+	".news.NewsR\x04news\x129\n" +
+	"\n" +
+	"annotation\x18\x02 \x01(\v2\x19.news.FavouriteAnnotationR\n" +
+	"annotation\"w\n" +
+	"\x18SearchFavouritesResponse\x123\n" +
+	"\n" +
+	"favourites\x18\x01 \x03(\v2\x13.news.AnnotatedNewsR\n" +
+	"favourites\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"U\n" +
+	"\x0fMarkSeenRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12!\n" +
+	"\bnews_ids\x18\x02 \x03(\x04B\x06\xbaH\x03\xc8\x01\x01R\anewsIds\",\n" +
+	"\x10MarkSeenResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"W\n" +
+	"\x11MarkUnseenRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12!\n" +
+	"\bnews_ids\x18\x02 \x03(\x04B\x06\xbaH\x03\xc8\x01\x01R\anewsIds\".\n" +
+	"\x12MarkUnseenResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"<\n" +
+	"\bSeenNews\x12\x17\n" +
+	"\anews_id\x18\x01 \x01(\x04R\x06newsId\x12\x17\n" +
+	"\aseen_at\x18\x02 \x01(\tR\x06seenAt\"\x91\x01\n" +
+	"\x0eGetSeenRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12\x19\n" +
+	"\bnews_ids\x18\x02 \x03(\x04R\anewsIds\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12$\n" +
+	"\tpage_size\x18\x04 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bpageSize\"]\n" +
+	"\x0fGetSeenResponse\x12\"\n" +
+	"\x04seen\x18\x01 \x03(\v2\x0e.news.SeenNewsR\x04seen\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x8c\x01\n" +
+	"\x19RecordNotificationRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12 \n" +
+	"\akeyword\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\akeyword\x12,\n" +
+	"\aarticle\x18\x03 \x01(\v2\n" +
+	".news.NewsB\x06\xbaH\x03\xc8\x01\x01R\aarticle\"6\n" +
+	"\x1aRecordNotificationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"d\n" +
+	"\x15ExportUserDataRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12*\n" +
+	"\x06format\x18\x02 \x01(\tB\x12\xbaH\x0fr\rR\x00R\x04jsonR\x03zipR\x06format\"k\n" +
+	"\x16ExportUserDataResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\"4\n" +
+	"\x11DeleteUserRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\".\n" +
+	"\x12DeleteUserResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xf4\x01\n" +
+	"\x06APIKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06prefix\x18\x03 \x01(\tR\x06prefix\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"rotated_at\x18\x06 \x01(\tR\trotatedAt\x12 \n" +
+	"\flast_used_at\x18\a \x01(\tR\n" +
+	"lastUsedAt\x12\x1d\n" +
+	"\n" +
+	"revoked_at\x18\b \x01(\tR\trevokedAt\x12\x17\n" +
+	"\auser_id\x18\t \x01(\x04R\x06userId\"j\n" +
+	"\x13CreateAPIKeyRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12\x1e\n" +
+	"\x06scopes\x18\x02 \x03(\tB\x06\xbaH\x03\xc8\x01\x01R\x06scopes\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x04R\x06userId\"O\n" +
+	"\x14APIKeySecretResponse\x12%\n" +
+	"\aapi_key\x18\x01 \x01(\v2\f.news.APIKeyR\x06apiKey\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"\x82\x01\n" +
+	"\x12ListAPIKeysRequest\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x01 \x01(\tR\tpageToken\x12$\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bpageSize\x12'\n" +
+	"\x0finclude_revoked\x18\x03 \x01(\bR\x0eincludeRevoked\"f\n" +
+	"\x13ListAPIKeysResponse\x12'\n" +
+	"\bapi_keys\x18\x01 \x03(\v2\f.news.APIKeyR\aapiKeys\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"-\n" +
+	"\x13RotateAPIKeyRequest\x12\x16\n" +
+	"\x02id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x02id\"-\n" +
+	"\x13RevokeAPIKeyRequest\x12\x16\n" +
+	"\x02id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x02id\"0\n" +
+	"\x14RevokeAPIKeyResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"-\n" +
+	"\x19AuthenticateAPIKeyRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"7\n" +
+	"\x0eAPIKeyResponse\x12%\n" +
+	"\aapi_key\x18\x01 \x01(\v2\f.news.APIKeyR\x06apiKey\"W\n" +
+	"\x10ListUsersRequest\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x01 \x01(\tR\tpageToken\x12$\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bpageSize\"]\n" +
+	"\x11ListUsersResponse\x12 \n" +
+	"\x05users\x18\x01 \x03(\v2\n" +
+	".news.UserR\x05users\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x16\n" +
+	"\x14RunMigrationsRequest\"\x17\n" +
+	"\x15RunMigrationsResponse\"\xd4\x03\n" +
+	"\x11SearchNewsRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12\x1c\n" +
+	"\x05query\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x05query\x12\x1d\n" +
 	"\asources\x18\x03 \x01(\tH\x00R\asources\x88\x01\x01\x12\x1d\n" +
 	"\adomains\x18\x04 \x01(\tH\x01R\adomains\x88\x01\x01\x12\x17\n" +
 	"\x04from\x18\x05 \x01(\tH\x02R\x04from\x88\x01\x01\x12\x13\n" +
@@ -1667,7 +7463,10 @@ const file_news_service_proto_rawDesc = "" +
 	"\asort_by\x18\b \x01(\tH\x05R\x06sortBy\x88\x01\x01\x12 \n" +
 	"\tpage_size\x18\t \x01(\x05H\x06R\bpageSize\x88\x01\x01\x12\x17\n" +
 	"\x04page\x18\n" +
-	" \x01(\x05H\aR\x04page\x88\x01\x01B\n" +
+	" \x01(\x05H\aR\x04page\x88\x01\x01\x12!\n" +
+	"\fskip_history\x18\v \x01(\bR\vskipHistory\x12\x1f\n" +
+	"\vunseen_only\x18\f \x01(\bR\n" +
+	"unseenOnlyB\n" +
 	"\n" +
 	"\b_sourcesB\n" +
 	"\n" +
@@ -1683,15 +7482,17 @@ const file_news_service_proto_rawDesc = "" +
 	"\x12SearchNewsResponse\x12\x1e\n" +
 	"\x04news\x18\x01 \x03(\v2\n" +
 	".news.NewsR\x04news\x12#\n" +
-	"\rtotal_results\x18\x02 \x01(\x05R\ftotalResults\"\xac\x02\n" +
-	"\x16GetTopHeadlinesRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1d\n" +
+	"\rtotal_results\x18\x02 \x01(\x05R\ftotalResults\"\xd5\x02\n" +
+	"\x16GetTopHeadlinesRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12\x1d\n" +
 	"\acountry\x18\x02 \x01(\tH\x00R\acountry\x88\x01\x01\x12\x1f\n" +
 	"\bcategory\x18\x03 \x01(\tH\x01R\bcategory\x88\x01\x01\x12\x1d\n" +
 	"\asources\x18\x04 \x01(\tH\x02R\asources\x88\x01\x01\x12\x19\n" +
 	"\x05query\x18\x05 \x01(\tH\x03R\x05query\x88\x01\x01\x12 \n" +
 	"\tpage_size\x18\x06 \x01(\x05H\x04R\bpageSize\x88\x01\x01\x12\x17\n" +
-	"\x04page\x18\a \x01(\x05H\x05R\x04page\x88\x01\x01B\n" +
+	"\x04page\x18\a \x01(\x05H\x05R\x04page\x88\x01\x01\x12\x1f\n" +
+	"\vunseen_only\x18\b \x01(\bR\n" +
+	"unseenOnlyB\n" +
 	"\n" +
 	"\b_countryB\v\n" +
 	"\t_categoryB\n" +
@@ -1704,20 +7505,25 @@ const file_news_service_proto_rawDesc = "" +
 	"\x17GetTopHeadlinesResponse\x12\x1e\n" +
 	"\x04news\x18\x01 \x03(\v2\n" +
 	".news.NewsR\x04news\x12#\n" +
-	"\rtotal_results\x18\x02 \x01(\x05R\ftotalResults\"[\n" +
-	"\x17CheckNewArticlesRequest\x12\x18\n" +
-	"\akeyword\x18\x01 \x01(\tR\akeyword\x12&\n" +
+	"\rtotal_results\x18\x02 \x01(\x05R\ftotalResults\"c\n" +
+	"\x17CheckNewArticlesRequest\x12 \n" +
+	"\akeyword\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\akeyword\x12&\n" +
 	"\x0flast_check_time\x18\x02 \x01(\tR\rlastCheckTime\"\x80\x01\n" +
 	"\x18CheckNewArticlesResponse\x12-\n" +
 	"\fnew_articles\x18\x01 \x03(\v2\n" +
 	".news.NewsR\vnewArticles\x125\n" +
 	"\n" +
-	"user_stats\x18\x02 \x03(\v2\x16.news.UserArticleStatsR\tuserStats\"t\n" +
-	"\x17SendNotificationRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x18\n" +
+	"user_stats\x18\x02 \x03(\v2\x16.news.UserArticleStatsR\tuserStats\"-\n" +
+	"\x11PurgeCacheRequest\x12\x18\n" +
+	"\apattern\x18\x01 \x01(\tR\apattern\".\n" +
+	"\x12PurgeCacheResponse\x12\x18\n" +
+	"\adeleted\x18\x01 \x01(\x03R\adeleted\"\xf4\x01\n" +
+	"\x17SendNotificationRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\x04B\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
 	"\barticles\x18\x03 \x03(\v2\n" +
-	".news.NewsR\barticles\"z\n" +
+	".news.NewsR\barticles:v\xbaHs\x1aq\n" +
+	"\x13message_or_articles\x12+either message or articles must be provided\x1a-this.message != '' || size(this.articles) > 0\"z\n" +
 	"\x10UserArticleStats\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12%\n" +
 	"\x0earticles_count\x18\x02 \x01(\x05R\rarticlesCount\x12&\n" +
@@ -1727,25 +7533,85 @@ const file_news_service_proto_rawDesc = "" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1d\n" +
 	"\n" +
 	"sent_count\x18\x02 \x01(\x05R\tsentCount\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage2\xb0\x05\n" +
-	"\vSaveService\x12A\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"9\n" +
+	"\x18ReplayDeadLettersRequest\x12\x1d\n" +
+	"\x05limit\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x05limit\"7\n" +
+	"\x19ReplayDeadLettersResponse\x12\x1a\n" +
+	"\breplayed\x18\x01 \x01(\x05R\breplayed\"H\n" +
+	"\x13TriggerCheckRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x18\n" +
+	"\akeyword\x18\x02 \x01(\tR\akeyword\"S\n" +
+	"\x14TriggerCheckResponse\x12\x18\n" +
+	"\achecked\x18\x01 \x01(\x05R\achecked\x12!\n" +
+	"\fnew_articles\x18\x02 \x01(\x05R\vnewArticles2\xdf-\n" +
+	"\vSaveService\x12U\n" +
+	"\n" +
+	"CreateUser\x12\x17.news.CreateUserRequest\x1a\x18.news.CreateUserResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/users\x12P\n" +
+	"\aGetUser\x12\x14.news.GetUserRequest\x1a\x12.news.UserResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/users/{user_id}\x12Y\n" +
+	"\n" +
+	"UpdateUser\x12\x17.news.UpdateUserRequest\x1a\x12.news.UserResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*2\x13/v1/users/{user_id}\x12;\n" +
+	"\bSaveNews\x12\x15.news.SaveNewsRequest\x1a\x16.news.SaveNewsResponse\"\x00\x12W\n" +
+	"\fGetNewsByIDs\x12\x19.news.GetNewsByIDsRequest\x1a\x1a.news.GetNewsByIDsResponse\"\x10\x82\xd3\xe4\x93\x02\n" +
+	"\x12\b/v1/news\x12p\n" +
+	"\fAddFavourite\x12\x19.news.AddFavouriteRequest\x1a\x1a.news.AddFavouriteResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/users/{user_id}/favourites\x12p\n" +
+	"\rGetFavourites\x12\x1a.news.GetFavouritesRequest\x1a\x1b.news.GetFavouritesResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/users/{user_id}/favourites\x12Y\n" +
+	"\x12AddToSearchHistory\x12\x1f.news.AddToSearchHistoryRequest\x1a .news.AddToSearchHistoryResponse\"\x00\x12}\n" +
+	"\x10GetSearchHistory\x12\x1d.news.GetSearchHistoryRequest\x1a\x1e.news.GetSearchHistoryResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/users/{user_id}/search-history\x12\x98\x01\n" +
+	"\x15GetSearchHistoryEntry\x12\".news.GetSearchHistoryEntryRequest\x1a#.news.GetSearchHistoryEntryResponse\"6\x82\xd3\xe4\x93\x020\x12./v1/users/{user_id}/search-history/{search_id}\x12j\n" +
+	"\tSubscribe\x12\x16.news.SubscribeRequest\x1a\x17.news.SubscribeResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/users/{user_id}/subscriptions\x12|\n" +
+	"\x10GetSubscriptions\x12\x1d.news.GetSubscriptionsRequest\x1a\x1e.news.GetSubscriptionsResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/users/{user_id}/subscriptions\x12\x91\x01\n" +
+	"\x12UpdateSubscription\x12\x1f.news.UpdateSubscriptionRequest\x1a\x1a.news.SubscriptionResponse\">\x82\xd3\xe4\x93\x028:\x01*23/v1/users/{user_id}/subscriptions/{subscription_id}\x12}\n" +
+	"\x11CreateSavedSearch\x12\x1e.news.CreateSavedSearchRequest\x1a\x19.news.SavedSearchResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/users/{user_id}/saved-searches\x12y\n" +
+	"\x0eGetSavedSearch\x12\x1b.news.GetSavedSearchRequest\x1a\x19.news.SavedSearchResponse\"/\x82\xd3\xe4\x93\x02)\x12'/v1/users/{user_id}/saved-searches/{id}\x12\x80\x01\n" +
+	"\x11ListSavedSearches\x12\x1e.news.ListSavedSearchesRequest\x1a\x1f.news.ListSavedSearchesResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/users/{user_id}/saved-searches\x12\x82\x01\n" +
+	"\x11UpdateSavedSearch\x12\x1e.news.UpdateSavedSearchRequest\x1a\x19.news.SavedSearchResponse\"2\x82\xd3\xe4\x93\x02,:\x01*2'/v1/users/{user_id}/saved-searches/{id}\x12\x85\x01\n" +
+	"\x11DeleteSavedSearch\x12\x1e.news.DeleteSavedSearchRequest\x1a\x1f.news.DeleteSavedSearchResponse\"/\x82\xd3\xe4\x93\x02)*'/v1/users/{user_id}/saved-searches/{id}\x12V\n" +
+	"\x14RecordSavedSearchRun\x12!.news.RecordSavedSearchRunRequest\x1a\x19.news.SavedSearchResponse\"\x00\x12w\n" +
+	"\x10CreateCollection\x12\x1d.news.CreateCollectionRequest\x1a\x18.news.CollectionResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/users/{user_id}/collections\x12w\n" +
+	"\x0fListCollections\x12\x1c.news.ListCollectionsRequest\x1a\x1d.news.ListCollectionsResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/users/{user_id}/collections\x12\x87\x01\n" +
+	"\x10RenameCollection\x12\x1d.news.RenameCollectionRequest\x1a\x18.news.CollectionResponse\":\x82\xd3\xe4\x93\x024:\x01*2//v1/users/{user_id}/collections/{collection_id}\x12\x8a\x01\n" +
+	"\x10DeleteCollection\x12\x1d.news.DeleteCollectionRequest\x1a\x1e.news.DeleteCollectionResponse\"7\x82\xd3\xe4\x93\x021*//v1/users/{user_id}/collections/{collection_id}\x12\x93\x01\n" +
+	"\x12GetCollectionItems\x12\x1f.news.GetCollectionItemsRequest\x1a\x1d.news.CollectionItemsResponse\"=\x82\xd3\xe4\x93\x027\x125/v1/users/{user_id}/collections/{collection_id}/items\x12\x90\x01\n" +
+	"\x0fAddToCollection\x12\x1c.news.AddToCollectionRequest\x1a\x1d.news.AddToCollectionResponse\"@\x82\xd3\xe4\x93\x02::\x01*\"5/v1/users/{user_id}/collections/{collection_id}/items\x12\xa6\x01\n" +
+	"\x14RemoveFromCollection\x12!.news.RemoveFromCollectionRequest\x1a\".news.RemoveFromCollectionResponse\"G\x82\xd3\xe4\x93\x02A*?/v1/users/{user_id}/collections/{collection_id}/items/{news_id}\x12\xa6\x01\n" +
+	"\x13CopyCollectionItems\x12 .news.CopyCollectionItemsRequest\x1a!.news.CopyCollectionItemsResponse\"J\x82\xd3\xe4\x93\x02D:\x01*\"?/v1/users/{user_id}/collections/{from_collection_id}/items:copy\x12\x96\x01\n" +
+	"\x11ReorderCollection\x12\x1e.news.ReorderCollectionRequest\x1a\x1f.news.ReorderCollectionResponse\"@\x82\xd3\xe4\x93\x02::\x01*\x1a5/v1/users/{user_id}/collections/{collection_id}/order\x12\x8b\x01\n" +
+	"\x0fShareCollection\x12\x1c.news.ShareCollectionRequest\x1a\x18.news.CollectionResponse\"@\x82\xd3\xe4\x93\x02::\x01*\"5/v1/users/{user_id}/collections/{collection_id}:share\x12\x84\x01\n" +
+	"\x13GetSharedCollection\x12 .news.GetSharedCollectionRequest\x1a\x1d.news.CollectionItemsResponse\",\x82\xd3\xe4\x93\x02&\x12$/v1/shared/collections/{share_token}\x12\x9d\x01\n" +
+	"\x16GetFavouriteAnnotation\x12#.news.GetFavouriteAnnotationRequest\x1a!.news.FavouriteAnnotationResponse\";\x82\xd3\xe4\x93\x025\x123/v1/users/{user_id}/favourites/{news_id}/annotation\x12\x8e\x01\n" +
+	"\x10SetFavouriteTags\x12\x1d.news.SetFavouriteTagsRequest\x1a!.news.FavouriteAnnotationResponse\"8\x82\xd3\xe4\x93\x022:\x01*\x1a-/v1/users/{user_id}/favourites/{news_id}/tags\x12\x8e\x01\n" +
+	"\x10SetFavouriteNote\x12\x1d.news.SetFavouriteNoteRequest\x1a!.news.FavouriteAnnotationResponse\"8\x82\xd3\xe4\x93\x022:\x01*\x1a-/v1/users/{user_id}/favourites/{news_id}/note\x12\x82\x01\n" +
+	"\fAddHighlight\x12\x19.news.AddHighlightRequest\x1a\x17.news.HighlightResponse\">\x82\xd3\xe4\x93\x028:\x01*\"3/v1/users/{user_id}/favourites/{news_id}/highlights\x12\x9a\x01\n" +
+	"\x0fDeleteHighlight\x12\x1c.news.DeleteHighlightRequest\x1a\x1d.news.DeleteHighlightResponse\"J\x82\xd3\xe4\x93\x02D*B/v1/users/{user_id}/favourites/{news_id}/highlights/{highlight_id}\x12\x80\x01\n" +
+	"\x10SearchFavourites\x12\x1d.news.SearchFavouritesRequest\x1a\x1e.news.SearchFavouritesResponse\"-\x82\xd3\xe4\x93\x02'\x12%/v1/users/{user_id}/favourites:search\x12^\n" +
+	"\bMarkSeen\x12\x15.news.MarkSeenRequest\x1a\x16.news.MarkSeenResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/users/{user_id}/seen\x12k\n" +
+	"\n" +
+	"MarkUnseen\x12\x17.news.MarkUnseenRequest\x1a\x18.news.MarkUnseenResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/users/{user_id}/seen:remove\x12X\n" +
+	"\aGetSeen\x12\x14.news.GetSeenRequest\x1a\x15.news.GetSeenResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/users/{user_id}/seen\x12Y\n" +
+	"\x12RecordNotification\x12\x1f.news.RecordNotificationRequest\x1a .news.RecordNotificationResponse\"\x00\x12M\n" +
+	"\x0eExportUserData\x12\x1b.news.ExportUserDataRequest\x1a\x1c.news.ExportUserDataResponse\"\x00\x12\\\n" +
+	"\n" +
+	"DeleteUser\x12\x17.news.DeleteUserRequest\x1a\x18.news.DeleteUserResponse\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/v1/users/{user_id}\x12G\n" +
+	"\fCreateAPIKey\x12\x19.news.CreateAPIKeyRequest\x1a\x1a.news.APIKeySecretResponse\"\x00\x12D\n" +
+	"\vListAPIKeys\x12\x18.news.ListAPIKeysRequest\x1a\x19.news.ListAPIKeysResponse\"\x00\x12G\n" +
+	"\fRotateAPIKey\x12\x19.news.RotateAPIKeyRequest\x1a\x1a.news.APIKeySecretResponse\"\x00\x12G\n" +
+	"\fRevokeAPIKey\x12\x19.news.RevokeAPIKeyRequest\x1a\x1a.news.RevokeAPIKeyResponse\"\x00\x12M\n" +
+	"\x12AuthenticateAPIKey\x12\x1f.news.AuthenticateAPIKeyRequest\x1a\x14.news.APIKeyResponse\"\x00\x12>\n" +
+	"\tListUsers\x12\x16.news.ListUsersRequest\x1a\x17.news.ListUsersResponse\"\x00\x12J\n" +
+	"\rRunMigrations\x12\x1a.news.RunMigrationsRequest\x1a\x1b.news.RunMigrationsResponse\"\x00\x12[\n" +
+	"\x14ListAllSubscriptions\x12!.news.ListAllSubscriptionsRequest\x1a\x1e.news.GetSubscriptionsResponse\"\x00\x12\\\n" +
+	"\x14ListAllSavedSearches\x12!.news.ListAllSavedSearchesRequest\x1a\x1f.news.ListSavedSearchesResponse\"\x002\xef\x02\n" +
+	"\rSearchService\x12X\n" +
 	"\n" +
-	"CreateUser\x12\x17.news.CreateUserRequest\x1a\x18.news.CreateUserResponse\"\x00\x12;\n" +
-	"\bSaveNews\x12\x15.news.SaveNewsRequest\x1a\x16.news.SaveNewsResponse\"\x00\x12G\n" +
-	"\fGetNewsByIDs\x12\x19.news.GetNewsByIDsRequest\x1a\x1a.news.GetNewsByIDsResponse\"\x00\x12G\n" +
-	"\fAddFavourite\x12\x19.news.AddFavouriteRequest\x1a\x1a.news.AddFavouriteResponse\"\x00\x12J\n" +
-	"\rGetFavourites\x12\x1a.news.GetFavouritesRequest\x1a\x1b.news.GetFavouritesResponse\"\x00\x12Y\n" +
-	"\x12AddToSearchHistory\x12\x1f.news.AddToSearchHistoryRequest\x1a .news.AddToSearchHistoryResponse\"\x00\x12S\n" +
-	"\x10GetSearchHistory\x12\x1d.news.GetSearchHistoryRequest\x1a\x1e.news.GetSearchHistoryResponse\"\x00\x12>\n" +
-	"\tSubscribe\x12\x16.news.SubscribeRequest\x1a\x17.news.SubscribeResponse\"\x00\x12S\n" +
-	"\x10GetSubscriptions\x12\x1d.news.GetSubscriptionsRequest\x1a\x1e.news.GetSubscriptionsResponse\"\x002\xf9\x01\n" +
-	"\rSearchService\x12A\n" +
+	"SearchNews\x12\x17.news.SearchNewsRequest\x1a\x18.news.SearchNewsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/search/news\x12l\n" +
+	"\x0fGetTopHeadlines\x12\x1c.news.GetTopHeadlinesRequest\x1a\x1d.news.GetTopHeadlinesResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/search/headlines\x12S\n" +
+	"\x10CheckNewArticles\x12\x1d.news.CheckNewArticlesRequest\x1a\x1e.news.CheckNewArticlesResponse\"\x00\x12A\n" +
 	"\n" +
-	"SearchNews\x12\x17.news.SearchNewsRequest\x1a\x18.news.SearchNewsResponse\"\x00\x12P\n" +
-	"\x0fGetTopHeadlines\x12\x1c.news.GetTopHeadlinesRequest\x1a\x1d.news.GetTopHeadlinesResponse\"\x00\x12S\n" +
-	"\x10CheckNewArticles\x12\x1d.news.CheckNewArticlesRequest\x1a\x1e.news.CheckNewArticlesResponse\"\x002j\n" +
+	"PurgeCache\x12\x17.news.PurgeCacheRequest\x1a\x18.news.PurgeCacheResponse\"\x002\x8b\x02\n" +
 	"\x13NotificationService\x12S\n" +
-	"\x10SendNotification\x12\x1d.news.SendNotificationRequest\x1a\x1e.news.SendNotificationResponse\"\x00B\x14Z\x12gonews/internal/pbb\x06proto3"
+	"\x10SendNotification\x12\x1d.news.SendNotificationRequest\x1a\x1e.news.SendNotificationResponse\"\x00\x12V\n" +
+	"\x11ReplayDeadLetters\x12\x1e.news.ReplayDeadLettersRequest\x1a\x1f.news.ReplayDeadLettersResponse\"\x00\x12G\n" +
+	"\fTriggerCheck\x12\x19.news.TriggerCheckRequest\x1a\x1a.news.TriggerCheckResponse\"\x00B\x14Z\x12gonews/internal/pbb\x06proto3"
 
 var (
 	file_news_service_proto_rawDescOnce sync.Once
@@ -1759,80 +7625,289 @@ func file_news_service_proto_rawDescGZIP() []byte {
 	return file_news_service_proto_rawDescData
 }
 
-var file_news_service_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_news_service_proto_msgTypes = make([]protoimpl.MessageInfo, 117)
 var file_news_service_proto_goTypes = []any{
-	(*News)(nil),                       // 0: news.News
-	(*CreateUserRequest)(nil),          // 1: news.CreateUserRequest
-	(*CreateUserResponse)(nil),         // 2: news.CreateUserResponse
-	(*SaveNewsRequest)(nil),            // 3: news.SaveNewsRequest
-	(*SaveNewsResponse)(nil),           // 4: news.SaveNewsResponse
-	(*GetNewsByIDsRequest)(nil),        // 5: news.GetNewsByIDsRequest
-	(*GetNewsByIDsResponse)(nil),       // 6: news.GetNewsByIDsResponse
-	(*AddFavouriteRequest)(nil),        // 7: news.AddFavouriteRequest
-	(*AddFavouriteResponse)(nil),       // 8: news.AddFavouriteResponse
-	(*GetFavouritesRequest)(nil),       // 9: news.GetFavouritesRequest
-	(*GetFavouritesResponse)(nil),      // 10: news.GetFavouritesResponse
-	(*AddToSearchHistoryRequest)(nil),  // 11: news.AddToSearchHistoryRequest
-	(*AddToSearchHistoryResponse)(nil), // 12: news.AddToSearchHistoryResponse
-	(*GetSearchHistoryRequest)(nil),    // 13: news.GetSearchHistoryRequest
-	(*GetSearchHistoryResponse)(nil),   // 14: news.GetSearchHistoryResponse
-	(*SubscribeRequest)(nil),           // 15: news.SubscribeRequest
-	(*SubscribeResponse)(nil),          // 16: news.SubscribeResponse
-	(*GetSubscriptionsRequest)(nil),    // 17: news.GetSubscriptionsRequest
-	(*GetSubscriptionsResponse)(nil),   // 18: news.GetSubscriptionsResponse
-	(*Subscription)(nil),               // 19: news.Subscription
-	(*SearchNewsRequest)(nil),          // 20: news.SearchNewsRequest
-	(*SearchNewsResponse)(nil),         // 21: news.SearchNewsResponse
-	(*GetTopHeadlinesRequest)(nil),     // 22: news.GetTopHeadlinesRequest
-	(*GetTopHeadlinesResponse)(nil),    // 23: news.GetTopHeadlinesResponse
-	(*CheckNewArticlesRequest)(nil),    // 24: news.CheckNewArticlesRequest
-	(*CheckNewArticlesResponse)(nil),   // 25: news.CheckNewArticlesResponse
-	(*SendNotificationRequest)(nil),    // 26: news.SendNotificationRequest
-	(*UserArticleStats)(nil),           // 27: news.UserArticleStats
-	(*SendNotificationResponse)(nil),   // 28: news.SendNotificationResponse
+	(*News)(nil),                          // 0: news.News
+	(*User)(nil),                          // 1: news.User
+	(*CreateUserRequest)(nil),             // 2: news.CreateUserRequest
+	(*CreateUserResponse)(nil),            // 3: news.CreateUserResponse
+	(*GetUserRequest)(nil),                // 4: news.GetUserRequest
+	(*UpdateUserRequest)(nil),             // 5: news.UpdateUserRequest
+	(*UserResponse)(nil),                  // 6: news.UserResponse
+	(*SaveNewsRequest)(nil),               // 7: news.SaveNewsRequest
+	(*SaveNewsResponse)(nil),              // 8: news.SaveNewsResponse
+	(*GetNewsByIDsRequest)(nil),           // 9: news.GetNewsByIDsRequest
+	(*GetNewsByIDsResponse)(nil),          // 10: news.GetNewsByIDsResponse
+	(*AddFavouriteRequest)(nil),           // 11: news.AddFavouriteRequest
+	(*AddFavouriteResponse)(nil),          // 12: news.AddFavouriteResponse
+	(*GetFavouritesRequest)(nil),          // 13: news.GetFavouritesRequest
+	(*GetFavouritesResponse)(nil),         // 14: news.GetFavouritesResponse
+	(*AddToSearchHistoryRequest)(nil),     // 15: news.AddToSearchHistoryRequest
+	(*SearchFilters)(nil),                 // 16: news.SearchFilters
+	(*SearchHistoryEntry)(nil),            // 17: news.SearchHistoryEntry
+	(*AddToSearchHistoryResponse)(nil),    // 18: news.AddToSearchHistoryResponse
+	(*GetSearchHistoryRequest)(nil),       // 19: news.GetSearchHistoryRequest
+	(*GetSearchHistoryResponse)(nil),      // 20: news.GetSearchHistoryResponse
+	(*GetSearchHistoryEntryRequest)(nil),  // 21: news.GetSearchHistoryEntryRequest
+	(*GetSearchHistoryEntryResponse)(nil), // 22: news.GetSearchHistoryEntryResponse
+	(*SubscribeRequest)(nil),              // 23: news.SubscribeRequest
+	(*SubscribeResponse)(nil),             // 24: news.SubscribeResponse
+	(*GetSubscriptionsRequest)(nil),       // 25: news.GetSubscriptionsRequest
+	(*GetSubscriptionsResponse)(nil),      // 26: news.GetSubscriptionsResponse
+	(*ListAllSubscriptionsRequest)(nil),   // 27: news.ListAllSubscriptionsRequest
+	(*Subscription)(nil),                  // 28: news.Subscription
+	(*UpdateSubscriptionRequest)(nil),     // 29: news.UpdateSubscriptionRequest
+	(*SubscriptionResponse)(nil),          // 30: news.SubscriptionResponse
+	(*SavedSearch)(nil),                   // 31: news.SavedSearch
+	(*CreateSavedSearchRequest)(nil),      // 32: news.CreateSavedSearchRequest
+	(*GetSavedSearchRequest)(nil),         // 33: news.GetSavedSearchRequest
+	(*ListSavedSearchesRequest)(nil),      // 34: news.ListSavedSearchesRequest
+	(*ListSavedSearchesResponse)(nil),     // 35: news.ListSavedSearchesResponse
+	(*ListAllSavedSearchesRequest)(nil),   // 36: news.ListAllSavedSearchesRequest
+	(*UpdateSavedSearchRequest)(nil),      // 37: news.UpdateSavedSearchRequest
+	(*DeleteSavedSearchRequest)(nil),      // 38: news.DeleteSavedSearchRequest
+	(*DeleteSavedSearchResponse)(nil),     // 39: news.DeleteSavedSearchResponse
+	(*RecordSavedSearchRunRequest)(nil),   // 40: news.RecordSavedSearchRunRequest
+	(*SavedSearchResponse)(nil),           // 41: news.SavedSearchResponse
+	(*Collection)(nil),                    // 42: news.Collection
+	(*CollectionResponse)(nil),            // 43: news.CollectionResponse
+	(*CreateCollectionRequest)(nil),       // 44: news.CreateCollectionRequest
+	(*ListCollectionsRequest)(nil),        // 45: news.ListCollectionsRequest
+	(*ListCollectionsResponse)(nil),       // 46: news.ListCollectionsResponse
+	(*RenameCollectionRequest)(nil),       // 47: news.RenameCollectionRequest
+	(*DeleteCollectionRequest)(nil),       // 48: news.DeleteCollectionRequest
+	(*DeleteCollectionResponse)(nil),      // 49: news.DeleteCollectionResponse
+	(*GetCollectionItemsRequest)(nil),     // 50: news.GetCollectionItemsRequest
+	(*CollectionItemsResponse)(nil),       // 51: news.CollectionItemsResponse
+	(*AddToCollectionRequest)(nil),        // 52: news.AddToCollectionRequest
+	(*AddToCollectionResponse)(nil),       // 53: news.AddToCollectionResponse
+	(*RemoveFromCollectionRequest)(nil),   // 54: news.RemoveFromCollectionRequest
+	(*RemoveFromCollectionResponse)(nil),  // 55: news.RemoveFromCollectionResponse
+	(*CopyCollectionItemsRequest)(nil),    // 56: news.CopyCollectionItemsRequest
+	(*CopyCollectionItemsResponse)(nil),   // 57: news.CopyCollectionItemsResponse
+	(*ReorderCollectionRequest)(nil),      // 58: news.ReorderCollectionRequest
+	(*ReorderCollectionResponse)(nil),     // 59: news.ReorderCollectionResponse
+	(*ShareCollectionRequest)(nil),        // 60: news.ShareCollectionRequest
+	(*GetSharedCollectionRequest)(nil),    // 61: news.GetSharedCollectionRequest
+	(*Highlight)(nil),                     // 62: news.Highlight
+	(*FavouriteAnnotation)(nil),           // 63: news.FavouriteAnnotation
+	(*FavouriteAnnotationResponse)(nil),   // 64: news.FavouriteAnnotationResponse
+	(*GetFavouriteAnnotationRequest)(nil), // 65: news.GetFavouriteAnnotationRequest
+	(*SetFavouriteTagsRequest)(nil),       // 66: news.SetFavouriteTagsRequest
+	(*SetFavouriteNoteRequest)(nil),       // 67: news.SetFavouriteNoteRequest
+	(*AddHighlightRequest)(nil),           // 68: news.AddHighlightRequest
+	(*HighlightResponse)(nil),             // 69: news.HighlightResponse
+	(*DeleteHighlightRequest)(nil),        // 70: news.DeleteHighlightRequest
+	(*DeleteHighlightResponse)(nil),       // 71: news.DeleteHighlightResponse
+	(*SearchFavouritesRequest)(nil),       // 72: news.SearchFavouritesRequest
+	(*AnnotatedNews)(nil),                 // 73: news.AnnotatedNews
+	(*SearchFavouritesResponse)(nil),      // 74: news.SearchFavouritesResponse
+	(*MarkSeenRequest)(nil),               // 75: news.MarkSeenRequest
+	(*MarkSeenResponse)(nil),              // 76: news.MarkSeenResponse
+	(*MarkUnseenRequest)(nil),             // 77: news.MarkUnseenRequest
+	(*MarkUnseenResponse)(nil),            // 78: news.MarkUnseenResponse
+	(*SeenNews)(nil),                      // 79: news.SeenNews
+	(*GetSeenRequest)(nil),                // 80: news.GetSeenRequest
+	(*GetSeenResponse)(nil),               // 81: news.GetSeenResponse
+	(*RecordNotificationRequest)(nil),     // 82: news.RecordNotificationRequest
+	(*RecordNotificationResponse)(nil),    // 83: news.RecordNotificationResponse
+	(*ExportUserDataRequest)(nil),         // 84: news.ExportUserDataRequest
+	(*ExportUserDataResponse)(nil),        // 85: news.ExportUserDataResponse
+	(*DeleteUserRequest)(nil),             // 86: news.DeleteUserRequest
+	(*DeleteUserResponse)(nil),            // 87: news.DeleteUserResponse
+	(*APIKey)(nil),                        // 88: news.APIKey
+	(*CreateAPIKeyRequest)(nil),           // 89: news.CreateAPIKeyRequest
+	(*APIKeySecretResponse)(nil),          // 90: news.APIKeySecretResponse
+	(*ListAPIKeysRequest)(nil),            // 91: news.ListAPIKeysRequest
+	(*ListAPIKeysResponse)(nil),           // 92: news.ListAPIKeysResponse
+	(*RotateAPIKeyRequest)(nil),           // 93: news.RotateAPIKeyRequest
+	(*RevokeAPIKeyRequest)(nil),           // 94: news.RevokeAPIKeyRequest
+	(*RevokeAPIKeyResponse)(nil),          // 95: news.RevokeAPIKeyResponse
+	(*AuthenticateAPIKeyRequest)(nil),     // 96: news.AuthenticateAPIKeyRequest
+	(*APIKeyResponse)(nil),                // 97: news.APIKeyResponse
+	(*ListUsersRequest)(nil),              // 98: news.ListUsersRequest
+	(*ListUsersResponse)(nil),             // 99: news.ListUsersResponse
+	(*RunMigrationsRequest)(nil),          // 100: news.RunMigrationsRequest
+	(*RunMigrationsResponse)(nil),         // 101: news.RunMigrationsResponse
+	(*SearchNewsRequest)(nil),             // 102: news.SearchNewsRequest
+	(*SearchNewsResponse)(nil),            // 103: news.SearchNewsResponse
+	(*GetTopHeadlinesRequest)(nil),        // 104: news.GetTopHeadlinesRequest
+	(*GetTopHeadlinesResponse)(nil),       // 105: news.GetTopHeadlinesResponse
+	(*CheckNewArticlesRequest)(nil),       // 106: news.CheckNewArticlesRequest
+	(*CheckNewArticlesResponse)(nil),      // 107: news.CheckNewArticlesResponse
+	(*PurgeCacheRequest)(nil),             // 108: news.PurgeCacheRequest
+	(*PurgeCacheResponse)(nil),            // 109: news.PurgeCacheResponse
+	(*SendNotificationRequest)(nil),       // 110: news.SendNotificationRequest
+	(*UserArticleStats)(nil),              // 111: news.UserArticleStats
+	(*SendNotificationResponse)(nil),      // 112: news.SendNotificationResponse
+	(*ReplayDeadLettersRequest)(nil),      // 113: news.ReplayDeadLettersRequest
+	(*ReplayDeadLettersResponse)(nil),     // 114: news.ReplayDeadLettersResponse
+	(*TriggerCheckRequest)(nil),           // 115: news.TriggerCheckRequest
+	(*TriggerCheckResponse)(nil),          // 116: news.TriggerCheckResponse
 }
 var file_news_service_proto_depIdxs = []int32{
-	0,  // 0: news.SaveNewsRequest.news:type_name -> news.News
-	0,  // 1: news.GetNewsByIDsResponse.news:type_name -> news.News
-	0,  // 2: news.GetFavouritesResponse.news:type_name -> news.News
-	19, // 3: news.GetSubscriptionsResponse.subscriptions:type_name -> news.Subscription
-	0,  // 4: news.SearchNewsResponse.news:type_name -> news.News
-	0,  // 5: news.GetTopHeadlinesResponse.news:type_name -> news.News
-	0,  // 6: news.CheckNewArticlesResponse.new_articles:type_name -> news.News
-	27, // 7: news.CheckNewArticlesResponse.user_stats:type_name -> news.UserArticleStats
-	0,  // 8: news.SendNotificationRequest.articles:type_name -> news.News
-	0,  // 9: news.UserArticleStats.articles:type_name -> news.News
-	1,  // 10: news.SaveService.CreateUser:input_type -> news.CreateUserRequest
-	3,  // 11: news.SaveService.SaveNews:input_type -> news.SaveNewsRequest
-	5,  // 12: news.SaveService.GetNewsByIDs:input_type -> news.GetNewsByIDsRequest
-	7,  // 13: news.SaveService.AddFavourite:input_type -> news.AddFavouriteRequest
-	9,  // 14: news.SaveService.GetFavourites:input_type -> news.GetFavouritesRequest
-	11, // 15: news.SaveService.AddToSearchHistory:input_type -> news.AddToSearchHistoryRequest
-	13, // 16: news.SaveService.GetSearchHistory:input_type -> news.GetSearchHistoryRequest
-	15, // 17: news.SaveService.Subscribe:input_type -> news.SubscribeRequest
-	17, // 18: news.SaveService.GetSubscriptions:input_type -> news.GetSubscriptionsRequest
-	20, // 19: news.SearchService.SearchNews:input_type -> news.SearchNewsRequest
-	22, // 20: news.SearchService.GetTopHeadlines:input_type -> news.GetTopHeadlinesRequest
-	24, // 21: news.SearchService.CheckNewArticles:input_type -> news.CheckNewArticlesRequest
-	26, // 22: news.NotificationService.SendNotification:input_type -> news.SendNotificationRequest
-	2,  // 23: news.SaveService.CreateUser:output_type -> news.CreateUserResponse
-	4,  // 24: news.SaveService.SaveNews:output_type -> news.SaveNewsResponse
-	6,  // 25: news.SaveService.GetNewsByIDs:output_type -> news.GetNewsByIDsResponse
-	8,  // 26: news.SaveService.AddFavourite:output_type -> news.AddFavouriteResponse
-	10, // 27: news.SaveService.GetFavourites:output_type -> news.GetFavouritesResponse
-	12, // 28: news.SaveService.AddToSearchHistory:output_type -> news.AddToSearchHistoryResponse
-	14, // 29: news.SaveService.GetSearchHistory:output_type -> news.GetSearchHistoryResponse
-	16, // 30: news.SaveService.Subscribe:output_type -> news.SubscribeResponse
-	18, // 31: news.SaveService.GetSubscriptions:output_type -> news.GetSubscriptionsResponse
-	21, // 32: news.SearchService.SearchNews:output_type -> news.SearchNewsResponse
-	23, // 33: news.SearchService.GetTopHeadlines:output_type -> news.GetTopHeadlinesResponse
-	25, // 34: news.SearchService.CheckNewArticles:output_type -> news.CheckNewArticlesResponse
-	28, // 35: news.NotificationService.SendNotification:output_type -> news.SendNotificationResponse
-	23, // [23:36] is the sub-list for method output_type
-	10, // [10:23] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	16,  // 0: news.User.default_filters:type_name -> news.SearchFilters
+	16,  // 1: news.CreateUserRequest.default_filters:type_name -> news.SearchFilters
+	1,   // 2: news.CreateUserResponse.user:type_name -> news.User
+	16,  // 3: news.UpdateUserRequest.default_filters:type_name -> news.SearchFilters
+	1,   // 4: news.UserResponse.user:type_name -> news.User
+	0,   // 5: news.SaveNewsRequest.news:type_name -> news.News
+	0,   // 6: news.GetNewsByIDsResponse.news:type_name -> news.News
+	0,   // 7: news.GetFavouritesResponse.news:type_name -> news.News
+	16,  // 8: news.AddToSearchHistoryRequest.filters:type_name -> news.SearchFilters
+	16,  // 9: news.SearchHistoryEntry.filters:type_name -> news.SearchFilters
+	17,  // 10: news.GetSearchHistoryResponse.entries:type_name -> news.SearchHistoryEntry
+	17,  // 11: news.GetSearchHistoryEntryResponse.entry:type_name -> news.SearchHistoryEntry
+	28,  // 12: news.GetSubscriptionsResponse.subscriptions:type_name -> news.Subscription
+	28,  // 13: news.SubscriptionResponse.subscription:type_name -> news.Subscription
+	16,  // 14: news.SavedSearch.filters:type_name -> news.SearchFilters
+	16,  // 15: news.CreateSavedSearchRequest.filters:type_name -> news.SearchFilters
+	31,  // 16: news.ListSavedSearchesResponse.saved_searches:type_name -> news.SavedSearch
+	16,  // 17: news.UpdateSavedSearchRequest.filters:type_name -> news.SearchFilters
+	31,  // 18: news.SavedSearchResponse.saved_search:type_name -> news.SavedSearch
+	42,  // 19: news.CollectionResponse.collection:type_name -> news.Collection
+	42,  // 20: news.ListCollectionsResponse.collections:type_name -> news.Collection
+	42,  // 21: news.CollectionItemsResponse.collection:type_name -> news.Collection
+	0,   // 22: news.CollectionItemsResponse.news:type_name -> news.News
+	62,  // 23: news.FavouriteAnnotation.highlights:type_name -> news.Highlight
+	63,  // 24: news.FavouriteAnnotationResponse.annotation:type_name -> news.FavouriteAnnotation
+	62,  // 25: news.HighlightResponse.highlight:type_name -> news.Highlight
+	0,   // 26: news.AnnotatedNews.news:type_name -> news.News
+	63,  // 27: news.AnnotatedNews.annotation:type_name -> news.FavouriteAnnotation
+	73,  // 28: news.SearchFavouritesResponse.favourites:type_name -> news.AnnotatedNews
+	79,  // 29: news.GetSeenResponse.seen:type_name -> news.SeenNews
+	0,   // 30: news.RecordNotificationRequest.article:type_name -> news.News
+	88,  // 31: news.APIKeySecretResponse.api_key:type_name -> news.APIKey
+	88,  // 32: news.ListAPIKeysResponse.api_keys:type_name -> news.APIKey
+	88,  // 33: news.APIKeyResponse.api_key:type_name -> news.APIKey
+	1,   // 34: news.ListUsersResponse.users:type_name -> news.User
+	0,   // 35: news.SearchNewsResponse.news:type_name -> news.News
+	0,   // 36: news.GetTopHeadlinesResponse.news:type_name -> news.News
+	0,   // 37: news.CheckNewArticlesResponse.new_articles:type_name -> news.News
+	111, // 38: news.CheckNewArticlesResponse.user_stats:type_name -> news.UserArticleStats
+	0,   // 39: news.SendNotificationRequest.articles:type_name -> news.News
+	0,   // 40: news.UserArticleStats.articles:type_name -> news.News
+	2,   // 41: news.SaveService.CreateUser:input_type -> news.CreateUserRequest
+	4,   // 42: news.SaveService.GetUser:input_type -> news.GetUserRequest
+	5,   // 43: news.SaveService.UpdateUser:input_type -> news.UpdateUserRequest
+	7,   // 44: news.SaveService.SaveNews:input_type -> news.SaveNewsRequest
+	9,   // 45: news.SaveService.GetNewsByIDs:input_type -> news.GetNewsByIDsRequest
+	11,  // 46: news.SaveService.AddFavourite:input_type -> news.AddFavouriteRequest
+	13,  // 47: news.SaveService.GetFavourites:input_type -> news.GetFavouritesRequest
+	15,  // 48: news.SaveService.AddToSearchHistory:input_type -> news.AddToSearchHistoryRequest
+	19,  // 49: news.SaveService.GetSearchHistory:input_type -> news.GetSearchHistoryRequest
+	21,  // 50: news.SaveService.GetSearchHistoryEntry:input_type -> news.GetSearchHistoryEntryRequest
+	23,  // 51: news.SaveService.Subscribe:input_type -> news.SubscribeRequest
+	25,  // 52: news.SaveService.GetSubscriptions:input_type -> news.GetSubscriptionsRequest
+	29,  // 53: news.SaveService.UpdateSubscription:input_type -> news.UpdateSubscriptionRequest
+	32,  // 54: news.SaveService.CreateSavedSearch:input_type -> news.CreateSavedSearchRequest
+	33,  // 55: news.SaveService.GetSavedSearch:input_type -> news.GetSavedSearchRequest
+	34,  // 56: news.SaveService.ListSavedSearches:input_type -> news.ListSavedSearchesRequest
+	37,  // 57: news.SaveService.UpdateSavedSearch:input_type -> news.UpdateSavedSearchRequest
+	38,  // 58: news.SaveService.DeleteSavedSearch:input_type -> news.DeleteSavedSearchRequest
+	40,  // 59: news.SaveService.RecordSavedSearchRun:input_type -> news.RecordSavedSearchRunRequest
+	44,  // 60: news.SaveService.CreateCollection:input_type -> news.CreateCollectionRequest
+	45,  // 61: news.SaveService.ListCollections:input_type -> news.ListCollectionsRequest
+	47,  // 62: news.SaveService.RenameCollection:input_type -> news.RenameCollectionRequest
+	48,  // 63: news.SaveService.DeleteCollection:input_type -> news.DeleteCollectionRequest
+	50,  // 64: news.SaveService.GetCollectionItems:input_type -> news.GetCollectionItemsRequest
+	52,  // 65: news.SaveService.AddToCollection:input_type -> news.AddToCollectionRequest
+	54,  // 66: news.SaveService.RemoveFromCollection:input_type -> news.RemoveFromCollectionRequest
+	56,  // 67: news.SaveService.CopyCollectionItems:input_type -> news.CopyCollectionItemsRequest
+	58,  // 68: news.SaveService.ReorderCollection:input_type -> news.ReorderCollectionRequest
+	60,  // 69: news.SaveService.ShareCollection:input_type -> news.ShareCollectionRequest
+	61,  // 70: news.SaveService.GetSharedCollection:input_type -> news.GetSharedCollectionRequest
+	65,  // 71: news.SaveService.GetFavouriteAnnotation:input_type -> news.GetFavouriteAnnotationRequest
+	66,  // 72: news.SaveService.SetFavouriteTags:input_type -> news.SetFavouriteTagsRequest
+	67,  // 73: news.SaveService.SetFavouriteNote:input_type -> news.SetFavouriteNoteRequest
+	68,  // 74: news.SaveService.AddHighlight:input_type -> news.AddHighlightRequest
+	70,  // 75: news.SaveService.DeleteHighlight:input_type -> news.DeleteHighlightRequest
+	72,  // 76: news.SaveService.SearchFavourites:input_type -> news.SearchFavouritesRequest
+	75,  // 77: news.SaveService.MarkSeen:input_type -> news.MarkSeenRequest
+	77,  // 78: news.SaveService.MarkUnseen:input_type -> news.MarkUnseenRequest
+	80,  // 79: news.SaveService.GetSeen:input_type -> news.GetSeenRequest
+	82,  // 80: news.SaveService.RecordNotification:input_type -> news.RecordNotificationRequest
+	84,  // 81: news.SaveService.ExportUserData:input_type -> news.ExportUserDataRequest
+	86,  // 82: news.SaveService.DeleteUser:input_type -> news.DeleteUserRequest
+	89,  // 83: news.SaveService.CreateAPIKey:input_type -> news.CreateAPIKeyRequest
+	91,  // 84: news.SaveService.ListAPIKeys:input_type -> news.ListAPIKeysRequest
+	93,  // 85: news.SaveService.RotateAPIKey:input_type -> news.RotateAPIKeyRequest
+	94,  // 86: news.SaveService.RevokeAPIKey:input_type -> news.RevokeAPIKeyRequest
+	96,  // 87: news.SaveService.AuthenticateAPIKey:input_type -> news.AuthenticateAPIKeyRequest
+	98,  // 88: news.SaveService.ListUsers:input_type -> news.ListUsersRequest
+	100, // 89: news.SaveService.RunMigrations:input_type -> news.RunMigrationsRequest
+	27,  // 90: news.SaveService.ListAllSubscriptions:input_type -> news.ListAllSubscriptionsRequest
+	36,  // 91: news.SaveService.ListAllSavedSearches:input_type -> news.ListAllSavedSearchesRequest
+	102, // 92: news.SearchService.SearchNews:input_type -> news.SearchNewsRequest
+	104, // 93: news.SearchService.GetTopHeadlines:input_type -> news.GetTopHeadlinesRequest
+	106, // 94: news.SearchService.CheckNewArticles:input_type -> news.CheckNewArticlesRequest
+	108, // 95: news.SearchService.PurgeCache:input_type -> news.PurgeCacheRequest
+	110, // 96: news.NotificationService.SendNotification:input_type -> news.SendNotificationRequest
+	113, // 97: news.NotificationService.ReplayDeadLetters:input_type -> news.ReplayDeadLettersRequest
+	115, // 98: news.NotificationService.TriggerCheck:input_type -> news.TriggerCheckRequest
+	3,   // 99: news.SaveService.CreateUser:output_type -> news.CreateUserResponse
+	6,   // 100: news.SaveService.GetUser:output_type -> news.UserResponse
+	6,   // 101: news.SaveService.UpdateUser:output_type -> news.UserResponse
+	8,   // 102: news.SaveService.SaveNews:output_type -> news.SaveNewsResponse
+	10,  // 103: news.SaveService.GetNewsByIDs:output_type -> news.GetNewsByIDsResponse
+	12,  // 104: news.SaveService.AddFavourite:output_type -> news.AddFavouriteResponse
+	14,  // 105: news.SaveService.GetFavourites:output_type -> news.GetFavouritesResponse
+	18,  // 106: news.SaveService.AddToSearchHistory:output_type -> news.AddToSearchHistoryResponse
+	20,  // 107: news.SaveService.GetSearchHistory:output_type -> news.GetSearchHistoryResponse
+	22,  // 108: news.SaveService.GetSearchHistoryEntry:output_type -> news.GetSearchHistoryEntryResponse
+	24,  // 109: news.SaveService.Subscribe:output_type -> news.SubscribeResponse
+	26,  // 110: news.SaveService.GetSubscriptions:output_type -> news.GetSubscriptionsResponse
+	30,  // 111: news.SaveService.UpdateSubscription:output_type -> news.SubscriptionResponse
+	41,  // 112: news.SaveService.CreateSavedSearch:output_type -> news.SavedSearchResponse
+	41,  // 113: news.SaveService.GetSavedSearch:output_type -> news.SavedSearchResponse
+	35,  // 114: news.SaveService.ListSavedSearches:output_type -> news.ListSavedSearchesResponse
+	41,  // 115: news.SaveService.UpdateSavedSearch:output_type -> news.SavedSearchResponse
+	39,  // 116: news.SaveService.DeleteSavedSearch:output_type -> news.DeleteSavedSearchResponse
+	41,  // 117: news.SaveService.RecordSavedSearchRun:output_type -> news.SavedSearchResponse
+	43,  // 118: news.SaveService.CreateCollection:output_type -> news.CollectionResponse
+	46,  // 119: news.SaveService.ListCollections:output_type -> news.ListCollectionsResponse
+	43,  // 120: news.SaveService.RenameCollection:output_type -> news.CollectionResponse
+	49,  // 121: news.SaveService.DeleteCollection:output_type -> news.DeleteCollectionResponse
+	51,  // 122: news.SaveService.GetCollectionItems:output_type -> news.CollectionItemsResponse
+	53,  // 123: news.SaveService.AddToCollection:output_type -> news.AddToCollectionResponse
+	55,  // 124: news.SaveService.RemoveFromCollection:output_type -> news.RemoveFromCollectionResponse
+	57,  // 125: news.SaveService.CopyCollectionItems:output_type -> news.CopyCollectionItemsResponse
+	59,  // 126: news.SaveService.ReorderCollection:output_type -> news.ReorderCollectionResponse
+	43,  // 127: news.SaveService.ShareCollection:output_type -> news.CollectionResponse
+	51,  // 128: news.SaveService.GetSharedCollection:output_type -> news.CollectionItemsResponse
+	64,  // 129: news.SaveService.GetFavouriteAnnotation:output_type -> news.FavouriteAnnotationResponse
+	64,  // 130: news.SaveService.SetFavouriteTags:output_type -> news.FavouriteAnnotationResponse
+	64,  // 131: news.SaveService.SetFavouriteNote:output_type -> news.FavouriteAnnotationResponse
+	69,  // 132: news.SaveService.AddHighlight:output_type -> news.HighlightResponse
+	71,  // 133: news.SaveService.DeleteHighlight:output_type -> news.DeleteHighlightResponse
+	74,  // 134: news.SaveService.SearchFavourites:output_type -> news.SearchFavouritesResponse
+	76,  // 135: news.SaveService.MarkSeen:output_type -> news.MarkSeenResponse
+	78,  // 136: news.SaveService.MarkUnseen:output_type -> news.MarkUnseenResponse
+	81,  // 137: news.SaveService.GetSeen:output_type -> news.GetSeenResponse
+	83,  // 138: news.SaveService.RecordNotification:output_type -> news.RecordNotificationResponse
+	85,  // 139: news.SaveService.ExportUserData:output_type -> news.ExportUserDataResponse
+	87,  // 140: news.SaveService.DeleteUser:output_type -> news.DeleteUserResponse
+	90,  // 141: news.SaveService.CreateAPIKey:output_type -> news.APIKeySecretResponse
+	92,  // 142: news.SaveService.ListAPIKeys:output_type -> news.ListAPIKeysResponse
+	90,  // 143: news.SaveService.RotateAPIKey:output_type -> news.APIKeySecretResponse
+	95,  // 144: news.SaveService.RevokeAPIKey:output_type -> news.RevokeAPIKeyResponse
+	97,  // 145: news.SaveService.AuthenticateAPIKey:output_type -> news.APIKeyResponse
+	99,  // 146: news.SaveService.ListUsers:output_type -> news.ListUsersResponse
+	101, // 147: news.SaveService.RunMigrations:output_type -> news.RunMigrationsResponse
+	26,  // 148: news.SaveService.ListAllSubscriptions:output_type -> news.GetSubscriptionsResponse
+	35,  // 149: news.SaveService.ListAllSavedSearches:output_type -> news.ListSavedSearchesResponse
+	103, // 150: news.SearchService.SearchNews:output_type -> news.SearchNewsResponse
+	105, // 151: news.SearchService.GetTopHeadlines:output_type -> news.GetTopHeadlinesResponse
+	107, // 152: news.SearchService.CheckNewArticles:output_type -> news.CheckNewArticlesResponse
+	109, // 153: news.SearchService.PurgeCache:output_type -> news.PurgeCacheResponse
+	112, // 154: news.NotificationService.SendNotification:output_type -> news.SendNotificationResponse
+	114, // 155: news.NotificationService.ReplayDeadLetters:output_type -> news.ReplayDeadLettersResponse
+	116, // 156: news.NotificationService.TriggerCheck:output_type -> news.TriggerCheckResponse
+	99,  // [99:157] is the sub-list for method output_type
+	41,  // [41:99] is the sub-list for method input_type
+	41,  // [41:41] is the sub-list for extension type_name
+	41,  // [41:41] is the sub-list for extension extendee
+	0,   // [0:41] is the sub-list for field type_name
 }
 
 func init() { file_news_service_proto_init() }
@@ -1840,15 +7915,19 @@ func file_news_service_proto_init() {
 	if File_news_service_proto != nil {
 		return
 	}
-	file_news_service_proto_msgTypes[20].OneofWrappers = []any{}
-	file_news_service_proto_msgTypes[22].OneofWrappers = []any{}
+	file_news_service_proto_msgTypes[5].OneofWrappers = []any{}
+	file_news_service_proto_msgTypes[16].OneofWrappers = []any{}
+	file_news_service_proto_msgTypes[37].OneofWrappers = []any{}
+	file_news_service_proto_msgTypes[72].OneofWrappers = []any{}
+	file_news_service_proto_msgTypes[102].OneofWrappers = []any{}
+	file_news_service_proto_msgTypes[104].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_news_service_proto_rawDesc), len(file_news_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   29,
+			NumMessages:   117,
 			NumExtensions: 0,
 			NumServices:   3,
 		},
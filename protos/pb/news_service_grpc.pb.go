@@ -19,15 +19,57 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SaveService_CreateUser_FullMethodName         = "/news.SaveService/CreateUser"
-	SaveService_SaveNews_FullMethodName           = "/news.SaveService/SaveNews"
-	SaveService_GetNewsByIDs_FullMethodName       = "/news.SaveService/GetNewsByIDs"
-	SaveService_AddFavourite_FullMethodName       = "/news.SaveService/AddFavourite"
-	SaveService_GetFavourites_FullMethodName      = "/news.SaveService/GetFavourites"
-	SaveService_AddToSearchHistory_FullMethodName = "/news.SaveService/AddToSearchHistory"
-	SaveService_GetSearchHistory_FullMethodName   = "/news.SaveService/GetSearchHistory"
-	SaveService_Subscribe_FullMethodName          = "/news.SaveService/Subscribe"
-	SaveService_GetSubscriptions_FullMethodName   = "/news.SaveService/GetSubscriptions"
+	SaveService_CreateUser_FullMethodName             = "/news.SaveService/CreateUser"
+	SaveService_GetUser_FullMethodName                = "/news.SaveService/GetUser"
+	SaveService_UpdateUser_FullMethodName             = "/news.SaveService/UpdateUser"
+	SaveService_SaveNews_FullMethodName               = "/news.SaveService/SaveNews"
+	SaveService_GetNewsByIDs_FullMethodName           = "/news.SaveService/GetNewsByIDs"
+	SaveService_AddFavourite_FullMethodName           = "/news.SaveService/AddFavourite"
+	SaveService_GetFavourites_FullMethodName          = "/news.SaveService/GetFavourites"
+	SaveService_AddToSearchHistory_FullMethodName     = "/news.SaveService/AddToSearchHistory"
+	SaveService_GetSearchHistory_FullMethodName       = "/news.SaveService/GetSearchHistory"
+	SaveService_GetSearchHistoryEntry_FullMethodName  = "/news.SaveService/GetSearchHistoryEntry"
+	SaveService_Subscribe_FullMethodName              = "/news.SaveService/Subscribe"
+	SaveService_GetSubscriptions_FullMethodName       = "/news.SaveService/GetSubscriptions"
+	SaveService_UpdateSubscription_FullMethodName     = "/news.SaveService/UpdateSubscription"
+	SaveService_CreateSavedSearch_FullMethodName      = "/news.SaveService/CreateSavedSearch"
+	SaveService_GetSavedSearch_FullMethodName         = "/news.SaveService/GetSavedSearch"
+	SaveService_ListSavedSearches_FullMethodName      = "/news.SaveService/ListSavedSearches"
+	SaveService_UpdateSavedSearch_FullMethodName      = "/news.SaveService/UpdateSavedSearch"
+	SaveService_DeleteSavedSearch_FullMethodName      = "/news.SaveService/DeleteSavedSearch"
+	SaveService_RecordSavedSearchRun_FullMethodName   = "/news.SaveService/RecordSavedSearchRun"
+	SaveService_CreateCollection_FullMethodName       = "/news.SaveService/CreateCollection"
+	SaveService_ListCollections_FullMethodName        = "/news.SaveService/ListCollections"
+	SaveService_RenameCollection_FullMethodName       = "/news.SaveService/RenameCollection"
+	SaveService_DeleteCollection_FullMethodName       = "/news.SaveService/DeleteCollection"
+	SaveService_GetCollectionItems_FullMethodName     = "/news.SaveService/GetCollectionItems"
+	SaveService_AddToCollection_FullMethodName        = "/news.SaveService/AddToCollection"
+	SaveService_RemoveFromCollection_FullMethodName   = "/news.SaveService/RemoveFromCollection"
+	SaveService_CopyCollectionItems_FullMethodName    = "/news.SaveService/CopyCollectionItems"
+	SaveService_ReorderCollection_FullMethodName      = "/news.SaveService/ReorderCollection"
+	SaveService_ShareCollection_FullMethodName        = "/news.SaveService/ShareCollection"
+	SaveService_GetSharedCollection_FullMethodName    = "/news.SaveService/GetSharedCollection"
+	SaveService_GetFavouriteAnnotation_FullMethodName = "/news.SaveService/GetFavouriteAnnotation"
+	SaveService_SetFavouriteTags_FullMethodName       = "/news.SaveService/SetFavouriteTags"
+	SaveService_SetFavouriteNote_FullMethodName       = "/news.SaveService/SetFavouriteNote"
+	SaveService_AddHighlight_FullMethodName           = "/news.SaveService/AddHighlight"
+	SaveService_DeleteHighlight_FullMethodName        = "/news.SaveService/DeleteHighlight"
+	SaveService_SearchFavourites_FullMethodName       = "/news.SaveService/SearchFavourites"
+	SaveService_MarkSeen_FullMethodName               = "/news.SaveService/MarkSeen"
+	SaveService_MarkUnseen_FullMethodName             = "/news.SaveService/MarkUnseen"
+	SaveService_GetSeen_FullMethodName                = "/news.SaveService/GetSeen"
+	SaveService_RecordNotification_FullMethodName     = "/news.SaveService/RecordNotification"
+	SaveService_ExportUserData_FullMethodName         = "/news.SaveService/ExportUserData"
+	SaveService_DeleteUser_FullMethodName             = "/news.SaveService/DeleteUser"
+	SaveService_CreateAPIKey_FullMethodName           = "/news.SaveService/CreateAPIKey"
+	SaveService_ListAPIKeys_FullMethodName            = "/news.SaveService/ListAPIKeys"
+	SaveService_RotateAPIKey_FullMethodName           = "/news.SaveService/RotateAPIKey"
+	SaveService_RevokeAPIKey_FullMethodName           = "/news.SaveService/RevokeAPIKey"
+	SaveService_AuthenticateAPIKey_FullMethodName     = "/news.SaveService/AuthenticateAPIKey"
+	SaveService_ListUsers_FullMethodName              = "/news.SaveService/ListUsers"
+	SaveService_RunMigrations_FullMethodName          = "/news.SaveService/RunMigrations"
+	SaveService_ListAllSubscriptions_FullMethodName   = "/news.SaveService/ListAllSubscriptions"
+	SaveService_ListAllSavedSearches_FullMethodName   = "/news.SaveService/ListAllSavedSearches"
 )
 
 // SaveServiceClient is the client API for SaveService service.
@@ -37,14 +79,59 @@ const (
 // Save Service
 type SaveServiceClient interface {
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
+	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UserResponse, error)
 	SaveNews(ctx context.Context, in *SaveNewsRequest, opts ...grpc.CallOption) (*SaveNewsResponse, error)
 	GetNewsByIDs(ctx context.Context, in *GetNewsByIDsRequest, opts ...grpc.CallOption) (*GetNewsByIDsResponse, error)
 	AddFavourite(ctx context.Context, in *AddFavouriteRequest, opts ...grpc.CallOption) (*AddFavouriteResponse, error)
 	GetFavourites(ctx context.Context, in *GetFavouritesRequest, opts ...grpc.CallOption) (*GetFavouritesResponse, error)
 	AddToSearchHistory(ctx context.Context, in *AddToSearchHistoryRequest, opts ...grpc.CallOption) (*AddToSearchHistoryResponse, error)
 	GetSearchHistory(ctx context.Context, in *GetSearchHistoryRequest, opts ...grpc.CallOption) (*GetSearchHistoryResponse, error)
+	GetSearchHistoryEntry(ctx context.Context, in *GetSearchHistoryEntryRequest, opts ...grpc.CallOption) (*GetSearchHistoryEntryResponse, error)
 	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (*SubscribeResponse, error)
 	GetSubscriptions(ctx context.Context, in *GetSubscriptionsRequest, opts ...grpc.CallOption) (*GetSubscriptionsResponse, error)
+	UpdateSubscription(ctx context.Context, in *UpdateSubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error)
+	CreateSavedSearch(ctx context.Context, in *CreateSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearchResponse, error)
+	GetSavedSearch(ctx context.Context, in *GetSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearchResponse, error)
+	ListSavedSearches(ctx context.Context, in *ListSavedSearchesRequest, opts ...grpc.CallOption) (*ListSavedSearchesResponse, error)
+	UpdateSavedSearch(ctx context.Context, in *UpdateSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearchResponse, error)
+	DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest, opts ...grpc.CallOption) (*DeleteSavedSearchResponse, error)
+	RecordSavedSearchRun(ctx context.Context, in *RecordSavedSearchRunRequest, opts ...grpc.CallOption) (*SavedSearchResponse, error)
+	CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CollectionResponse, error)
+	ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error)
+	RenameCollection(ctx context.Context, in *RenameCollectionRequest, opts ...grpc.CallOption) (*CollectionResponse, error)
+	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error)
+	GetCollectionItems(ctx context.Context, in *GetCollectionItemsRequest, opts ...grpc.CallOption) (*CollectionItemsResponse, error)
+	AddToCollection(ctx context.Context, in *AddToCollectionRequest, opts ...grpc.CallOption) (*AddToCollectionResponse, error)
+	RemoveFromCollection(ctx context.Context, in *RemoveFromCollectionRequest, opts ...grpc.CallOption) (*RemoveFromCollectionResponse, error)
+	CopyCollectionItems(ctx context.Context, in *CopyCollectionItemsRequest, opts ...grpc.CallOption) (*CopyCollectionItemsResponse, error)
+	ReorderCollection(ctx context.Context, in *ReorderCollectionRequest, opts ...grpc.CallOption) (*ReorderCollectionResponse, error)
+	ShareCollection(ctx context.Context, in *ShareCollectionRequest, opts ...grpc.CallOption) (*CollectionResponse, error)
+	GetSharedCollection(ctx context.Context, in *GetSharedCollectionRequest, opts ...grpc.CallOption) (*CollectionItemsResponse, error)
+	GetFavouriteAnnotation(ctx context.Context, in *GetFavouriteAnnotationRequest, opts ...grpc.CallOption) (*FavouriteAnnotationResponse, error)
+	SetFavouriteTags(ctx context.Context, in *SetFavouriteTagsRequest, opts ...grpc.CallOption) (*FavouriteAnnotationResponse, error)
+	SetFavouriteNote(ctx context.Context, in *SetFavouriteNoteRequest, opts ...grpc.CallOption) (*FavouriteAnnotationResponse, error)
+	AddHighlight(ctx context.Context, in *AddHighlightRequest, opts ...grpc.CallOption) (*HighlightResponse, error)
+	DeleteHighlight(ctx context.Context, in *DeleteHighlightRequest, opts ...grpc.CallOption) (*DeleteHighlightResponse, error)
+	SearchFavourites(ctx context.Context, in *SearchFavouritesRequest, opts ...grpc.CallOption) (*SearchFavouritesResponse, error)
+	MarkSeen(ctx context.Context, in *MarkSeenRequest, opts ...grpc.CallOption) (*MarkSeenResponse, error)
+	MarkUnseen(ctx context.Context, in *MarkUnseenRequest, opts ...grpc.CallOption) (*MarkUnseenResponse, error)
+	GetSeen(ctx context.Context, in *GetSeenRequest, opts ...grpc.CallOption) (*GetSeenResponse, error)
+	RecordNotification(ctx context.Context, in *RecordNotificationRequest, opts ...grpc.CallOption) (*RecordNotificationResponse, error)
+	ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error)
+	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	// API ключи машинных клиентов; управление ключами в REST только через /api/admin gateway
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*APIKeySecretResponse, error)
+	ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error)
+	RotateAPIKey(ctx context.Context, in *RotateAPIKeyRequest, opts ...grpc.CallOption) (*APIKeySecretResponse, error)
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error)
+	AuthenticateAPIKey(ctx context.Context, in *AuthenticateAPIKeyRequest, opts ...grpc.CallOption) (*APIKeyResponse, error)
+	// Администрирование (gonewsctl); в REST не публикуется
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	RunMigrations(ctx context.Context, in *RunMigrationsRequest, opts ...grpc.CallOption) (*RunMigrationsResponse, error)
+	// Выборки по всем пользователям для планировщика notify_service и gonewsctl; в REST не публикуются
+	ListAllSubscriptions(ctx context.Context, in *ListAllSubscriptionsRequest, opts ...grpc.CallOption) (*GetSubscriptionsResponse, error)
+	ListAllSavedSearches(ctx context.Context, in *ListAllSavedSearchesRequest, opts ...grpc.CallOption) (*ListSavedSearchesResponse, error)
 }
 
 type saveServiceClient struct {
@@ -65,6 +152,26 @@ func (c *saveServiceClient) CreateUser(ctx context.Context, in *CreateUserReques
 	return out, nil
 }
 
+func (c *saveServiceClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, SaveService_GetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *saveServiceClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, SaveService_UpdateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *saveServiceClient) SaveNews(ctx context.Context, in *SaveNewsRequest, opts ...grpc.CallOption) (*SaveNewsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SaveNewsResponse)
@@ -125,6 +232,16 @@ func (c *saveServiceClient) GetSearchHistory(ctx context.Context, in *GetSearchH
 	return out, nil
 }
 
+func (c *saveServiceClient) GetSearchHistoryEntry(ctx context.Context, in *GetSearchHistoryEntryRequest, opts ...grpc.CallOption) (*GetSearchHistoryEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSearchHistoryEntryResponse)
+	err := c.cc.Invoke(ctx, SaveService_GetSearchHistoryEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *saveServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (*SubscribeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SubscribeResponse)
@@ -145,237 +262,1554 @@ func (c *saveServiceClient) GetSubscriptions(ctx context.Context, in *GetSubscri
 	return out, nil
 }
 
-// SaveServiceServer is the server API for SaveService service.
-// All implementations must embed UnimplementedSaveServiceServer
-// for forward compatibility.
-//
-// Save Service
-type SaveServiceServer interface {
-	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
-	SaveNews(context.Context, *SaveNewsRequest) (*SaveNewsResponse, error)
-	GetNewsByIDs(context.Context, *GetNewsByIDsRequest) (*GetNewsByIDsResponse, error)
-	AddFavourite(context.Context, *AddFavouriteRequest) (*AddFavouriteResponse, error)
-	GetFavourites(context.Context, *GetFavouritesRequest) (*GetFavouritesResponse, error)
-	AddToSearchHistory(context.Context, *AddToSearchHistoryRequest) (*AddToSearchHistoryResponse, error)
-	GetSearchHistory(context.Context, *GetSearchHistoryRequest) (*GetSearchHistoryResponse, error)
-	Subscribe(context.Context, *SubscribeRequest) (*SubscribeResponse, error)
-	GetSubscriptions(context.Context, *GetSubscriptionsRequest) (*GetSubscriptionsResponse, error)
-	mustEmbedUnimplementedSaveServiceServer()
+func (c *saveServiceClient) UpdateSubscription(ctx context.Context, in *UpdateSubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscriptionResponse)
+	err := c.cc.Invoke(ctx, SaveService_UpdateSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedSaveServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedSaveServiceServer struct{}
+func (c *saveServiceClient) CreateSavedSearch(ctx context.Context, in *CreateSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedSearchResponse)
+	err := c.cc.Invoke(ctx, SaveService_CreateSavedSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedSaveServiceServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateUser not implemented")
+func (c *saveServiceClient) GetSavedSearch(ctx context.Context, in *GetSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedSearchResponse)
+	err := c.cc.Invoke(ctx, SaveService_GetSavedSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSaveServiceServer) SaveNews(context.Context, *SaveNewsRequest) (*SaveNewsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method SaveNews not implemented")
+
+func (c *saveServiceClient) ListSavedSearches(ctx context.Context, in *ListSavedSearchesRequest, opts ...grpc.CallOption) (*ListSavedSearchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSavedSearchesResponse)
+	err := c.cc.Invoke(ctx, SaveService_ListSavedSearches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSaveServiceServer) GetNewsByIDs(context.Context, *GetNewsByIDsRequest) (*GetNewsByIDsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetNewsByIDs not implemented")
+
+func (c *saveServiceClient) UpdateSavedSearch(ctx context.Context, in *UpdateSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedSearchResponse)
+	err := c.cc.Invoke(ctx, SaveService_UpdateSavedSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSaveServiceServer) AddFavourite(context.Context, *AddFavouriteRequest) (*AddFavouriteResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method AddFavourite not implemented")
+
+func (c *saveServiceClient) DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest, opts ...grpc.CallOption) (*DeleteSavedSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSavedSearchResponse)
+	err := c.cc.Invoke(ctx, SaveService_DeleteSavedSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSaveServiceServer) GetFavourites(context.Context, *GetFavouritesRequest) (*GetFavouritesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetFavourites not implemented")
+
+func (c *saveServiceClient) RecordSavedSearchRun(ctx context.Context, in *RecordSavedSearchRunRequest, opts ...grpc.CallOption) (*SavedSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedSearchResponse)
+	err := c.cc.Invoke(ctx, SaveService_RecordSavedSearchRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSaveServiceServer) AddToSearchHistory(context.Context, *AddToSearchHistoryRequest) (*AddToSearchHistoryResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method AddToSearchHistory not implemented")
+
+func (c *saveServiceClient) CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectionResponse)
+	err := c.cc.Invoke(ctx, SaveService_CreateCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSaveServiceServer) GetSearchHistory(context.Context, *GetSearchHistoryRequest) (*GetSearchHistoryResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetSearchHistory not implemented")
+
+func (c *saveServiceClient) ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectionsResponse)
+	err := c.cc.Invoke(ctx, SaveService_ListCollections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSaveServiceServer) Subscribe(context.Context, *SubscribeRequest) (*SubscribeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method Subscribe not implemented")
+
+func (c *saveServiceClient) RenameCollection(ctx context.Context, in *RenameCollectionRequest, opts ...grpc.CallOption) (*CollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectionResponse)
+	err := c.cc.Invoke(ctx, SaveService_RenameCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *saveServiceClient) DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCollectionResponse)
+	err := c.cc.Invoke(ctx, SaveService_DeleteCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *saveServiceClient) GetCollectionItems(ctx context.Context, in *GetCollectionItemsRequest, opts ...grpc.CallOption) (*CollectionItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectionItemsResponse)
+	err := c.cc.Invoke(ctx, SaveService_GetCollectionItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *saveServiceClient) AddToCollection(ctx context.Context, in *AddToCollectionRequest, opts ...grpc.CallOption) (*AddToCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddToCollectionResponse)
+	err := c.cc.Invoke(ctx, SaveService_AddToCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *saveServiceClient) RemoveFromCollection(ctx context.Context, in *RemoveFromCollectionRequest, opts ...grpc.CallOption) (*RemoveFromCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveFromCollectionResponse)
+	err := c.cc.Invoke(ctx, SaveService_RemoveFromCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *saveServiceClient) CopyCollectionItems(ctx context.Context, in *CopyCollectionItemsRequest, opts ...grpc.CallOption) (*CopyCollectionItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CopyCollectionItemsResponse)
+	err := c.cc.Invoke(ctx, SaveService_CopyCollectionItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *saveServiceClient) ReorderCollection(ctx context.Context, in *ReorderCollectionRequest, opts ...grpc.CallOption) (*ReorderCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderCollectionResponse)
+	err := c.cc.Invoke(ctx, SaveService_ReorderCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *saveServiceClient) ShareCollection(ctx context.Context, in *ShareCollectionRequest, opts ...grpc.CallOption) (*CollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectionResponse)
+	err := c.cc.Invoke(ctx, SaveService_ShareCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *saveServiceClient) GetSharedCollection(ctx context.Context, in *GetSharedCollectionRequest, opts ...grpc.CallOption) (*CollectionItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectionItemsResponse)
+	err := c.cc.Invoke(ctx, SaveService_GetSharedCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *saveServiceClient) GetFavouriteAnnotation(ctx context.Context, in *GetFavouriteAnnotationRequest, opts ...grpc.CallOption) (*FavouriteAnnotationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FavouriteAnnotationResponse)
+	err := c.cc.Invoke(ctx, SaveService_GetFavouriteAnnotation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *saveServiceClient) SetFavouriteTags(ctx context.Context, in *SetFavouriteTagsRequest, opts ...grpc.CallOption) (*FavouriteAnnotationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FavouriteAnnotationResponse)
+	err := c.cc.Invoke(ctx, SaveService_SetFavouriteTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *saveServiceClient) SetFavouriteNote(ctx context.Context, in *SetFavouriteNoteRequest, opts ...grpc.CallOption) (*FavouriteAnnotationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FavouriteAnnotationResponse)
+	err := c.cc.Invoke(ctx, SaveService_SetFavouriteNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *saveServiceClient) AddHighlight(ctx context.Context, in *AddHighlightRequest, opts ...grpc.CallOption) (*HighlightResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HighlightResponse)
+	err := c.cc.Invoke(ctx, SaveService_AddHighlight_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *saveServiceClient) DeleteHighlight(ctx context.Context, in *DeleteHighlightRequest, opts ...grpc.CallOption) (*DeleteHighlightResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteHighlightResponse)
+	err := c.cc.Invoke(ctx, SaveService_DeleteHighlight_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *saveServiceClient) SearchFavourites(ctx context.Context, in *SearchFavouritesRequest, opts ...grpc.CallOption) (*SearchFavouritesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchFavouritesResponse)
+	err := c.cc.Invoke(ctx, SaveService_SearchFavourites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *saveServiceClient) MarkSeen(ctx context.Context, in *MarkSeenRequest, opts ...grpc.CallOption) (*MarkSeenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkSeenResponse)
+	err := c.cc.Invoke(ctx, SaveService_MarkSeen_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *saveServiceClient) MarkUnseen(ctx context.Context, in *MarkUnseenRequest, opts ...grpc.CallOption) (*MarkUnseenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkUnseenResponse)
+	err := c.cc.Invoke(ctx, SaveService_MarkUnseen_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *saveServiceClient) GetSeen(ctx context.Context, in *GetSeenRequest, opts ...grpc.CallOption) (*GetSeenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSeenResponse)
+	err := c.cc.Invoke(ctx, SaveService_GetSeen_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *saveServiceClient) RecordNotification(ctx context.Context, in *RecordNotificationRequest, opts ...grpc.CallOption) (*RecordNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordNotificationResponse)
+	err := c.cc.Invoke(ctx, SaveService_RecordNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *saveServiceClient) ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportUserDataResponse)
+	err := c.cc.Invoke(ctx, SaveService_ExportUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *saveServiceClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteUserResponse)
+	err := c.cc.Invoke(ctx, SaveService_DeleteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *saveServiceClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*APIKeySecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(APIKeySecretResponse)
+	err := c.cc.Invoke(ctx, SaveService_CreateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *saveServiceClient) ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAPIKeysResponse)
+	err := c.cc.Invoke(ctx, SaveService_ListAPIKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *saveServiceClient) RotateAPIKey(ctx context.Context, in *RotateAPIKeyRequest, opts ...grpc.CallOption) (*APIKeySecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(APIKeySecretResponse)
+	err := c.cc.Invoke(ctx, SaveService_RotateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *saveServiceClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAPIKeyResponse)
+	err := c.cc.Invoke(ctx, SaveService_RevokeAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *saveServiceClient) AuthenticateAPIKey(ctx context.Context, in *AuthenticateAPIKeyRequest, opts ...grpc.CallOption) (*APIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(APIKeyResponse)
+	err := c.cc.Invoke(ctx, SaveService_AuthenticateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *saveServiceClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, SaveService_ListUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *saveServiceClient) RunMigrations(ctx context.Context, in *RunMigrationsRequest, opts ...grpc.CallOption) (*RunMigrationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunMigrationsResponse)
+	err := c.cc.Invoke(ctx, SaveService_RunMigrations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *saveServiceClient) ListAllSubscriptions(ctx context.Context, in *ListAllSubscriptionsRequest, opts ...grpc.CallOption) (*GetSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, SaveService_ListAllSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *saveServiceClient) ListAllSavedSearches(ctx context.Context, in *ListAllSavedSearchesRequest, opts ...grpc.CallOption) (*ListSavedSearchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSavedSearchesResponse)
+	err := c.cc.Invoke(ctx, SaveService_ListAllSavedSearches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SaveServiceServer is the server API for SaveService service.
+// All implementations must embed UnimplementedSaveServiceServer
+// for forward compatibility.
+//
+// Save Service
+type SaveServiceServer interface {
+	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
+	GetUser(context.Context, *GetUserRequest) (*UserResponse, error)
+	UpdateUser(context.Context, *UpdateUserRequest) (*UserResponse, error)
+	SaveNews(context.Context, *SaveNewsRequest) (*SaveNewsResponse, error)
+	GetNewsByIDs(context.Context, *GetNewsByIDsRequest) (*GetNewsByIDsResponse, error)
+	AddFavourite(context.Context, *AddFavouriteRequest) (*AddFavouriteResponse, error)
+	GetFavourites(context.Context, *GetFavouritesRequest) (*GetFavouritesResponse, error)
+	AddToSearchHistory(context.Context, *AddToSearchHistoryRequest) (*AddToSearchHistoryResponse, error)
+	GetSearchHistory(context.Context, *GetSearchHistoryRequest) (*GetSearchHistoryResponse, error)
+	GetSearchHistoryEntry(context.Context, *GetSearchHistoryEntryRequest) (*GetSearchHistoryEntryResponse, error)
+	Subscribe(context.Context, *SubscribeRequest) (*SubscribeResponse, error)
+	GetSubscriptions(context.Context, *GetSubscriptionsRequest) (*GetSubscriptionsResponse, error)
+	UpdateSubscription(context.Context, *UpdateSubscriptionRequest) (*SubscriptionResponse, error)
+	CreateSavedSearch(context.Context, *CreateSavedSearchRequest) (*SavedSearchResponse, error)
+	GetSavedSearch(context.Context, *GetSavedSearchRequest) (*SavedSearchResponse, error)
+	ListSavedSearches(context.Context, *ListSavedSearchesRequest) (*ListSavedSearchesResponse, error)
+	UpdateSavedSearch(context.Context, *UpdateSavedSearchRequest) (*SavedSearchResponse, error)
+	DeleteSavedSearch(context.Context, *DeleteSavedSearchRequest) (*DeleteSavedSearchResponse, error)
+	RecordSavedSearchRun(context.Context, *RecordSavedSearchRunRequest) (*SavedSearchResponse, error)
+	CreateCollection(context.Context, *CreateCollectionRequest) (*CollectionResponse, error)
+	ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error)
+	RenameCollection(context.Context, *RenameCollectionRequest) (*CollectionResponse, error)
+	DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error)
+	GetCollectionItems(context.Context, *GetCollectionItemsRequest) (*CollectionItemsResponse, error)
+	AddToCollection(context.Context, *AddToCollectionRequest) (*AddToCollectionResponse, error)
+	RemoveFromCollection(context.Context, *RemoveFromCollectionRequest) (*RemoveFromCollectionResponse, error)
+	CopyCollectionItems(context.Context, *CopyCollectionItemsRequest) (*CopyCollectionItemsResponse, error)
+	ReorderCollection(context.Context, *ReorderCollectionRequest) (*ReorderCollectionResponse, error)
+	ShareCollection(context.Context, *ShareCollectionRequest) (*CollectionResponse, error)
+	GetSharedCollection(context.Context, *GetSharedCollectionRequest) (*CollectionItemsResponse, error)
+	GetFavouriteAnnotation(context.Context, *GetFavouriteAnnotationRequest) (*FavouriteAnnotationResponse, error)
+	SetFavouriteTags(context.Context, *SetFavouriteTagsRequest) (*FavouriteAnnotationResponse, error)
+	SetFavouriteNote(context.Context, *SetFavouriteNoteRequest) (*FavouriteAnnotationResponse, error)
+	AddHighlight(context.Context, *AddHighlightRequest) (*HighlightResponse, error)
+	DeleteHighlight(context.Context, *DeleteHighlightRequest) (*DeleteHighlightResponse, error)
+	SearchFavourites(context.Context, *SearchFavouritesRequest) (*SearchFavouritesResponse, error)
+	MarkSeen(context.Context, *MarkSeenRequest) (*MarkSeenResponse, error)
+	MarkUnseen(context.Context, *MarkUnseenRequest) (*MarkUnseenResponse, error)
+	GetSeen(context.Context, *GetSeenRequest) (*GetSeenResponse, error)
+	RecordNotification(context.Context, *RecordNotificationRequest) (*RecordNotificationResponse, error)
+	ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error)
+	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	// API ключи машинных клиентов; управление ключами в REST только через /api/admin gateway
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*APIKeySecretResponse, error)
+	ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error)
+	RotateAPIKey(context.Context, *RotateAPIKeyRequest) (*APIKeySecretResponse, error)
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error)
+	AuthenticateAPIKey(context.Context, *AuthenticateAPIKeyRequest) (*APIKeyResponse, error)
+	// Администрирование (gonewsctl); в REST не публикуется
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	RunMigrations(context.Context, *RunMigrationsRequest) (*RunMigrationsResponse, error)
+	// Выборки по всем пользователям для планировщика notify_service и gonewsctl; в REST не публикуются
+	ListAllSubscriptions(context.Context, *ListAllSubscriptionsRequest) (*GetSubscriptionsResponse, error)
+	ListAllSavedSearches(context.Context, *ListAllSavedSearchesRequest) (*ListSavedSearchesResponse, error)
+	mustEmbedUnimplementedSaveServiceServer()
+}
+
+// UnimplementedSaveServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSaveServiceServer struct{}
+
+func (UnimplementedSaveServiceServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedSaveServiceServer) GetUser(context.Context, *GetUserRequest) (*UserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedSaveServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*UserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedSaveServiceServer) SaveNews(context.Context, *SaveNewsRequest) (*SaveNewsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveNews not implemented")
+}
+func (UnimplementedSaveServiceServer) GetNewsByIDs(context.Context, *GetNewsByIDsRequest) (*GetNewsByIDsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNewsByIDs not implemented")
+}
+func (UnimplementedSaveServiceServer) AddFavourite(context.Context, *AddFavouriteRequest) (*AddFavouriteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddFavourite not implemented")
+}
+func (UnimplementedSaveServiceServer) GetFavourites(context.Context, *GetFavouritesRequest) (*GetFavouritesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFavourites not implemented")
+}
+func (UnimplementedSaveServiceServer) AddToSearchHistory(context.Context, *AddToSearchHistoryRequest) (*AddToSearchHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddToSearchHistory not implemented")
+}
+func (UnimplementedSaveServiceServer) GetSearchHistory(context.Context, *GetSearchHistoryRequest) (*GetSearchHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSearchHistory not implemented")
+}
+func (UnimplementedSaveServiceServer) GetSearchHistoryEntry(context.Context, *GetSearchHistoryEntryRequest) (*GetSearchHistoryEntryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSearchHistoryEntry not implemented")
+}
+func (UnimplementedSaveServiceServer) Subscribe(context.Context, *SubscribeRequest) (*SubscribeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedSaveServiceServer) GetSubscriptions(context.Context, *GetSubscriptionsRequest) (*GetSubscriptionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSubscriptions not implemented")
+}
+func (UnimplementedSaveServiceServer) UpdateSubscription(context.Context, *UpdateSubscriptionRequest) (*SubscriptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSubscription not implemented")
+}
+func (UnimplementedSaveServiceServer) CreateSavedSearch(context.Context, *CreateSavedSearchRequest) (*SavedSearchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateSavedSearch not implemented")
+}
+func (UnimplementedSaveServiceServer) GetSavedSearch(context.Context, *GetSavedSearchRequest) (*SavedSearchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSavedSearch not implemented")
+}
+func (UnimplementedSaveServiceServer) ListSavedSearches(context.Context, *ListSavedSearchesRequest) (*ListSavedSearchesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSavedSearches not implemented")
+}
+func (UnimplementedSaveServiceServer) UpdateSavedSearch(context.Context, *UpdateSavedSearchRequest) (*SavedSearchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSavedSearch not implemented")
+}
+func (UnimplementedSaveServiceServer) DeleteSavedSearch(context.Context, *DeleteSavedSearchRequest) (*DeleteSavedSearchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteSavedSearch not implemented")
+}
+func (UnimplementedSaveServiceServer) RecordSavedSearchRun(context.Context, *RecordSavedSearchRunRequest) (*SavedSearchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecordSavedSearchRun not implemented")
+}
+func (UnimplementedSaveServiceServer) CreateCollection(context.Context, *CreateCollectionRequest) (*CollectionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCollection not implemented")
+}
+func (UnimplementedSaveServiceServer) ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCollections not implemented")
+}
+func (UnimplementedSaveServiceServer) RenameCollection(context.Context, *RenameCollectionRequest) (*CollectionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RenameCollection not implemented")
+}
+func (UnimplementedSaveServiceServer) DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCollection not implemented")
+}
+func (UnimplementedSaveServiceServer) GetCollectionItems(context.Context, *GetCollectionItemsRequest) (*CollectionItemsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCollectionItems not implemented")
+}
+func (UnimplementedSaveServiceServer) AddToCollection(context.Context, *AddToCollectionRequest) (*AddToCollectionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddToCollection not implemented")
+}
+func (UnimplementedSaveServiceServer) RemoveFromCollection(context.Context, *RemoveFromCollectionRequest) (*RemoveFromCollectionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveFromCollection not implemented")
+}
+func (UnimplementedSaveServiceServer) CopyCollectionItems(context.Context, *CopyCollectionItemsRequest) (*CopyCollectionItemsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CopyCollectionItems not implemented")
+}
+func (UnimplementedSaveServiceServer) ReorderCollection(context.Context, *ReorderCollectionRequest) (*ReorderCollectionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReorderCollection not implemented")
+}
+func (UnimplementedSaveServiceServer) ShareCollection(context.Context, *ShareCollectionRequest) (*CollectionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ShareCollection not implemented")
+}
+func (UnimplementedSaveServiceServer) GetSharedCollection(context.Context, *GetSharedCollectionRequest) (*CollectionItemsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSharedCollection not implemented")
+}
+func (UnimplementedSaveServiceServer) GetFavouriteAnnotation(context.Context, *GetFavouriteAnnotationRequest) (*FavouriteAnnotationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFavouriteAnnotation not implemented")
+}
+func (UnimplementedSaveServiceServer) SetFavouriteTags(context.Context, *SetFavouriteTagsRequest) (*FavouriteAnnotationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetFavouriteTags not implemented")
+}
+func (UnimplementedSaveServiceServer) SetFavouriteNote(context.Context, *SetFavouriteNoteRequest) (*FavouriteAnnotationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetFavouriteNote not implemented")
+}
+func (UnimplementedSaveServiceServer) AddHighlight(context.Context, *AddHighlightRequest) (*HighlightResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddHighlight not implemented")
+}
+func (UnimplementedSaveServiceServer) DeleteHighlight(context.Context, *DeleteHighlightRequest) (*DeleteHighlightResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteHighlight not implemented")
+}
+func (UnimplementedSaveServiceServer) SearchFavourites(context.Context, *SearchFavouritesRequest) (*SearchFavouritesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchFavourites not implemented")
+}
+func (UnimplementedSaveServiceServer) MarkSeen(context.Context, *MarkSeenRequest) (*MarkSeenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MarkSeen not implemented")
+}
+func (UnimplementedSaveServiceServer) MarkUnseen(context.Context, *MarkUnseenRequest) (*MarkUnseenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MarkUnseen not implemented")
+}
+func (UnimplementedSaveServiceServer) GetSeen(context.Context, *GetSeenRequest) (*GetSeenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSeen not implemented")
+}
+func (UnimplementedSaveServiceServer) RecordNotification(context.Context, *RecordNotificationRequest) (*RecordNotificationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecordNotification not implemented")
+}
+func (UnimplementedSaveServiceServer) ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedSaveServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedSaveServiceServer) CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*APIKeySecretResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedSaveServiceServer) ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (UnimplementedSaveServiceServer) RotateAPIKey(context.Context, *RotateAPIKeyRequest) (*APIKeySecretResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RotateAPIKey not implemented")
+}
+func (UnimplementedSaveServiceServer) RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+func (UnimplementedSaveServiceServer) AuthenticateAPIKey(context.Context, *AuthenticateAPIKeyRequest) (*APIKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AuthenticateAPIKey not implemented")
+}
+func (UnimplementedSaveServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedSaveServiceServer) RunMigrations(context.Context, *RunMigrationsRequest) (*RunMigrationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RunMigrations not implemented")
+}
+func (UnimplementedSaveServiceServer) ListAllSubscriptions(context.Context, *ListAllSubscriptionsRequest) (*GetSubscriptionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAllSubscriptions not implemented")
+}
+func (UnimplementedSaveServiceServer) ListAllSavedSearches(context.Context, *ListAllSavedSearchesRequest) (*ListSavedSearchesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAllSavedSearches not implemented")
+}
+func (UnimplementedSaveServiceServer) mustEmbedUnimplementedSaveServiceServer() {}
+func (UnimplementedSaveServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeSaveServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SaveServiceServer will
+// result in compilation errors.
+type UnsafeSaveServiceServer interface {
+	mustEmbedUnimplementedSaveServiceServer()
+}
+
+func RegisterSaveServiceServer(s grpc.ServiceRegistrar, srv SaveServiceServer) {
+	// If the following call panics, it indicates UnimplementedSaveServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SaveService_ServiceDesc, srv)
+}
+
+func _SaveService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_SaveNews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveNewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).SaveNews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_SaveNews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).SaveNews(ctx, req.(*SaveNewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_GetNewsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNewsByIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).GetNewsByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_GetNewsByIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).GetNewsByIDs(ctx, req.(*GetNewsByIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_AddFavourite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFavouriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).AddFavourite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_AddFavourite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).AddFavourite(ctx, req.(*AddFavouriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_GetFavourites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFavouritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).GetFavourites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_GetFavourites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).GetFavourites(ctx, req.(*GetFavouritesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_AddToSearchHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToSearchHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).AddToSearchHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_AddToSearchHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).AddToSearchHistory(ctx, req.(*AddToSearchHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_GetSearchHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSearchHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).GetSearchHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_GetSearchHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).GetSearchHistory(ctx, req.(*GetSearchHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_GetSearchHistoryEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSearchHistoryEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).GetSearchHistoryEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_GetSearchHistoryEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).GetSearchHistoryEntry(ctx, req.(*GetSearchHistoryEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_Subscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).Subscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_Subscribe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).Subscribe(ctx, req.(*SubscribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_GetSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).GetSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_GetSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).GetSubscriptions(ctx, req.(*GetSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_UpdateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).UpdateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_UpdateSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).UpdateSubscription(ctx, req.(*UpdateSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_CreateSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).CreateSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_CreateSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).CreateSavedSearch(ctx, req.(*CreateSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_GetSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).GetSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_GetSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).GetSavedSearch(ctx, req.(*GetSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_ListSavedSearches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavedSearchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).ListSavedSearches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_ListSavedSearches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).ListSavedSearches(ctx, req.(*ListSavedSearchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_UpdateSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).UpdateSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_UpdateSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).UpdateSavedSearch(ctx, req.(*UpdateSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_DeleteSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).DeleteSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_DeleteSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).DeleteSavedSearch(ctx, req.(*DeleteSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_RecordSavedSearchRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordSavedSearchRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).RecordSavedSearchRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_RecordSavedSearchRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).RecordSavedSearchRun(ctx, req.(*RecordSavedSearchRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_CreateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_ListCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).ListCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_ListCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).ListCollections(ctx, req.(*ListCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_RenameCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).RenameCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_RenameCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).RenameCollection(ctx, req.(*RenameCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_DeleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).DeleteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_DeleteCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).DeleteCollection(ctx, req.(*DeleteCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_GetCollectionItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).GetCollectionItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_GetCollectionItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).GetCollectionItems(ctx, req.(*GetCollectionItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_AddToCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).AddToCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_AddToCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).AddToCollection(ctx, req.(*AddToCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_RemoveFromCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFromCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).RemoveFromCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_RemoveFromCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).RemoveFromCollection(ctx, req.(*RemoveFromCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_CopyCollectionItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyCollectionItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).CopyCollectionItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_CopyCollectionItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).CopyCollectionItems(ctx, req.(*CopyCollectionItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_ReorderCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).ReorderCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_ReorderCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).ReorderCollection(ctx, req.(*ReorderCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_ShareCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).ShareCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_ShareCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).ShareCollection(ctx, req.(*ShareCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_GetSharedCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).GetSharedCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_GetSharedCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).GetSharedCollection(ctx, req.(*GetSharedCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_GetFavouriteAnnotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFavouriteAnnotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).GetFavouriteAnnotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_GetFavouriteAnnotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).GetFavouriteAnnotation(ctx, req.(*GetFavouriteAnnotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_SetFavouriteTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFavouriteTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).SetFavouriteTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_SetFavouriteTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).SetFavouriteTags(ctx, req.(*SetFavouriteTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_SetFavouriteNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFavouriteNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).SetFavouriteNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_SetFavouriteNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).SetFavouriteNote(ctx, req.(*SetFavouriteNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_AddHighlight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddHighlightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).AddHighlight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_AddHighlight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).AddHighlight(ctx, req.(*AddHighlightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_DeleteHighlight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHighlightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).DeleteHighlight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_DeleteHighlight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).DeleteHighlight(ctx, req.(*DeleteHighlightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_SearchFavourites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchFavouritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).SearchFavourites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_SearchFavourites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).SearchFavourites(ctx, req.(*SearchFavouritesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_MarkSeen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkSeenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).MarkSeen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_MarkSeen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).MarkSeen(ctx, req.(*MarkSeenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_MarkUnseen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkUnseenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).MarkUnseen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_MarkUnseen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).MarkUnseen(ctx, req.(*MarkUnseenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaveService_GetSeen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).GetSeen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_GetSeen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).GetSeen(ctx, req.(*GetSeenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSaveServiceServer) GetSubscriptions(context.Context, *GetSubscriptionsRequest) (*GetSubscriptionsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetSubscriptions not implemented")
+
+func _SaveService_RecordNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).RecordNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_RecordNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).RecordNotification(ctx, req.(*RecordNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSaveServiceServer) mustEmbedUnimplementedSaveServiceServer() {}
-func (UnimplementedSaveServiceServer) testEmbeddedByValue()                     {}
 
-// UnsafeSaveServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to SaveServiceServer will
-// result in compilation errors.
-type UnsafeSaveServiceServer interface {
-	mustEmbedUnimplementedSaveServiceServer()
+func _SaveService_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaveServiceServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_ExportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).ExportUserData(ctx, req.(*ExportUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterSaveServiceServer(s grpc.ServiceRegistrar, srv SaveServiceServer) {
-	// If the following call panics, it indicates UnimplementedSaveServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _SaveService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&SaveService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(SaveServiceServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaveService_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaveServiceServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _SaveService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserRequest)
+func _SaveService_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SaveServiceServer).CreateUser(ctx, in)
+		return srv.(SaveServiceServer).CreateAPIKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SaveService_CreateUser_FullMethodName,
+		FullMethod: SaveService_CreateAPIKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SaveServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+		return srv.(SaveServiceServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SaveService_SaveNews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaveNewsRequest)
+func _SaveService_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPIKeysRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SaveServiceServer).SaveNews(ctx, in)
+		return srv.(SaveServiceServer).ListAPIKeys(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SaveService_SaveNews_FullMethodName,
+		FullMethod: SaveService_ListAPIKeys_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SaveServiceServer).SaveNews(ctx, req.(*SaveNewsRequest))
+		return srv.(SaveServiceServer).ListAPIKeys(ctx, req.(*ListAPIKeysRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SaveService_GetNewsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetNewsByIDsRequest)
+func _SaveService_RotateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateAPIKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SaveServiceServer).GetNewsByIDs(ctx, in)
+		return srv.(SaveServiceServer).RotateAPIKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SaveService_GetNewsByIDs_FullMethodName,
+		FullMethod: SaveService_RotateAPIKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SaveServiceServer).GetNewsByIDs(ctx, req.(*GetNewsByIDsRequest))
+		return srv.(SaveServiceServer).RotateAPIKey(ctx, req.(*RotateAPIKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SaveService_AddFavourite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddFavouriteRequest)
+func _SaveService_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SaveServiceServer).AddFavourite(ctx, in)
+		return srv.(SaveServiceServer).RevokeAPIKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SaveService_AddFavourite_FullMethodName,
+		FullMethod: SaveService_RevokeAPIKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SaveServiceServer).AddFavourite(ctx, req.(*AddFavouriteRequest))
+		return srv.(SaveServiceServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SaveService_GetFavourites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFavouritesRequest)
+func _SaveService_AuthenticateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthenticateAPIKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SaveServiceServer).GetFavourites(ctx, in)
+		return srv.(SaveServiceServer).AuthenticateAPIKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SaveService_GetFavourites_FullMethodName,
+		FullMethod: SaveService_AuthenticateAPIKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SaveServiceServer).GetFavourites(ctx, req.(*GetFavouritesRequest))
+		return srv.(SaveServiceServer).AuthenticateAPIKey(ctx, req.(*AuthenticateAPIKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SaveService_AddToSearchHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddToSearchHistoryRequest)
+func _SaveService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SaveServiceServer).AddToSearchHistory(ctx, in)
+		return srv.(SaveServiceServer).ListUsers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SaveService_AddToSearchHistory_FullMethodName,
+		FullMethod: SaveService_ListUsers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SaveServiceServer).AddToSearchHistory(ctx, req.(*AddToSearchHistoryRequest))
+		return srv.(SaveServiceServer).ListUsers(ctx, req.(*ListUsersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SaveService_GetSearchHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSearchHistoryRequest)
+func _SaveService_RunMigrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunMigrationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SaveServiceServer).GetSearchHistory(ctx, in)
+		return srv.(SaveServiceServer).RunMigrations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SaveService_GetSearchHistory_FullMethodName,
+		FullMethod: SaveService_RunMigrations_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SaveServiceServer).GetSearchHistory(ctx, req.(*GetSearchHistoryRequest))
+		return srv.(SaveServiceServer).RunMigrations(ctx, req.(*RunMigrationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SaveService_Subscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubscribeRequest)
+func _SaveService_ListAllSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllSubscriptionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SaveServiceServer).Subscribe(ctx, in)
+		return srv.(SaveServiceServer).ListAllSubscriptions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SaveService_Subscribe_FullMethodName,
+		FullMethod: SaveService_ListAllSubscriptions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SaveServiceServer).Subscribe(ctx, req.(*SubscribeRequest))
+		return srv.(SaveServiceServer).ListAllSubscriptions(ctx, req.(*ListAllSubscriptionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SaveService_GetSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSubscriptionsRequest)
+func _SaveService_ListAllSavedSearches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllSavedSearchesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SaveServiceServer).GetSubscriptions(ctx, in)
+		return srv.(SaveServiceServer).ListAllSavedSearches(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SaveService_GetSubscriptions_FullMethodName,
+		FullMethod: SaveService_ListAllSavedSearches_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SaveServiceServer).GetSubscriptions(ctx, req.(*GetSubscriptionsRequest))
+		return srv.(SaveServiceServer).ListAllSavedSearches(ctx, req.(*ListAllSavedSearchesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -391,6 +1825,14 @@ var SaveService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateUser",
 			Handler:    _SaveService_CreateUser_Handler,
 		},
+		{
+			MethodName: "GetUser",
+			Handler:    _SaveService_GetUser_Handler,
+		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _SaveService_UpdateUser_Handler,
+		},
 		{
 			MethodName: "SaveNews",
 			Handler:    _SaveService_SaveNews_Handler,
@@ -415,6 +1857,10 @@ var SaveService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSearchHistory",
 			Handler:    _SaveService_GetSearchHistory_Handler,
 		},
+		{
+			MethodName: "GetSearchHistoryEntry",
+			Handler:    _SaveService_GetSearchHistoryEntry_Handler,
+		},
 		{
 			MethodName: "Subscribe",
 			Handler:    _SaveService_Subscribe_Handler,
@@ -423,6 +1869,162 @@ var SaveService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSubscriptions",
 			Handler:    _SaveService_GetSubscriptions_Handler,
 		},
+		{
+			MethodName: "UpdateSubscription",
+			Handler:    _SaveService_UpdateSubscription_Handler,
+		},
+		{
+			MethodName: "CreateSavedSearch",
+			Handler:    _SaveService_CreateSavedSearch_Handler,
+		},
+		{
+			MethodName: "GetSavedSearch",
+			Handler:    _SaveService_GetSavedSearch_Handler,
+		},
+		{
+			MethodName: "ListSavedSearches",
+			Handler:    _SaveService_ListSavedSearches_Handler,
+		},
+		{
+			MethodName: "UpdateSavedSearch",
+			Handler:    _SaveService_UpdateSavedSearch_Handler,
+		},
+		{
+			MethodName: "DeleteSavedSearch",
+			Handler:    _SaveService_DeleteSavedSearch_Handler,
+		},
+		{
+			MethodName: "RecordSavedSearchRun",
+			Handler:    _SaveService_RecordSavedSearchRun_Handler,
+		},
+		{
+			MethodName: "CreateCollection",
+			Handler:    _SaveService_CreateCollection_Handler,
+		},
+		{
+			MethodName: "ListCollections",
+			Handler:    _SaveService_ListCollections_Handler,
+		},
+		{
+			MethodName: "RenameCollection",
+			Handler:    _SaveService_RenameCollection_Handler,
+		},
+		{
+			MethodName: "DeleteCollection",
+			Handler:    _SaveService_DeleteCollection_Handler,
+		},
+		{
+			MethodName: "GetCollectionItems",
+			Handler:    _SaveService_GetCollectionItems_Handler,
+		},
+		{
+			MethodName: "AddToCollection",
+			Handler:    _SaveService_AddToCollection_Handler,
+		},
+		{
+			MethodName: "RemoveFromCollection",
+			Handler:    _SaveService_RemoveFromCollection_Handler,
+		},
+		{
+			MethodName: "CopyCollectionItems",
+			Handler:    _SaveService_CopyCollectionItems_Handler,
+		},
+		{
+			MethodName: "ReorderCollection",
+			Handler:    _SaveService_ReorderCollection_Handler,
+		},
+		{
+			MethodName: "ShareCollection",
+			Handler:    _SaveService_ShareCollection_Handler,
+		},
+		{
+			MethodName: "GetSharedCollection",
+			Handler:    _SaveService_GetSharedCollection_Handler,
+		},
+		{
+			MethodName: "GetFavouriteAnnotation",
+			Handler:    _SaveService_GetFavouriteAnnotation_Handler,
+		},
+		{
+			MethodName: "SetFavouriteTags",
+			Handler:    _SaveService_SetFavouriteTags_Handler,
+		},
+		{
+			MethodName: "SetFavouriteNote",
+			Handler:    _SaveService_SetFavouriteNote_Handler,
+		},
+		{
+			MethodName: "AddHighlight",
+			Handler:    _SaveService_AddHighlight_Handler,
+		},
+		{
+			MethodName: "DeleteHighlight",
+			Handler:    _SaveService_DeleteHighlight_Handler,
+		},
+		{
+			MethodName: "SearchFavourites",
+			Handler:    _SaveService_SearchFavourites_Handler,
+		},
+		{
+			MethodName: "MarkSeen",
+			Handler:    _SaveService_MarkSeen_Handler,
+		},
+		{
+			MethodName: "MarkUnseen",
+			Handler:    _SaveService_MarkUnseen_Handler,
+		},
+		{
+			MethodName: "GetSeen",
+			Handler:    _SaveService_GetSeen_Handler,
+		},
+		{
+			MethodName: "RecordNotification",
+			Handler:    _SaveService_RecordNotification_Handler,
+		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _SaveService_ExportUserData_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _SaveService_DeleteUser_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _SaveService_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _SaveService_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "RotateAPIKey",
+			Handler:    _SaveService_RotateAPIKey_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _SaveService_RevokeAPIKey_Handler,
+		},
+		{
+			MethodName: "AuthenticateAPIKey",
+			Handler:    _SaveService_AuthenticateAPIKey_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _SaveService_ListUsers_Handler,
+		},
+		{
+			MethodName: "RunMigrations",
+			Handler:    _SaveService_RunMigrations_Handler,
+		},
+		{
+			MethodName: "ListAllSubscriptions",
+			Handler:    _SaveService_ListAllSubscriptions_Handler,
+		},
+		{
+			MethodName: "ListAllSavedSearches",
+			Handler:    _SaveService_ListAllSavedSearches_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "news_service.proto",
@@ -432,6 +2034,7 @@ const (
 	SearchService_SearchNews_FullMethodName       = "/news.SearchService/SearchNews"
 	SearchService_GetTopHeadlines_FullMethodName  = "/news.SearchService/GetTopHeadlines"
 	SearchService_CheckNewArticles_FullMethodName = "/news.SearchService/CheckNewArticles"
+	SearchService_PurgeCache_FullMethodName       = "/news.SearchService/PurgeCache"
 )
 
 // SearchServiceClient is the client API for SearchService service.
@@ -443,6 +2046,8 @@ type SearchServiceClient interface {
 	SearchNews(ctx context.Context, in *SearchNewsRequest, opts ...grpc.CallOption) (*SearchNewsResponse, error)
 	GetTopHeadlines(ctx context.Context, in *GetTopHeadlinesRequest, opts ...grpc.CallOption) (*GetTopHeadlinesResponse, error)
 	CheckNewArticles(ctx context.Context, in *CheckNewArticlesRequest, opts ...grpc.CallOption) (*CheckNewArticlesResponse, error)
+	// Администрирование (gonewsctl); в REST не публикуется
+	PurgeCache(ctx context.Context, in *PurgeCacheRequest, opts ...grpc.CallOption) (*PurgeCacheResponse, error)
 }
 
 type searchServiceClient struct {
@@ -483,6 +2088,16 @@ func (c *searchServiceClient) CheckNewArticles(ctx context.Context, in *CheckNew
 	return out, nil
 }
 
+func (c *searchServiceClient) PurgeCache(ctx context.Context, in *PurgeCacheRequest, opts ...grpc.CallOption) (*PurgeCacheResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeCacheResponse)
+	err := c.cc.Invoke(ctx, SearchService_PurgeCache_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SearchServiceServer is the server API for SearchService service.
 // All implementations must embed UnimplementedSearchServiceServer
 // for forward compatibility.
@@ -492,6 +2107,8 @@ type SearchServiceServer interface {
 	SearchNews(context.Context, *SearchNewsRequest) (*SearchNewsResponse, error)
 	GetTopHeadlines(context.Context, *GetTopHeadlinesRequest) (*GetTopHeadlinesResponse, error)
 	CheckNewArticles(context.Context, *CheckNewArticlesRequest) (*CheckNewArticlesResponse, error)
+	// Администрирование (gonewsctl); в REST не публикуется
+	PurgeCache(context.Context, *PurgeCacheRequest) (*PurgeCacheResponse, error)
 	mustEmbedUnimplementedSearchServiceServer()
 }
 
@@ -511,6 +2128,9 @@ func (UnimplementedSearchServiceServer) GetTopHeadlines(context.Context, *GetTop
 func (UnimplementedSearchServiceServer) CheckNewArticles(context.Context, *CheckNewArticlesRequest) (*CheckNewArticlesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CheckNewArticles not implemented")
 }
+func (UnimplementedSearchServiceServer) PurgeCache(context.Context, *PurgeCacheRequest) (*PurgeCacheResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeCache not implemented")
+}
 func (UnimplementedSearchServiceServer) mustEmbedUnimplementedSearchServiceServer() {}
 func (UnimplementedSearchServiceServer) testEmbeddedByValue()                       {}
 
@@ -586,6 +2206,24 @@ func _SearchService_CheckNewArticles_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SearchService_PurgeCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServiceServer).PurgeCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SearchService_PurgeCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServiceServer).PurgeCache(ctx, req.(*PurgeCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SearchService_ServiceDesc is the grpc.ServiceDesc for SearchService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -605,13 +2243,19 @@ var SearchService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckNewArticles",
 			Handler:    _SearchService_CheckNewArticles_Handler,
 		},
+		{
+			MethodName: "PurgeCache",
+			Handler:    _SearchService_PurgeCache_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "news_service.proto",
 }
 
 const (
-	NotificationService_SendNotification_FullMethodName = "/news.NotificationService/SendNotification"
+	NotificationService_SendNotification_FullMethodName  = "/news.NotificationService/SendNotification"
+	NotificationService_ReplayDeadLetters_FullMethodName = "/news.NotificationService/ReplayDeadLetters"
+	NotificationService_TriggerCheck_FullMethodName      = "/news.NotificationService/TriggerCheck"
 )
 
 // NotificationServiceClient is the client API for NotificationService service.
@@ -621,6 +2265,10 @@ const (
 // Notification Service
 type NotificationServiceClient interface {
 	SendNotification(ctx context.Context, in *SendNotificationRequest, opts ...grpc.CallOption) (*SendNotificationResponse, error)
+	// Администрирование (gonewsctl); в REST не публикуется
+	ReplayDeadLetters(ctx context.Context, in *ReplayDeadLettersRequest, opts ...grpc.CallOption) (*ReplayDeadLettersResponse, error)
+	// Внеплановая проверка подписок, не дожидаясь расписания
+	TriggerCheck(ctx context.Context, in *TriggerCheckRequest, opts ...grpc.CallOption) (*TriggerCheckResponse, error)
 }
 
 type notificationServiceClient struct {
@@ -641,6 +2289,26 @@ func (c *notificationServiceClient) SendNotification(ctx context.Context, in *Se
 	return out, nil
 }
 
+func (c *notificationServiceClient) ReplayDeadLetters(ctx context.Context, in *ReplayDeadLettersRequest, opts ...grpc.CallOption) (*ReplayDeadLettersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayDeadLettersResponse)
+	err := c.cc.Invoke(ctx, NotificationService_ReplayDeadLetters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) TriggerCheck(ctx context.Context, in *TriggerCheckRequest, opts ...grpc.CallOption) (*TriggerCheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TriggerCheckResponse)
+	err := c.cc.Invoke(ctx, NotificationService_TriggerCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NotificationServiceServer is the server API for NotificationService service.
 // All implementations must embed UnimplementedNotificationServiceServer
 // for forward compatibility.
@@ -648,6 +2316,10 @@ func (c *notificationServiceClient) SendNotification(ctx context.Context, in *Se
 // Notification Service
 type NotificationServiceServer interface {
 	SendNotification(context.Context, *SendNotificationRequest) (*SendNotificationResponse, error)
+	// Администрирование (gonewsctl); в REST не публикуется
+	ReplayDeadLetters(context.Context, *ReplayDeadLettersRequest) (*ReplayDeadLettersResponse, error)
+	// Внеплановая проверка подписок, не дожидаясь расписания
+	TriggerCheck(context.Context, *TriggerCheckRequest) (*TriggerCheckResponse, error)
 	mustEmbedUnimplementedNotificationServiceServer()
 }
 
@@ -661,6 +2333,12 @@ type UnimplementedNotificationServiceServer struct{}
 func (UnimplementedNotificationServiceServer) SendNotification(context.Context, *SendNotificationRequest) (*SendNotificationResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SendNotification not implemented")
 }
+func (UnimplementedNotificationServiceServer) ReplayDeadLetters(context.Context, *ReplayDeadLettersRequest) (*ReplayDeadLettersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReplayDeadLetters not implemented")
+}
+func (UnimplementedNotificationServiceServer) TriggerCheck(context.Context, *TriggerCheckRequest) (*TriggerCheckResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TriggerCheck not implemented")
+}
 func (UnimplementedNotificationServiceServer) mustEmbedUnimplementedNotificationServiceServer() {}
 func (UnimplementedNotificationServiceServer) testEmbeddedByValue()                             {}
 
@@ -700,6 +2378,42 @@ func _NotificationService_SendNotification_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationService_ReplayDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ReplayDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_ReplayDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ReplayDeadLetters(ctx, req.(*ReplayDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_TriggerCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).TriggerCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_TriggerCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).TriggerCheck(ctx, req.(*TriggerCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NotificationService_ServiceDesc is the grpc.ServiceDesc for NotificationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -711,6 +2425,14 @@ var NotificationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendNotification",
 			Handler:    _NotificationService_SendNotification_Handler,
 		},
+		{
+			MethodName: "ReplayDeadLetters",
+			Handler:    _NotificationService_ReplayDeadLetters_Handler,
+		},
+		{
+			MethodName: "TriggerCheck",
+			Handler:    _NotificationService_TriggerCheck_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "news_service.proto",
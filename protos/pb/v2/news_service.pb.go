@@ -0,0 +1,2233 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.2
+// source: v2/news_service.proto
+
+package pbv2
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Enums
+type SortBy int32
+
+const (
+	SortBy_SORT_BY_UNSPECIFIED  SortBy = 0
+	SortBy_SORT_BY_RELEVANCY    SortBy = 1
+	SortBy_SORT_BY_POPULARITY   SortBy = 2
+	SortBy_SORT_BY_PUBLISHED_AT SortBy = 3
+)
+
+// Enum value maps for SortBy.
+var (
+	SortBy_name = map[int32]string{
+		0: "SORT_BY_UNSPECIFIED",
+		1: "SORT_BY_RELEVANCY",
+		2: "SORT_BY_POPULARITY",
+		3: "SORT_BY_PUBLISHED_AT",
+	}
+	SortBy_value = map[string]int32{
+		"SORT_BY_UNSPECIFIED":  0,
+		"SORT_BY_RELEVANCY":    1,
+		"SORT_BY_POPULARITY":   2,
+		"SORT_BY_PUBLISHED_AT": 3,
+	}
+)
+
+func (x SortBy) Enum() *SortBy {
+	p := new(SortBy)
+	*p = x
+	return p
+}
+
+func (x SortBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_v2_news_service_proto_enumTypes[0].Descriptor()
+}
+
+func (SortBy) Type() protoreflect.EnumType {
+	return &file_v2_news_service_proto_enumTypes[0]
+}
+
+func (x SortBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortBy.Descriptor instead.
+func (SortBy) EnumDescriptor() ([]byte, []int) {
+	return file_v2_news_service_proto_rawDescGZIP(), []int{0}
+}
+
+type Category int32
+
+const (
+	Category_CATEGORY_UNSPECIFIED   Category = 0
+	Category_CATEGORY_BUSINESS      Category = 1
+	Category_CATEGORY_ENTERTAINMENT Category = 2
+	Category_CATEGORY_GENERAL       Category = 3
+	Category_CATEGORY_HEALTH        Category = 4
+	Category_CATEGORY_SCIENCE       Category = 5
+	Category_CATEGORY_SPORTS        Category = 6
+	Category_CATEGORY_TECHNOLOGY    Category = 7
+)
+
+// Enum value maps for Category.
+var (
+	Category_name = map[int32]string{
+		0: "CATEGORY_UNSPECIFIED",
+		1: "CATEGORY_BUSINESS",
+		2: "CATEGORY_ENTERTAINMENT",
+		3: "CATEGORY_GENERAL",
+		4: "CATEGORY_HEALTH",
+		5: "CATEGORY_SCIENCE",
+		6: "CATEGORY_SPORTS",
+		7: "CATEGORY_TECHNOLOGY",
+	}
+	Category_value = map[string]int32{
+		"CATEGORY_UNSPECIFIED":   0,
+		"CATEGORY_BUSINESS":      1,
+		"CATEGORY_ENTERTAINMENT": 2,
+		"CATEGORY_GENERAL":       3,
+		"CATEGORY_HEALTH":        4,
+		"CATEGORY_SCIENCE":       5,
+		"CATEGORY_SPORTS":        6,
+		"CATEGORY_TECHNOLOGY":    7,
+	}
+)
+
+func (x Category) Enum() *Category {
+	p := new(Category)
+	*p = x
+	return p
+}
+
+func (x Category) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Category) Descriptor() protoreflect.EnumDescriptor {
+	return file_v2_news_service_proto_enumTypes[1].Descriptor()
+}
+
+func (Category) Type() protoreflect.EnumType {
+	return &file_v2_news_service_proto_enumTypes[1]
+}
+
+func (x Category) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Category.Descriptor instead.
+func (Category) EnumDescriptor() ([]byte, []int) {
+	return file_v2_news_service_proto_rawDescGZIP(), []int{1}
+}
+
+type Language int32
+
+const (
+	Language_LANGUAGE_UNSPECIFIED Language = 0
+	Language_LANGUAGE_AR          Language = 1
+	Language_LANGUAGE_DE          Language = 2
+	Language_LANGUAGE_EN          Language = 3
+	Language_LANGUAGE_ES          Language = 4
+	Language_LANGUAGE_FR          Language = 5
+	Language_LANGUAGE_HE          Language = 6
+	Language_LANGUAGE_IT          Language = 7
+	Language_LANGUAGE_NL          Language = 8
+	Language_LANGUAGE_NO          Language = 9
+	Language_LANGUAGE_PT          Language = 10
+	Language_LANGUAGE_RU          Language = 11
+	Language_LANGUAGE_SV          Language = 12
+	Language_LANGUAGE_UD          Language = 13
+	Language_LANGUAGE_ZH          Language = 14
+)
+
+// Enum value maps for Language.
+var (
+	Language_name = map[int32]string{
+		0:  "LANGUAGE_UNSPECIFIED",
+		1:  "LANGUAGE_AR",
+		2:  "LANGUAGE_DE",
+		3:  "LANGUAGE_EN",
+		4:  "LANGUAGE_ES",
+		5:  "LANGUAGE_FR",
+		6:  "LANGUAGE_HE",
+		7:  "LANGUAGE_IT",
+		8:  "LANGUAGE_NL",
+		9:  "LANGUAGE_NO",
+		10: "LANGUAGE_PT",
+		11: "LANGUAGE_RU",
+		12: "LANGUAGE_SV",
+		13: "LANGUAGE_UD",
+		14: "LANGUAGE_ZH",
+	}
+	Language_value = map[string]int32{
+		"LANGUAGE_UNSPECIFIED": 0,
+		"LANGUAGE_AR":          1,
+		"LANGUAGE_DE":          2,
+		"LANGUAGE_EN":          3,
+		"LANGUAGE_ES":          4,
+		"LANGUAGE_FR":          5,
+		"LANGUAGE_HE":          6,
+		"LANGUAGE_IT":          7,
+		"LANGUAGE_NL":          8,
+		"LANGUAGE_NO":          9,
+		"LANGUAGE_PT":          10,
+		"LANGUAGE_RU":          11,
+		"LANGUAGE_SV":          12,
+		"LANGUAGE_UD":          13,
+		"LANGUAGE_ZH":          14,
+	}
+)
+
+func (x Language) Enum() *Language {
+	p := new(Language)
+	*p = x
+	return p
+}
+
+func (x Language) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Language) Descriptor() protoreflect.EnumDescriptor {
+	return file_v2_news_service_proto_enumTypes[2].Descriptor()
+}
+
+func (Language) Type() protoreflect.EnumType {
+	return &file_v2_news_service_proto_enumTypes[2]
+}
+
+func (x Language) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Language.Descriptor instead.
+func (Language) EnumDescriptor() ([]byte, []int) {
+	return file_v2_news_service_proto_rawDescGZIP(), []int{2}
+}
+
+// Common Messages
+type News struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Author        string                 `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Url           string                 `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	PublishedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *News) Reset() {
+	*x = News{}
+	mi := &file_v2_news_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *News) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*News) ProtoMessage() {}
+
+func (x *News) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_news_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use News.ProtoReflect.Descriptor instead.
+func (*News) Descriptor() ([]byte, []int) {
+	return file_v2_news_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *News) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *News) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *News) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *News) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *News) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *News) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *News) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *News) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+type CreateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserRequest) Reset() {
+	*x = CreateUserRequest{}
+	mi := &file_v2_news_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserRequest) ProtoMessage() {}
+
+func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_news_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+	return file_v2_news_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateUserRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserResponse) Reset() {
+	*x = CreateUserResponse{}
+	mi := &file_v2_news_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserResponse) ProtoMessage() {}
+
+func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_news_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
+func (*CreateUserResponse) Descriptor() ([]byte, []int) {
+	return file_v2_news_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateUserResponse) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type SaveNewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	News          []*News                `protobuf:"bytes,1,rep,name=news,proto3" json:"news,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveNewsRequest) Reset() {
+	*x = SaveNewsRequest{}
+	mi := &file_v2_news_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveNewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveNewsRequest) ProtoMessage() {}
+
+func (x *SaveNewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_news_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveNewsRequest.ProtoReflect.Descriptor instead.
+func (*SaveNewsRequest) Descriptor() ([]byte, []int) {
+	return file_v2_news_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SaveNewsRequest) GetNews() []*News {
+	if x != nil {
+		return x.News
+	}
+	return nil
+}
+
+type SaveNewsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// ID сохранённых новостей в порядке запроса.
+	Ids           []uint64 `protobuf:"varint,2,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveNewsResponse) Reset() {
+	*x = SaveNewsResponse{}
+	mi := &file_v2_news_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveNewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveNewsResponse) ProtoMessage() {}
+
+func (x *SaveNewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_news_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveNewsResponse.ProtoReflect.Descriptor instead.
+func (*SaveNewsResponse) Descriptor() ([]byte, []int) {
+	return file_v2_news_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SaveNewsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SaveNewsResponse) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type GetNewsByIDsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []uint64               `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNewsByIDsRequest) Reset() {
+	*x = GetNewsByIDsRequest{}
+	mi := &file_v2_news_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNewsByIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNewsByIDsRequest) ProtoMessage() {}
+
+func (x *GetNewsByIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_news_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNewsByIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetNewsByIDsRequest) Descriptor() ([]byte, []int) {
+	return file_v2_news_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetNewsByIDsRequest) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type GetNewsByIDsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	News          []*News                `protobuf:"bytes,1,rep,name=news,proto3" json:"news,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNewsByIDsResponse) Reset() {
+	*x = GetNewsByIDsResponse{}
+	mi := &file_v2_news_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNewsByIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNewsByIDsResponse) ProtoMessage() {}
+
+func (x *GetNewsByIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_news_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNewsByIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetNewsByIDsResponse) Descriptor() ([]byte, []int) {
+	return file_v2_news_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetNewsByIDsResponse) GetNews() []*News {
+	if x != nil {
+		return x.News
+	}
+	return nil
+}
+
+type AddFavouriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NewsId        uint64                 `protobuf:"varint,2,opt,name=news_id,json=newsId,proto3" json:"news_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddFavouriteRequest) Reset() {
+	*x = AddFavouriteRequest{}
+	mi := &file_v2_news_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddFavouriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFavouriteRequest) ProtoMessage() {}
+
+func (x *AddFavouriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_news_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFavouriteRequest.ProtoReflect.Descriptor instead.
+func (*AddFavouriteRequest) Descriptor() ([]byte, []int) {
+	return file_v2_news_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AddFavouriteRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AddFavouriteRequest) GetNewsId() uint64 {
+	if x != nil {
+		return x.NewsId
+	}
+	return 0
+}
+
+type AddFavouriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddFavouriteResponse) Reset() {
+	*x = AddFavouriteResponse{}
+	mi := &file_v2_news_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddFavouriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFavouriteResponse) ProtoMessage() {}
+
+func (x *AddFavouriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_news_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFavouriteResponse.ProtoReflect.Descriptor instead.
+func (*AddFavouriteResponse) Descriptor() ([]byte, []int) {
+	return file_v2_news_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AddFavouriteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetFavouritesRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Курсор из next_page_token предыдущего ответа; пусто - первая страница.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// 0 - размер страницы по умолчанию; больше максимума - урезается до максимума.
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Пропускать новости, которые пользователь уже просмотрел.
+	UnseenOnly    bool `protobuf:"varint,4,opt,name=unseen_only,json=unseenOnly,proto3" json:"unseen_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFavouritesRequest) Reset() {
+	*x = GetFavouritesRequest{}
+	mi := &file_v2_news_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFavouritesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFavouritesRequest) ProtoMessage() {}
+
+func (x *GetFavouritesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_news_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFavouritesRequest.ProtoReflect.Descriptor instead.
+func (*GetFavouritesRequest) Descriptor() ([]byte, []int) {
+	return file_v2_news_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetFavouritesRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetFavouritesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetFavouritesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetFavouritesRequest) GetUnseenOnly() bool {
+	if x != nil {
+		return x.UnseenOnly
+	}
+	return false
+}
+
+type GetFavouritesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	News  []*News                `protobuf:"bytes,1,rep,name=news,proto3" json:"news,omitempty"`
+	// Пусто, если страниц больше нет.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFavouritesResponse) Reset() {
+	*x = GetFavouritesResponse{}
+	mi := &file_v2_news_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFavouritesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFavouritesResponse) ProtoMessage() {}
+
+func (x *GetFavouritesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_news_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFavouritesResponse.ProtoReflect.Descriptor instead.
+func (*GetFavouritesResponse) Descriptor() ([]byte, []int) {
+	return file_v2_news_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetFavouritesResponse) GetNews() []*News {
+	if x != nil {
+		return x.News
+	}
+	return nil
+}
+
+func (x *GetFavouritesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type AddToSearchHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Results       []uint64               `protobuf:"varint,3,rep,packed,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddToSearchHistoryRequest) Reset() {
+	*x = AddToSearchHistoryRequest{}
+	mi := &file_v2_news_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddToSearchHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToSearchHistoryRequest) ProtoMessage() {}
+
+func (x *AddToSearchHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_news_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToSearchHistoryRequest.ProtoReflect.Descriptor instead.
+func (*AddToSearchHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_v2_news_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AddToSearchHistoryRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AddToSearchHistoryRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *AddToSearchHistoryRequest) GetResults() []uint64 {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type AddToSearchHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddToSearchHistoryResponse) Reset() {
+	*x = AddToSearchHistoryResponse{}
+	mi := &file_v2_news_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddToSearchHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToSearchHistoryResponse) ProtoMessage() {}
+
+func (x *AddToSearchHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_news_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToSearchHistoryResponse.ProtoReflect.Descriptor instead.
+func (*AddToSearchHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_v2_news_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AddToSearchHistoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetSearchHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSearchHistoryRequest) Reset() {
+	*x = GetSearchHistoryRequest{}
+	mi := &file_v2_news_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSearchHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSearchHistoryRequest) ProtoMessage() {}
+
+func (x *GetSearchHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_news_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSearchHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetSearchHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_v2_news_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetSearchHistoryRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetSearchHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetSearchHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetSearchHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queries       []string               `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSearchHistoryResponse) Reset() {
+	*x = GetSearchHistoryResponse{}
+	mi := &file_v2_news_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSearchHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSearchHistoryResponse) ProtoMessage() {}
+
+func (x *GetSearchHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_news_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSearchHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetSearchHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_v2_news_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetSearchHistoryResponse) GetQueries() []string {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+func (x *GetSearchHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SubscribeRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	UserId  uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Keyword string                 `protobuf:"bytes,2,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	// Расписание проверок, как в v1; пусто - общий интервал.
+	Schedule      string `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	mi := &file_v2_news_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_news_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_v2_news_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SubscribeRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SubscribeRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SubscribeRequest) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+type SubscribeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeResponse) Reset() {
+	*x = SubscribeResponse{}
+	mi := &file_v2_news_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeResponse) ProtoMessage() {}
+
+func (x *SubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_news_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_v2_news_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SubscribeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetSubscriptionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 - подписки всех пользователей.
+	UserId        uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize      int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSubscriptionsRequest) Reset() {
+	*x = GetSubscriptionsRequest{}
+	mi := &file_v2_news_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscriptionsRequest) ProtoMessage() {}
+
+func (x *GetSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_news_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_v2_news_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetSubscriptionsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetSubscriptionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetSubscriptionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetSubscriptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscriptions []*Subscription        `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSubscriptionsResponse) Reset() {
+	*x = GetSubscriptionsResponse{}
+	mi := &file_v2_news_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscriptionsResponse) ProtoMessage() {}
+
+func (x *GetSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_news_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_v2_news_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetSubscriptionsResponse) GetSubscriptions() []*Subscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+func (x *GetSubscriptionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type Subscription struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Keyword       string                 `protobuf:"bytes,3,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	Schedule      string                 `protobuf:"bytes,4,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	mi := &file_v2_news_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_news_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_v2_news_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Subscription) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Subscription) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Subscription) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *Subscription) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+// Search Service Messages
+type SearchNewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Sources       *string                `protobuf:"bytes,3,opt,name=sources,proto3,oneof" json:"sources,omitempty"`
+	Domains       *string                `protobuf:"bytes,4,opt,name=domains,proto3,oneof" json:"domains,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	Language      Language               `protobuf:"varint,7,opt,name=language,proto3,enum=news.v2.Language" json:"language,omitempty"`
+	SortBy        SortBy                 `protobuf:"varint,8,opt,name=sort_by,json=sortBy,proto3,enum=news.v2.SortBy" json:"sort_by,omitempty"`
+	PageSize      *int32                 `protobuf:"varint,9,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	Page          *int32                 `protobuf:"varint,10,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchNewsRequest) Reset() {
+	*x = SearchNewsRequest{}
+	mi := &file_v2_news_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchNewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchNewsRequest) ProtoMessage() {}
+
+func (x *SearchNewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_news_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchNewsRequest.ProtoReflect.Descriptor instead.
+func (*SearchNewsRequest) Descriptor() ([]byte, []int) {
+	return file_v2_news_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SearchNewsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SearchNewsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchNewsRequest) GetSources() string {
+	if x != nil && x.Sources != nil {
+		return *x.Sources
+	}
+	return ""
+}
+
+func (x *SearchNewsRequest) GetDomains() string {
+	if x != nil && x.Domains != nil {
+		return *x.Domains
+	}
+	return ""
+}
+
+func (x *SearchNewsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *SearchNewsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *SearchNewsRequest) GetLanguage() Language {
+	if x != nil {
+		return x.Language
+	}
+	return Language_LANGUAGE_UNSPECIFIED
+}
+
+func (x *SearchNewsRequest) GetSortBy() SortBy {
+	if x != nil {
+		return x.SortBy
+	}
+	return SortBy_SORT_BY_UNSPECIFIED
+}
+
+func (x *SearchNewsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchNewsRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+type SearchNewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	News          []*News                `protobuf:"bytes,1,rep,name=news,proto3" json:"news,omitempty"`
+	TotalResults  int32                  `protobuf:"varint,2,opt,name=total_results,json=totalResults,proto3" json:"total_results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchNewsResponse) Reset() {
+	*x = SearchNewsResponse{}
+	mi := &file_v2_news_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchNewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchNewsResponse) ProtoMessage() {}
+
+func (x *SearchNewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_news_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchNewsResponse.ProtoReflect.Descriptor instead.
+func (*SearchNewsResponse) Descriptor() ([]byte, []int) {
+	return file_v2_news_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SearchNewsResponse) GetNews() []*News {
+	if x != nil {
+		return x.News
+	}
+	return nil
+}
+
+func (x *SearchNewsResponse) GetTotalResults() int32 {
+	if x != nil {
+		return x.TotalResults
+	}
+	return 0
+}
+
+type GetTopHeadlinesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Country       *string                `protobuf:"bytes,2,opt,name=country,proto3,oneof" json:"country,omitempty"`
+	Category      Category               `protobuf:"varint,3,opt,name=category,proto3,enum=news.v2.Category" json:"category,omitempty"`
+	Sources       *string                `protobuf:"bytes,4,opt,name=sources,proto3,oneof" json:"sources,omitempty"`
+	Query         *string                `protobuf:"bytes,5,opt,name=query,proto3,oneof" json:"query,omitempty"`
+	PageSize      *int32                 `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	Page          *int32                 `protobuf:"varint,7,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTopHeadlinesRequest) Reset() {
+	*x = GetTopHeadlinesRequest{}
+	mi := &file_v2_news_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopHeadlinesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopHeadlinesRequest) ProtoMessage() {}
+
+func (x *GetTopHeadlinesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_news_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopHeadlinesRequest.ProtoReflect.Descriptor instead.
+func (*GetTopHeadlinesRequest) Descriptor() ([]byte, []int) {
+	return file_v2_news_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetTopHeadlinesRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetTopHeadlinesRequest) GetCountry() string {
+	if x != nil && x.Country != nil {
+		return *x.Country
+	}
+	return ""
+}
+
+func (x *GetTopHeadlinesRequest) GetCategory() Category {
+	if x != nil {
+		return x.Category
+	}
+	return Category_CATEGORY_UNSPECIFIED
+}
+
+func (x *GetTopHeadlinesRequest) GetSources() string {
+	if x != nil && x.Sources != nil {
+		return *x.Sources
+	}
+	return ""
+}
+
+func (x *GetTopHeadlinesRequest) GetQuery() string {
+	if x != nil && x.Query != nil {
+		return *x.Query
+	}
+	return ""
+}
+
+func (x *GetTopHeadlinesRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *GetTopHeadlinesRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+type GetTopHeadlinesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	News          []*News                `protobuf:"bytes,1,rep,name=news,proto3" json:"news,omitempty"`
+	TotalResults  int32                  `protobuf:"varint,2,opt,name=total_results,json=totalResults,proto3" json:"total_results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTopHeadlinesResponse) Reset() {
+	*x = GetTopHeadlinesResponse{}
+	mi := &file_v2_news_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopHeadlinesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopHeadlinesResponse) ProtoMessage() {}
+
+func (x *GetTopHeadlinesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_news_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopHeadlinesResponse.ProtoReflect.Descriptor instead.
+func (*GetTopHeadlinesResponse) Descriptor() ([]byte, []int) {
+	return file_v2_news_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetTopHeadlinesResponse) GetNews() []*News {
+	if x != nil {
+		return x.News
+	}
+	return nil
+}
+
+func (x *GetTopHeadlinesResponse) GetTotalResults() int32 {
+	if x != nil {
+		return x.TotalResults
+	}
+	return 0
+}
+
+type CheckNewArticlesRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Keyword string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	// Если не задано, проверяются статьи за последние 24 часа.
+	LastCheckTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_check_time,json=lastCheckTime,proto3" json:"last_check_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckNewArticlesRequest) Reset() {
+	*x = CheckNewArticlesRequest{}
+	mi := &file_v2_news_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckNewArticlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckNewArticlesRequest) ProtoMessage() {}
+
+func (x *CheckNewArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_news_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckNewArticlesRequest.ProtoReflect.Descriptor instead.
+func (*CheckNewArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_v2_news_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CheckNewArticlesRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *CheckNewArticlesRequest) GetLastCheckTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastCheckTime
+	}
+	return nil
+}
+
+type CheckNewArticlesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NewArticles   []*News                `protobuf:"bytes,1,rep,name=new_articles,json=newArticles,proto3" json:"new_articles,omitempty"`
+	UserStats     []*UserArticleStats    `protobuf:"bytes,2,rep,name=user_stats,json=userStats,proto3" json:"user_stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckNewArticlesResponse) Reset() {
+	*x = CheckNewArticlesResponse{}
+	mi := &file_v2_news_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckNewArticlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckNewArticlesResponse) ProtoMessage() {}
+
+func (x *CheckNewArticlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_news_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckNewArticlesResponse.ProtoReflect.Descriptor instead.
+func (*CheckNewArticlesResponse) Descriptor() ([]byte, []int) {
+	return file_v2_news_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CheckNewArticlesResponse) GetNewArticles() []*News {
+	if x != nil {
+		return x.NewArticles
+	}
+	return nil
+}
+
+func (x *CheckNewArticlesResponse) GetUserStats() []*UserArticleStats {
+	if x != nil {
+		return x.UserStats
+	}
+	return nil
+}
+
+// Notification Service Messages
+type SendNotificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Articles      []*News                `protobuf:"bytes,3,rep,name=articles,proto3" json:"articles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendNotificationRequest) Reset() {
+	*x = SendNotificationRequest{}
+	mi := &file_v2_news_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendNotificationRequest) ProtoMessage() {}
+
+func (x *SendNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_news_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendNotificationRequest.ProtoReflect.Descriptor instead.
+func (*SendNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_v2_news_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SendNotificationRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SendNotificationRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SendNotificationRequest) GetArticles() []*News {
+	if x != nil {
+		return x.Articles
+	}
+	return nil
+}
+
+type UserArticleStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ArticlesCount int32                  `protobuf:"varint,2,opt,name=articles_count,json=articlesCount,proto3" json:"articles_count,omitempty"`
+	Articles      []*News                `protobuf:"bytes,3,rep,name=articles,proto3" json:"articles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserArticleStats) Reset() {
+	*x = UserArticleStats{}
+	mi := &file_v2_news_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserArticleStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserArticleStats) ProtoMessage() {}
+
+func (x *UserArticleStats) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_news_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserArticleStats.ProtoReflect.Descriptor instead.
+func (*UserArticleStats) Descriptor() ([]byte, []int) {
+	return file_v2_news_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UserArticleStats) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserArticleStats) GetArticlesCount() int32 {
+	if x != nil {
+		return x.ArticlesCount
+	}
+	return 0
+}
+
+func (x *UserArticleStats) GetArticles() []*News {
+	if x != nil {
+		return x.Articles
+	}
+	return nil
+}
+
+type SendNotificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	SentCount     int32                  `protobuf:"varint,2,opt,name=sent_count,json=sentCount,proto3" json:"sent_count,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendNotificationResponse) Reset() {
+	*x = SendNotificationResponse{}
+	mi := &file_v2_news_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendNotificationResponse) ProtoMessage() {}
+
+func (x *SendNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_news_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendNotificationResponse.ProtoReflect.Descriptor instead.
+func (*SendNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_v2_news_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SendNotificationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SendNotificationResponse) GetSentCount() int32 {
+	if x != nil {
+		return x.SentCount
+	}
+	return 0
+}
+
+func (x *SendNotificationResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_v2_news_service_proto protoreflect.FileDescriptor
+
+const file_v2_news_service_proto_rawDesc = "" +
+	"\n" +
+	"\x15v2/news_service.proto\x12\anews.v2\x1a\x1fgoogle/protobuf/timestamp.proto\"\xec\x01\n" +
+	"\x04News\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x16\n" +
+	"\x06author\x18\x03 \x01(\tR\x06author\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x10\n" +
+	"\x03url\x18\x06 \x01(\tR\x03url\x12\x1b\n" +
+	"\timage_url\x18\a \x01(\tR\bimageUrl\x12=\n" +
+	"\fpublished_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vpublishedAt\"'\n" +
+	"\x11CreateUserRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"-\n" +
+	"\x12CreateUserResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"4\n" +
+	"\x0fSaveNewsRequest\x12!\n" +
+	"\x04news\x18\x01 \x03(\v2\r.news.v2.NewsR\x04news\">\n" +
+	"\x10SaveNewsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x10\n" +
+	"\x03ids\x18\x02 \x03(\x04R\x03ids\"'\n" +
+	"\x13GetNewsByIDsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x04R\x03ids\"9\n" +
+	"\x14GetNewsByIDsResponse\x12!\n" +
+	"\x04news\x18\x01 \x03(\v2\r.news.v2.NewsR\x04news\"G\n" +
+	"\x13AddFavouriteRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x17\n" +
+	"\anews_id\x18\x02 \x01(\x04R\x06newsId\"0\n" +
+	"\x14AddFavouriteResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x8c\x01\n" +
+	"\x14GetFavouritesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1f\n" +
+	"\vunseen_only\x18\x04 \x01(\bR\n" +
+	"unseenOnly\"b\n" +
+	"\x15GetFavouritesResponse\x12!\n" +
+	"\x04news\x18\x01 \x03(\v2\r.news.v2.NewsR\x04news\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"d\n" +
+	"\x19AddToSearchHistoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x18\n" +
+	"\aresults\x18\x03 \x03(\x04R\aresults\"6\n" +
+	"\x1aAddToSearchHistoryResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"n\n" +
+	"\x17GetSearchHistoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\\\n" +
+	"\x18GetSearchHistoryResponse\x12\x18\n" +
+	"\aqueries\x18\x01 \x03(\tR\aqueries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"a\n" +
+	"\x10SubscribeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x18\n" +
+	"\akeyword\x18\x02 \x01(\tR\akeyword\x12\x1a\n" +
+	"\bschedule\x18\x03 \x01(\tR\bschedule\"-\n" +
+	"\x11SubscribeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"n\n" +
+	"\x17GetSubscriptionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\x7f\n" +
+	"\x18GetSubscriptionsResponse\x12;\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2\x15.news.v2.SubscriptionR\rsubscriptions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"m\n" +
+	"\fSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x18\n" +
+	"\akeyword\x18\x03 \x01(\tR\akeyword\x12\x1a\n" +
+	"\bschedule\x18\x04 \x01(\tR\bschedule\"\x9f\x03\n" +
+	"\x11SearchNewsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x1d\n" +
+	"\asources\x18\x03 \x01(\tH\x00R\asources\x88\x01\x01\x12\x1d\n" +
+	"\adomains\x18\x04 \x01(\tH\x01R\adomains\x88\x01\x01\x12.\n" +
+	"\x04from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12-\n" +
+	"\blanguage\x18\a \x01(\x0e2\x11.news.v2.LanguageR\blanguage\x12(\n" +
+	"\asort_by\x18\b \x01(\x0e2\x0f.news.v2.SortByR\x06sortBy\x12 \n" +
+	"\tpage_size\x18\t \x01(\x05H\x02R\bpageSize\x88\x01\x01\x12\x17\n" +
+	"\x04page\x18\n" +
+	" \x01(\x05H\x03R\x04page\x88\x01\x01B\n" +
+	"\n" +
+	"\b_sourcesB\n" +
+	"\n" +
+	"\b_domainsB\f\n" +
+	"\n" +
+	"_page_sizeB\a\n" +
+	"\x05_page\"\\\n" +
+	"\x12SearchNewsResponse\x12!\n" +
+	"\x04news\x18\x01 \x03(\v2\r.news.v2.NewsR\x04news\x12#\n" +
+	"\rtotal_results\x18\x02 \x01(\x05R\ftotalResults\"\xad\x02\n" +
+	"\x16GetTopHeadlinesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1d\n" +
+	"\acountry\x18\x02 \x01(\tH\x00R\acountry\x88\x01\x01\x12-\n" +
+	"\bcategory\x18\x03 \x01(\x0e2\x11.news.v2.CategoryR\bcategory\x12\x1d\n" +
+	"\asources\x18\x04 \x01(\tH\x01R\asources\x88\x01\x01\x12\x19\n" +
+	"\x05query\x18\x05 \x01(\tH\x02R\x05query\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x06 \x01(\x05H\x03R\bpageSize\x88\x01\x01\x12\x17\n" +
+	"\x04page\x18\a \x01(\x05H\x04R\x04page\x88\x01\x01B\n" +
+	"\n" +
+	"\b_countryB\n" +
+	"\n" +
+	"\b_sourcesB\b\n" +
+	"\x06_queryB\f\n" +
+	"\n" +
+	"_page_sizeB\a\n" +
+	"\x05_page\"a\n" +
+	"\x17GetTopHeadlinesResponse\x12!\n" +
+	"\x04news\x18\x01 \x03(\v2\r.news.v2.NewsR\x04news\x12#\n" +
+	"\rtotal_results\x18\x02 \x01(\x05R\ftotalResults\"w\n" +
+	"\x17CheckNewArticlesRequest\x12\x18\n" +
+	"\akeyword\x18\x01 \x01(\tR\akeyword\x12B\n" +
+	"\x0flast_check_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\rlastCheckTime\"\x86\x01\n" +
+	"\x18CheckNewArticlesResponse\x120\n" +
+	"\fnew_articles\x18\x01 \x03(\v2\r.news.v2.NewsR\vnewArticles\x128\n" +
+	"\n" +
+	"user_stats\x18\x02 \x03(\v2\x19.news.v2.UserArticleStatsR\tuserStats\"w\n" +
+	"\x17SendNotificationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12)\n" +
+	"\barticles\x18\x03 \x03(\v2\r.news.v2.NewsR\barticles\"}\n" +
+	"\x10UserArticleStats\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12%\n" +
+	"\x0earticles_count\x18\x02 \x01(\x05R\rarticlesCount\x12)\n" +
+	"\barticles\x18\x03 \x03(\v2\r.news.v2.NewsR\barticles\"m\n" +
+	"\x18SendNotificationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1d\n" +
+	"\n" +
+	"sent_count\x18\x02 \x01(\x05R\tsentCount\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage*j\n" +
+	"\x06SortBy\x12\x17\n" +
+	"\x13SORT_BY_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11SORT_BY_RELEVANCY\x10\x01\x12\x16\n" +
+	"\x12SORT_BY_POPULARITY\x10\x02\x12\x18\n" +
+	"\x14SORT_BY_PUBLISHED_AT\x10\x03*\xc6\x01\n" +
+	"\bCategory\x12\x18\n" +
+	"\x14CATEGORY_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11CATEGORY_BUSINESS\x10\x01\x12\x1a\n" +
+	"\x16CATEGORY_ENTERTAINMENT\x10\x02\x12\x14\n" +
+	"\x10CATEGORY_GENERAL\x10\x03\x12\x13\n" +
+	"\x0fCATEGORY_HEALTH\x10\x04\x12\x14\n" +
+	"\x10CATEGORY_SCIENCE\x10\x05\x12\x13\n" +
+	"\x0fCATEGORY_SPORTS\x10\x06\x12\x17\n" +
+	"\x13CATEGORY_TECHNOLOGY\x10\a*\x92\x02\n" +
+	"\bLanguage\x12\x18\n" +
+	"\x14LANGUAGE_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vLANGUAGE_AR\x10\x01\x12\x0f\n" +
+	"\vLANGUAGE_DE\x10\x02\x12\x0f\n" +
+	"\vLANGUAGE_EN\x10\x03\x12\x0f\n" +
+	"\vLANGUAGE_ES\x10\x04\x12\x0f\n" +
+	"\vLANGUAGE_FR\x10\x05\x12\x0f\n" +
+	"\vLANGUAGE_HE\x10\x06\x12\x0f\n" +
+	"\vLANGUAGE_IT\x10\a\x12\x0f\n" +
+	"\vLANGUAGE_NL\x10\b\x12\x0f\n" +
+	"\vLANGUAGE_NO\x10\t\x12\x0f\n" +
+	"\vLANGUAGE_PT\x10\n" +
+	"\x12\x0f\n" +
+	"\vLANGUAGE_RU\x10\v\x12\x0f\n" +
+	"\vLANGUAGE_SV\x10\f\x12\x0f\n" +
+	"\vLANGUAGE_UD\x10\r\x12\x0f\n" +
+	"\vLANGUAGE_ZH\x10\x0e2\xe6\x05\n" +
+	"\vSaveService\x12G\n" +
+	"\n" +
+	"CreateUser\x12\x1a.news.v2.CreateUserRequest\x1a\x1b.news.v2.CreateUserResponse\"\x00\x12A\n" +
+	"\bSaveNews\x12\x18.news.v2.SaveNewsRequest\x1a\x19.news.v2.SaveNewsResponse\"\x00\x12M\n" +
+	"\fGetNewsByIDs\x12\x1c.news.v2.GetNewsByIDsRequest\x1a\x1d.news.v2.GetNewsByIDsResponse\"\x00\x12M\n" +
+	"\fAddFavourite\x12\x1c.news.v2.AddFavouriteRequest\x1a\x1d.news.v2.AddFavouriteResponse\"\x00\x12P\n" +
+	"\rGetFavourites\x12\x1d.news.v2.GetFavouritesRequest\x1a\x1e.news.v2.GetFavouritesResponse\"\x00\x12_\n" +
+	"\x12AddToSearchHistory\x12\".news.v2.AddToSearchHistoryRequest\x1a#.news.v2.AddToSearchHistoryResponse\"\x00\x12Y\n" +
+	"\x10GetSearchHistory\x12 .news.v2.GetSearchHistoryRequest\x1a!.news.v2.GetSearchHistoryResponse\"\x00\x12D\n" +
+	"\tSubscribe\x12\x19.news.v2.SubscribeRequest\x1a\x1a.news.v2.SubscribeResponse\"\x00\x12Y\n" +
+	"\x10GetSubscriptions\x12 .news.v2.GetSubscriptionsRequest\x1a!.news.v2.GetSubscriptionsResponse\"\x002\x8b\x02\n" +
+	"\rSearchService\x12G\n" +
+	"\n" +
+	"SearchNews\x12\x1a.news.v2.SearchNewsRequest\x1a\x1b.news.v2.SearchNewsResponse\"\x00\x12V\n" +
+	"\x0fGetTopHeadlines\x12\x1f.news.v2.GetTopHeadlinesRequest\x1a .news.v2.GetTopHeadlinesResponse\"\x00\x12Y\n" +
+	"\x10CheckNewArticles\x12 .news.v2.CheckNewArticlesRequest\x1a!.news.v2.CheckNewArticlesResponse\"\x002p\n" +
+	"\x13NotificationService\x12Y\n" +
+	"\x10SendNotification\x12 .news.v2.SendNotificationRequest\x1a!.news.v2.SendNotificationResponse\"\x00B\x1aZ\x18gonews/protos/pb/v2;pbv2b\x06proto3"
+
+var (
+	file_v2_news_service_proto_rawDescOnce sync.Once
+	file_v2_news_service_proto_rawDescData []byte
+)
+
+func file_v2_news_service_proto_rawDescGZIP() []byte {
+	file_v2_news_service_proto_rawDescOnce.Do(func() {
+		file_v2_news_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v2_news_service_proto_rawDesc), len(file_v2_news_service_proto_rawDesc)))
+	})
+	return file_v2_news_service_proto_rawDescData
+}
+
+var file_v2_news_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_v2_news_service_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_v2_news_service_proto_goTypes = []any{
+	(SortBy)(0),                        // 0: news.v2.SortBy
+	(Category)(0),                      // 1: news.v2.Category
+	(Language)(0),                      // 2: news.v2.Language
+	(*News)(nil),                       // 3: news.v2.News
+	(*CreateUserRequest)(nil),          // 4: news.v2.CreateUserRequest
+	(*CreateUserResponse)(nil),         // 5: news.v2.CreateUserResponse
+	(*SaveNewsRequest)(nil),            // 6: news.v2.SaveNewsRequest
+	(*SaveNewsResponse)(nil),           // 7: news.v2.SaveNewsResponse
+	(*GetNewsByIDsRequest)(nil),        // 8: news.v2.GetNewsByIDsRequest
+	(*GetNewsByIDsResponse)(nil),       // 9: news.v2.GetNewsByIDsResponse
+	(*AddFavouriteRequest)(nil),        // 10: news.v2.AddFavouriteRequest
+	(*AddFavouriteResponse)(nil),       // 11: news.v2.AddFavouriteResponse
+	(*GetFavouritesRequest)(nil),       // 12: news.v2.GetFavouritesRequest
+	(*GetFavouritesResponse)(nil),      // 13: news.v2.GetFavouritesResponse
+	(*AddToSearchHistoryRequest)(nil),  // 14: news.v2.AddToSearchHistoryRequest
+	(*AddToSearchHistoryResponse)(nil), // 15: news.v2.AddToSearchHistoryResponse
+	(*GetSearchHistoryRequest)(nil),    // 16: news.v2.GetSearchHistoryRequest
+	(*GetSearchHistoryResponse)(nil),   // 17: news.v2.GetSearchHistoryResponse
+	(*SubscribeRequest)(nil),           // 18: news.v2.SubscribeRequest
+	(*SubscribeResponse)(nil),          // 19: news.v2.SubscribeResponse
+	(*GetSubscriptionsRequest)(nil),    // 20: news.v2.GetSubscriptionsRequest
+	(*GetSubscriptionsResponse)(nil),   // 21: news.v2.GetSubscriptionsResponse
+	(*Subscription)(nil),               // 22: news.v2.Subscription
+	(*SearchNewsRequest)(nil),          // 23: news.v2.SearchNewsRequest
+	(*SearchNewsResponse)(nil),         // 24: news.v2.SearchNewsResponse
+	(*GetTopHeadlinesRequest)(nil),     // 25: news.v2.GetTopHeadlinesRequest
+	(*GetTopHeadlinesResponse)(nil),    // 26: news.v2.GetTopHeadlinesResponse
+	(*CheckNewArticlesRequest)(nil),    // 27: news.v2.CheckNewArticlesRequest
+	(*CheckNewArticlesResponse)(nil),   // 28: news.v2.CheckNewArticlesResponse
+	(*SendNotificationRequest)(nil),    // 29: news.v2.SendNotificationRequest
+	(*UserArticleStats)(nil),           // 30: news.v2.UserArticleStats
+	(*SendNotificationResponse)(nil),   // 31: news.v2.SendNotificationResponse
+	(*timestamppb.Timestamp)(nil),      // 32: google.protobuf.Timestamp
+}
+var file_v2_news_service_proto_depIdxs = []int32{
+	32, // 0: news.v2.News.published_at:type_name -> google.protobuf.Timestamp
+	3,  // 1: news.v2.SaveNewsRequest.news:type_name -> news.v2.News
+	3,  // 2: news.v2.GetNewsByIDsResponse.news:type_name -> news.v2.News
+	3,  // 3: news.v2.GetFavouritesResponse.news:type_name -> news.v2.News
+	22, // 4: news.v2.GetSubscriptionsResponse.subscriptions:type_name -> news.v2.Subscription
+	32, // 5: news.v2.SearchNewsRequest.from:type_name -> google.protobuf.Timestamp
+	32, // 6: news.v2.SearchNewsRequest.to:type_name -> google.protobuf.Timestamp
+	2,  // 7: news.v2.SearchNewsRequest.language:type_name -> news.v2.Language
+	0,  // 8: news.v2.SearchNewsRequest.sort_by:type_name -> news.v2.SortBy
+	3,  // 9: news.v2.SearchNewsResponse.news:type_name -> news.v2.News
+	1,  // 10: news.v2.GetTopHeadlinesRequest.category:type_name -> news.v2.Category
+	3,  // 11: news.v2.GetTopHeadlinesResponse.news:type_name -> news.v2.News
+	32, // 12: news.v2.CheckNewArticlesRequest.last_check_time:type_name -> google.protobuf.Timestamp
+	3,  // 13: news.v2.CheckNewArticlesResponse.new_articles:type_name -> news.v2.News
+	30, // 14: news.v2.CheckNewArticlesResponse.user_stats:type_name -> news.v2.UserArticleStats
+	3,  // 15: news.v2.SendNotificationRequest.articles:type_name -> news.v2.News
+	3,  // 16: news.v2.UserArticleStats.articles:type_name -> news.v2.News
+	4,  // 17: news.v2.SaveService.CreateUser:input_type -> news.v2.CreateUserRequest
+	6,  // 18: news.v2.SaveService.SaveNews:input_type -> news.v2.SaveNewsRequest
+	8,  // 19: news.v2.SaveService.GetNewsByIDs:input_type -> news.v2.GetNewsByIDsRequest
+	10, // 20: news.v2.SaveService.AddFavourite:input_type -> news.v2.AddFavouriteRequest
+	12, // 21: news.v2.SaveService.GetFavourites:input_type -> news.v2.GetFavouritesRequest
+	14, // 22: news.v2.SaveService.AddToSearchHistory:input_type -> news.v2.AddToSearchHistoryRequest
+	16, // 23: news.v2.SaveService.GetSearchHistory:input_type -> news.v2.GetSearchHistoryRequest
+	18, // 24: news.v2.SaveService.Subscribe:input_type -> news.v2.SubscribeRequest
+	20, // 25: news.v2.SaveService.GetSubscriptions:input_type -> news.v2.GetSubscriptionsRequest
+	23, // 26: news.v2.SearchService.SearchNews:input_type -> news.v2.SearchNewsRequest
+	25, // 27: news.v2.SearchService.GetTopHeadlines:input_type -> news.v2.GetTopHeadlinesRequest
+	27, // 28: news.v2.SearchService.CheckNewArticles:input_type -> news.v2.CheckNewArticlesRequest
+	29, // 29: news.v2.NotificationService.SendNotification:input_type -> news.v2.SendNotificationRequest
+	5,  // 30: news.v2.SaveService.CreateUser:output_type -> news.v2.CreateUserResponse
+	7,  // 31: news.v2.SaveService.SaveNews:output_type -> news.v2.SaveNewsResponse
+	9,  // 32: news.v2.SaveService.GetNewsByIDs:output_type -> news.v2.GetNewsByIDsResponse
+	11, // 33: news.v2.SaveService.AddFavourite:output_type -> news.v2.AddFavouriteResponse
+	13, // 34: news.v2.SaveService.GetFavourites:output_type -> news.v2.GetFavouritesResponse
+	15, // 35: news.v2.SaveService.AddToSearchHistory:output_type -> news.v2.AddToSearchHistoryResponse
+	17, // 36: news.v2.SaveService.GetSearchHistory:output_type -> news.v2.GetSearchHistoryResponse
+	19, // 37: news.v2.SaveService.Subscribe:output_type -> news.v2.SubscribeResponse
+	21, // 38: news.v2.SaveService.GetSubscriptions:output_type -> news.v2.GetSubscriptionsResponse
+	24, // 39: news.v2.SearchService.SearchNews:output_type -> news.v2.SearchNewsResponse
+	26, // 40: news.v2.SearchService.GetTopHeadlines:output_type -> news.v2.GetTopHeadlinesResponse
+	28, // 41: news.v2.SearchService.CheckNewArticles:output_type -> news.v2.CheckNewArticlesResponse
+	31, // 42: news.v2.NotificationService.SendNotification:output_type -> news.v2.SendNotificationResponse
+	30, // [30:43] is the sub-list for method output_type
+	17, // [17:30] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
+}
+
+func init() { file_v2_news_service_proto_init() }
+func file_v2_news_service_proto_init() {
+	if File_v2_news_service_proto != nil {
+		return
+	}
+	file_v2_news_service_proto_msgTypes[20].OneofWrappers = []any{}
+	file_v2_news_service_proto_msgTypes[22].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v2_news_service_proto_rawDesc), len(file_v2_news_service_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   29,
+			NumExtensions: 0,
+			NumServices:   3,
+		},
+		GoTypes:           file_v2_news_service_proto_goTypes,
+		DependencyIndexes: file_v2_news_service_proto_depIdxs,
+		EnumInfos:         file_v2_news_service_proto_enumTypes,
+		MessageInfos:      file_v2_news_service_proto_msgTypes,
+	}.Build()
+	File_v2_news_service_proto = out.File
+	file_v2_news_service_proto_goTypes = nil
+	file_v2_news_service_proto_depIdxs = nil
+}
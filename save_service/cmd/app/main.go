@@ -5,21 +5,23 @@ import (
 	"gonews/save_service/config"
 	"gonews/save_service/internal/bootstrap"
 	"os"
+	_ "time/tzdata" // часовые пояса профилей не зависят от образа
 )
 
 func main() {
-	path := os.Getenv("configPath")
-	if path == "" {
-		path = "/config/config.yaml"
-	}
-	cfg, err := config.LoadConfig(path)
+	cfg, err := config.LoadConfig(os.Args[1:])
 	if err != nil {
 		panic(fmt.Sprintf("config load error: %v", err))
 	}
 
+	bootstrap.InitLogging(cfg)
+	bootstrap.InitConfigReload(cfg, os.Args[1:])
+	shutdownTracing := bootstrap.InitTracing(cfg)
+	bootstrap.InitMetrics(cfg)
 	storage := bootstrap.InitPGStorage(cfg)
 	saveService := bootstrap.InitSaveService(storage, cfg)
-	grpcServer := bootstrap.InitGRPCServer(saveService, cfg)
+	healthServer := bootstrap.InitHealthServer(storage, cfg)
+	grpcServer := bootstrap.InitGRPCServer(saveService, healthServer, cfg)
 
-	bootstrap.AppRun(grpcServer, cfg)
+	bootstrap.AppRun(grpcServer, shutdownTracing, cfg)
 }
@@ -1,45 +1,78 @@
 package config
 
 import (
+	"context"
 	"fmt"
-	"os"
-
-	"gopkg.in/yaml.v3"
+	"gonews/pkg/configloader"
+	"gonews/pkg/logging"
+	"gonews/pkg/tlsconfig"
+	"gonews/pkg/tracing"
 )
 
 type DatabaseConfig struct {
-	Host     string `yaml:"host"`
-	Port     int    `yaml:"port"`
-	Username string `yaml:"username"`
-	Password string `yaml:"password"`
-	DBName   string `yaml:"name"`
-	SSLMode  string `yaml:"ssl_mode"`
+	Host     string `yaml:"host" default:"postgres" validate:"required"`
+	Port     int    `yaml:"port" default:"5432" validate:"port"`
+	Username string `yaml:"username" validate:"required"`
+	Password string `yaml:"password" secret:"true"`
+	DBName   string `yaml:"name" validate:"required"`
+	SSLMode  string `yaml:"ssl_mode" default:"disable" validate:"oneof=disable allow prefer require verify-ca verify-full"`
 }
 
 type GRPCConfig struct {
-	Port int `yaml:"port"`
+	Port int `yaml:"port" default:"50051" validate:"port"`
+	// DefaultDeadlineSeconds - дедлайн вызовов, пришедших без дедлайна клиента
+	DefaultDeadlineSeconds int `yaml:"default_deadline_seconds" default:"10" validate:"min=1"`
 }
 
-type Config struct {
-	Database DatabaseConfig `yaml:"database"`
-	GRPC     GRPCConfig     `yaml:"grpc"`
+type PaginationConfig struct {
+	DefaultPageSize int `yaml:"default_page_size" default:"20" validate:"min=1"`
+	MaxPageSize     int `yaml:"max_page_size" default:"100" validate:"min=1"`
 }
 
-func LoadConfig(filename string) (*Config, error) {
-	if filename == "" {
-		filename = "config/config.yaml"
+// Validate - размер страницы по умолчанию не больше максимального
+func (c PaginationConfig) Validate() error {
+	if c.DefaultPageSize > c.MaxPageSize {
+		return fmt.Errorf("default_page_size: must not exceed max_page_size %d, got %d", c.MaxPageSize, c.DefaultPageSize)
 	}
+	return nil
+}
 
-	data, err := os.ReadFile(filename)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read config file: %w", err)
-	}
+type HealthConfig struct {
+	IntervalSeconds int `yaml:"interval_seconds" default:"10" validate:"min=1"`
+	TimeoutSeconds  int `yaml:"timeout_seconds" default:"2" validate:"min=1"`
+}
+
+type MetricsConfig struct {
+	Port int `yaml:"port" default:"9101" validate:"port"`
+}
 
+type Config struct {
+	Database   DatabaseConfig   `yaml:"database"`
+	GRPC       GRPCConfig       `yaml:"grpc"`
+	Pagination PaginationConfig `yaml:"pagination"`
+	Health     HealthConfig     `yaml:"health"`
+	Metrics    MetricsConfig    `yaml:"metrics"`
+	Logging    logging.Config   `yaml:"logging"`
+	Tracing    tracing.Config   `yaml:"tracing"`
+	TLS        tlsconfig.Config `yaml:"tls"`
+}
+
+func options(args []string) configloader.Options {
+	return configloader.Options{DefaultPath: "/config/config.yaml", Args: args}
+}
+
+// LoadConfig - значения по умолчанию, YAML, переменные окружения (GONEWS_*) и флаги из args
+func LoadConfig(args []string) (*Config, error) {
 	var config Config
-	err = yaml.Unmarshal(data, &config)
-	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal yaml: %w", err)
+	if err := configloader.Load(&config, options(args)); err != nil {
+		return nil, err
 	}
 
 	return &config, nil
 }
+
+// WatchConfig - по SIGHUP и при изменении файла передаёт в apply конфиг с новыми значениями
+// полей reload:"true"; остальные поля применяются только после перезапуска
+func WatchConfig(ctx context.Context, current *Config, args []string, apply func(*Config)) {
+	configloader.Watch(ctx, current, options(args), apply)
+}
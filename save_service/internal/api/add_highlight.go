@@ -0,0 +1,22 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+	"gonews/save_service/internal/models"
+)
+
+func (s *GRPCServer) AddHighlight(ctx context.Context, req *pb.AddHighlightRequest) (*pb.HighlightResponse, error) {
+	highlight, err := s.saveService.AddHighlight(ctx, &models.Highlight{
+		UserID:      req.UserId,
+		NewsID:      req.NewsId,
+		StartOffset: int(req.StartOffset),
+		EndOffset:   int(req.EndOffset),
+		Comment:     req.Comment,
+	})
+	if err != nil {
+		return nil, storageError(err, annotationErrors)
+	}
+
+	return &pb.HighlightResponse{Highlight: highlightToProto(highlight)}, nil
+}
@@ -0,0 +1,15 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+)
+
+func (s *GRPCServer) AddToCollection(ctx context.Context, req *pb.AddToCollectionRequest) (*pb.AddToCollectionResponse, error) {
+	err := s.saveService.AddToCollection(ctx, req.UserId, req.CollectionId, req.NewsId)
+	if err != nil {
+		return nil, storageError(err, collectionErrors)
+	}
+
+	return &pb.AddToCollectionResponse{Success: true}, nil
+}
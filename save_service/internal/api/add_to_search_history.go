@@ -2,21 +2,58 @@ package api
 
 import (
 	"context"
+	"errors"
 	"gonews/protos/pb"
+	"gonews/save_service/internal/models"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 func (s *GRPCServer) AddToSearchHistory(ctx context.Context, req *pb.AddToSearchHistoryRequest) (*pb.AddToSearchHistoryResponse, error) {
-	if req.UserId == 0 || req.Query == "" {
-		return nil, status.Error(codes.InvalidArgument, "user_id and query are required")
+	err := s.saveService.AddToSearchHistory(ctx, &models.SearchHistoryEntry{
+		UserID:       req.UserId,
+		Query:        req.Query,
+		Filters:      filtersFromProto(req.Filters),
+		TotalResults: int(req.TotalResults),
+		ResultIDs:    req.Results,
+	})
+	if errors.Is(err, models.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "user not found")
 	}
-
-	err := s.saveService.AddToSearchHistory(ctx, req.UserId, req.Query, req.Results)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	return &pb.AddToSearchHistoryResponse{Success: true}, nil
 }
+
+func filtersFromProto(f *pb.SearchFilters) models.SearchFilters {
+	if f == nil {
+		return models.SearchFilters{}
+	}
+
+	return models.SearchFilters{
+		Sources:  f.Sources,
+		Domains:  f.Domains,
+		From:     f.From,
+		To:       f.To,
+		Language: f.Language,
+		SortBy:   f.SortBy,
+		PageSize: f.PageSize,
+		Page:     f.Page,
+	}
+}
+
+func filtersToProto(f models.SearchFilters) *pb.SearchFilters {
+	return &pb.SearchFilters{
+		Sources:  f.Sources,
+		Domains:  f.Domains,
+		From:     f.From,
+		To:       f.To,
+		Language: f.Language,
+		SortBy:   f.SortBy,
+		PageSize: f.PageSize,
+		Page:     f.Page,
+	}
+}
@@ -0,0 +1,23 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AuthenticateAPIKey - проверка ключа из заголовка X-API-Key для gateway
+func (s *GRPCServer) AuthenticateAPIKey(ctx context.Context, req *pb.AuthenticateAPIKeyRequest) (*pb.APIKeyResponse, error) {
+	if req.Key == "" {
+		return nil, status.Error(codes.Unauthenticated, "API key is required")
+	}
+
+	key, err := s.saveService.AuthenticateAPIKey(ctx, req.Key)
+	if err != nil {
+		return nil, storageError(err, apiKeyErrors)
+	}
+
+	return &pb.APIKeyResponse{ApiKey: apiKeyToProto(key)}, nil
+}
@@ -0,0 +1,15 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+)
+
+func (s *GRPCServer) CopyCollectionItems(ctx context.Context, req *pb.CopyCollectionItemsRequest) (*pb.CopyCollectionItemsResponse, error) {
+	err := s.saveService.CopyCollectionItems(ctx, req.UserId, req.FromCollectionId, req.ToCollectionId, req.NewsIds, req.Move)
+	if err != nil {
+		return nil, storageError(err, collectionErrors)
+	}
+
+	return &pb.CopyCollectionItemsResponse{Success: true}, nil
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+	"gonews/save_service/internal/models"
+	"time"
+)
+
+func (s *GRPCServer) CreateAPIKey(ctx context.Context, req *pb.CreateAPIKeyRequest) (*pb.APIKeySecretResponse, error) {
+	key, secret, err := s.saveService.CreateAPIKey(ctx, req.Name, req.Scopes, req.UserId)
+	if err != nil {
+		return nil, storageError(err, apiKeyErrors)
+	}
+
+	return &pb.APIKeySecretResponse{ApiKey: apiKeyToProto(key), Key: secret}, nil
+}
+
+func apiKeyToProto(key *models.APIKey) *pb.APIKey {
+	return &pb.APIKey{
+		Id:         key.ID,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Scopes:     key.Scopes,
+		CreatedAt:  key.CreatedAt.Format(time.RFC3339),
+		RotatedAt:  formatOptionalTime(key.RotatedAt),
+		LastUsedAt: formatOptionalTime(key.LastUsedAt),
+		RevokedAt:  formatOptionalTime(key.RevokedAt),
+		UserId:     optionalID(key.UserID),
+	}
+}
+
+// optionalID - 0, если id нет
+func optionalID(id *uint64) uint64 {
+	if id == nil {
+		return 0
+	}
+	return *id
+}
+
+// formatOptionalTime - RFC 3339 или пустая строка, если времени нет
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,29 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+	"gonews/save_service/internal/models"
+	"time"
+)
+
+func (s *GRPCServer) CreateCollection(ctx context.Context, req *pb.CreateCollectionRequest) (*pb.CollectionResponse, error) {
+	collection, err := s.saveService.CreateCollection(ctx, req.UserId, req.Name)
+	if err != nil {
+		return nil, storageError(err, collectionErrors)
+	}
+
+	return &pb.CollectionResponse{Collection: collectionToProto(collection)}, nil
+}
+
+func collectionToProto(collection *models.Collection) *pb.Collection {
+	return &pb.Collection{
+		Id:         collection.ID,
+		UserId:     collection.UserID,
+		Name:       collection.Name,
+		IsDefault:  collection.IsDefault,
+		ShareToken: collection.ShareToken,
+		ItemCount:  int32(collection.ItemCount),
+		CreatedAt:  collection.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,45 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+	"gonews/save_service/internal/models"
+	"time"
+)
+
+func (s *GRPCServer) CreateSavedSearch(ctx context.Context, req *pb.CreateSavedSearchRequest) (*pb.SavedSearchResponse, error) {
+	savedSearch, err := s.saveService.CreateSavedSearch(ctx, &models.SavedSearch{
+		UserID:                 req.UserId,
+		Name:                   req.Name,
+		Query:                  req.Query,
+		Filters:                filtersFromProto(req.Filters),
+		RefreshIntervalMinutes: int(req.RefreshIntervalMinutes),
+		Notify:                 req.Notify,
+	})
+	if err != nil {
+		return nil, storageError(err, savedSearchErrors)
+	}
+
+	return &pb.SavedSearchResponse{SavedSearch: savedSearchToProto(savedSearch)}, nil
+}
+
+func savedSearchToProto(savedSearch *models.SavedSearch) *pb.SavedSearch {
+	var lastRunAt string
+	if savedSearch.LastRunAt != nil {
+		lastRunAt = savedSearch.LastRunAt.Format(time.RFC3339)
+	}
+
+	return &pb.SavedSearch{
+		Id:                     savedSearch.ID,
+		UserId:                 savedSearch.UserID,
+		Name:                   savedSearch.Name,
+		Query:                  savedSearch.Query,
+		Filters:                filtersToProto(savedSearch.Filters),
+		RefreshIntervalMinutes: int32(savedSearch.RefreshIntervalMinutes),
+		Notify:                 savedSearch.Notify,
+		LastRunAt:              lastRunAt,
+		LastResultIds:          savedSearch.LastResultIDs,
+		NewResultIds:           savedSearch.NewResultIDs,
+		CreatedAt:              savedSearch.CreatedAt.Format(time.RFC3339),
+	}
+}
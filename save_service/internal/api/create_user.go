@@ -3,20 +3,38 @@ package api
 import (
 	"context"
 	"gonews/protos/pb"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"gonews/save_service/internal/models"
+	"time"
 )
 
 func (s *GRPCServer) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.CreateUserResponse, error) {
-	if req.Name == "" {
-		return nil, status.Error(codes.InvalidArgument, "name is required")
-	}
-
-	userID, err := s.saveService.CreateUser(ctx, req.Name)
+	user, err := s.saveService.CreateUser(ctx, &models.User{
+		Handle:         req.Handle,
+		Email:          req.Email,
+		DisplayName:    req.Name,
+		Language:       req.Language,
+		Country:        req.Country,
+		Timezone:       req.Timezone,
+		DefaultFilters: filtersFromProto(req.DefaultFilters),
+	})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, storageError(err, userErrors)
 	}
 
-	return &pb.CreateUserResponse{UserId: userID}, nil
+	return &pb.CreateUserResponse{UserId: user.ID, User: userToProto(user)}, nil
+}
+
+func userToProto(user *models.User) *pb.User {
+	return &pb.User{
+		Id:             user.ID,
+		Handle:         user.Handle,
+		Email:          user.Email,
+		DisplayName:    user.DisplayName,
+		Language:       user.Language,
+		Country:        user.Country,
+		Timezone:       user.Timezone,
+		DefaultFilters: filtersToProto(user.DefaultFilters),
+		CreatedAt:      user.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      user.UpdatedAt.Format(time.RFC3339),
+	}
 }
@@ -0,0 +1,15 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+)
+
+func (s *GRPCServer) DeleteCollection(ctx context.Context, req *pb.DeleteCollectionRequest) (*pb.DeleteCollectionResponse, error) {
+	err := s.saveService.DeleteCollection(ctx, req.UserId, req.CollectionId)
+	if err != nil {
+		return nil, storageError(err, collectionErrors)
+	}
+
+	return &pb.DeleteCollectionResponse{Success: true}, nil
+}
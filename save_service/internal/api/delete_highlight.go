@@ -0,0 +1,15 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+)
+
+func (s *GRPCServer) DeleteHighlight(ctx context.Context, req *pb.DeleteHighlightRequest) (*pb.DeleteHighlightResponse, error) {
+	err := s.saveService.DeleteHighlight(ctx, req.UserId, req.NewsId, req.HighlightId)
+	if err != nil {
+		return nil, storageError(err, annotationErrors)
+	}
+
+	return &pb.DeleteHighlightResponse{Success: true}, nil
+}
@@ -0,0 +1,15 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+)
+
+func (s *GRPCServer) DeleteSavedSearch(ctx context.Context, req *pb.DeleteSavedSearchRequest) (*pb.DeleteSavedSearchResponse, error) {
+	err := s.saveService.DeleteSavedSearch(ctx, req.UserId, req.Id)
+	if err != nil {
+		return nil, storageError(err, savedSearchErrors)
+	}
+
+	return &pb.DeleteSavedSearchResponse{Success: true}, nil
+}
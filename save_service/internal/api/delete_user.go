@@ -0,0 +1,23 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"gonews/protos/pb"
+	"gonews/save_service/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error) {
+	err := s.saveService.DeleteUser(ctx, req.UserId)
+	if errors.Is(err, models.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.DeleteUserResponse{Success: true}, nil
+}
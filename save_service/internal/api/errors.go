@@ -0,0 +1,49 @@
+package api
+
+import (
+	"errors"
+	"gonews/save_service/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// resourceErrors - сообщения клиенту об отсутствующем и уже существующем ресурсе
+type resourceErrors struct {
+	notFound string
+	conflict string
+}
+
+var (
+	// pageErrors - списки, где ошибкой клиента бывает только курсор
+	pageErrors         = resourceErrors{notFound: "not found", conflict: "already exists"}
+	savedSearchErrors  = resourceErrors{notFound: "saved search not found", conflict: "saved search with this name already exists"}
+	collectionErrors   = resourceErrors{notFound: "collection or news not found", conflict: "collection with this name already exists"}
+	annotationErrors   = resourceErrors{notFound: "article is not saved by user", conflict: "annotation already exists"}
+	userErrors         = resourceErrors{notFound: "user not found", conflict: "handle or email is already taken"}
+	subscriptionErrors = resourceErrors{notFound: "user or subscription not found", conflict: "already subscribed to this keyword"}
+	apiKeyErrors       = resourceErrors{notFound: "API key or its user not found, or the key is revoked", conflict: "active API key with this name already exists"}
+)
+
+// storageError - ошибка хранилища в статус gRPC: ошибки клиента сопоставляются в одном месте,
+// сообщения об отсутствии и конфликте берутся из res, остальное - внутренняя ошибка
+func storageError(err error, res resourceErrors) error {
+	switch {
+	case errors.Is(err, models.ErrNotFound):
+		return status.Error(codes.NotFound, res.notFound)
+	case errors.Is(err, models.ErrAlreadyExists):
+		return status.Error(codes.AlreadyExists, res.conflict)
+	case errors.Is(err, models.ErrInvalidPageToken):
+		return status.Error(codes.InvalidArgument, models.ErrInvalidPageToken.Error())
+	case errors.Is(err, models.ErrDefaultCollection):
+		return status.Error(codes.FailedPrecondition, models.ErrDefaultCollection.Error())
+	case errors.Is(err, models.ErrInvalidAPIKey):
+		return status.Error(codes.Unauthenticated, models.ErrInvalidAPIKey.Error())
+	case errors.Is(err, models.ErrInvalidHighlight),
+		errors.Is(err, models.ErrInvalidProfile),
+		errors.Is(err, models.ErrInvalidSchedule),
+		errors.Is(err, models.ErrInvalidAPIKeySettings):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
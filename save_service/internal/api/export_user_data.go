@@ -0,0 +1,32 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"gonews/protos/pb"
+	"gonews/save_service/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) ExportUserData(ctx context.Context, req *pb.ExportUserDataRequest) (*pb.ExportUserDataResponse, error) {
+	format := req.Format
+	if format == "" {
+		format = models.ExportFormatJSON
+	}
+
+	file, err := s.saveService.ExportUserData(ctx, req.UserId, format)
+	if errors.Is(err, models.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.ExportUserDataResponse{
+		Data:        file.Data,
+		ContentType: file.ContentType,
+		Filename:    file.Filename,
+	}, nil
+}
@@ -0,0 +1,42 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+	"gonews/save_service/internal/models"
+	"time"
+)
+
+func (s *GRPCServer) GetCollectionItems(ctx context.Context, req *pb.GetCollectionItemsRequest) (*pb.CollectionItemsResponse, error) {
+	collection, news, nextPageToken, err := s.saveService.GetCollectionItems(ctx, req.UserId, req.CollectionId, models.PageRequest{
+		Token: req.PageToken,
+		Size:  int(req.PageSize),
+	})
+	if err != nil {
+		return nil, storageError(err, collectionErrors)
+	}
+
+	return &pb.CollectionItemsResponse{
+		Collection:    collectionToProto(collection),
+		News:          newsListToProto(news),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func newsListToProto(news []*models.News) []*pb.News {
+	protoNews := make([]*pb.News, len(news))
+	for i, n := range news {
+		protoNews[i] = &pb.News{
+			Id:          n.ID,
+			Source:      n.Source,
+			Author:      n.Author,
+			Title:       n.Title,
+			Description: n.Description,
+			Url:         n.URL,
+			ImageUrl:    n.ImageURL,
+			PublishedAt: n.PublishedAt.Format(time.RFC3339),
+			Content:     n.Content,
+		}
+	}
+	return protoNews
+}
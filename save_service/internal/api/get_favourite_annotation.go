@@ -0,0 +1,43 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+	"gonews/save_service/internal/models"
+	"time"
+)
+
+func (s *GRPCServer) GetFavouriteAnnotation(ctx context.Context, req *pb.GetFavouriteAnnotationRequest) (*pb.FavouriteAnnotationResponse, error) {
+	annotation, err := s.saveService.GetFavouriteAnnotation(ctx, req.UserId, req.NewsId)
+	if err != nil {
+		return nil, storageError(err, annotationErrors)
+	}
+
+	return &pb.FavouriteAnnotationResponse{Annotation: annotationToProto(annotation)}, nil
+}
+
+func annotationToProto(annotation *models.FavouriteAnnotation) *pb.FavouriteAnnotation {
+	highlights := make([]*pb.Highlight, len(annotation.Highlights))
+	for i, h := range annotation.Highlights {
+		highlights[i] = highlightToProto(h)
+	}
+
+	return &pb.FavouriteAnnotation{
+		NewsId:     annotation.NewsID,
+		Tags:       annotation.Tags,
+		Note:       annotation.Note,
+		Highlights: highlights,
+	}
+}
+
+func highlightToProto(h *models.Highlight) *pb.Highlight {
+	return &pb.Highlight{
+		Id:          h.ID,
+		NewsId:      h.NewsID,
+		StartOffset: int32(h.StartOffset),
+		EndOffset:   int32(h.EndOffset),
+		Text:        h.Text,
+		Comment:     h.Comment,
+		CreatedAt:   h.CreatedAt.Format(time.RFC3339),
+	}
+}
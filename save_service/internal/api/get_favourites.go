@@ -3,19 +3,16 @@ package api
 import (
 	"context"
 	"gonews/protos/pb"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"gonews/save_service/internal/models"
 )
 
 func (s *GRPCServer) GetFavourites(ctx context.Context, req *pb.GetFavouritesRequest) (*pb.GetFavouritesResponse, error) {
-	if req.UserId == 0 {
-		return nil, status.Error(codes.InvalidArgument, "user_id is required")
-	}
-
-	news, err := s.saveService.GetFavourites(ctx, req.UserId)
+	news, nextPageToken, err := s.saveService.GetFavourites(ctx, req.UserId, models.PageRequest{
+		Token: req.PageToken,
+		Size:  int(req.PageSize),
+	}, req.UnseenOnly)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, storageError(err, pageErrors)
 	}
 
 	protoNews := make([]*pb.News, len(news))
@@ -32,5 +29,5 @@ func (s *GRPCServer) GetFavourites(ctx context.Context, req *pb.GetFavouritesReq
 		}
 	}
 
-	return &pb.GetFavouritesResponse{News: protoNews}, nil
+	return &pb.GetFavouritesResponse{News: protoNews, NextPageToken: nextPageToken}, nil
 }
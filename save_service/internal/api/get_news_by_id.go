@@ -10,10 +10,6 @@ import (
 )
 
 func (s *GRPCServer) GetNewsByIDs(ctx context.Context, req *pb.GetNewsByIDsRequest) (*pb.GetNewsByIDsResponse, error) {
-	if len(req.Ids) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "no ids provided")
-	}
-
 	news, err := s.saveService.GetNewsByIDs(ctx, req.Ids)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -30,6 +26,7 @@ func (s *GRPCServer) GetNewsByIDs(ctx context.Context, req *pb.GetNewsByIDsReque
 			Url:         n.URL,
 			ImageUrl:    n.ImageURL,
 			PublishedAt: n.PublishedAt.Format(time.RFC3339),
+			Content:     n.Content,
 		}
 	}
 
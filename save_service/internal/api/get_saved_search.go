@@ -0,0 +1,15 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+)
+
+func (s *GRPCServer) GetSavedSearch(ctx context.Context, req *pb.GetSavedSearchRequest) (*pb.SavedSearchResponse, error) {
+	savedSearch, err := s.saveService.GetSavedSearch(ctx, req.UserId, req.Id)
+	if err != nil {
+		return nil, storageError(err, savedSearchErrors)
+	}
+
+	return &pb.SavedSearchResponse{SavedSearch: savedSearchToProto(savedSearch)}, nil
+}
@@ -3,20 +3,41 @@ package api
 import (
 	"context"
 	"gonews/protos/pb"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"gonews/save_service/internal/models"
+	"time"
 )
 
 func (s *GRPCServer) GetSearchHistory(ctx context.Context, req *pb.GetSearchHistoryRequest) (*pb.GetSearchHistoryResponse, error) {
-	if req.UserId == 0 {
-		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	entries, nextPageToken, err := s.saveService.GetSearchHistory(ctx, req.UserId, models.PageRequest{
+		Token: req.PageToken,
+		Size:  int(req.PageSize),
+	}, req.UniqueQueries)
+	if err != nil {
+		return nil, storageError(err, pageErrors)
 	}
 
-	queries, err := s.saveService.GetSearchHistory(ctx, req.UserId)
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+	queries := make([]string, len(entries))
+	protoEntries := make([]*pb.SearchHistoryEntry, len(entries))
+	for i, entry := range entries {
+		queries[i] = entry.Query
+		protoEntries[i] = historyEntryToProto(entry)
 	}
 
-	return &pb.GetSearchHistoryResponse{Queries: queries}, nil
+	return &pb.GetSearchHistoryResponse{
+		Queries:       queries,
+		NextPageToken: nextPageToken,
+		Entries:       protoEntries,
+	}, nil
+}
+
+func historyEntryToProto(entry *models.SearchHistoryEntry) *pb.SearchHistoryEntry {
+	return &pb.SearchHistoryEntry{
+		Id:           entry.ID,
+		Query:        entry.Query,
+		SearchedAt:   entry.SearchedAt.Format(time.RFC3339),
+		Filters:      filtersToProto(entry.Filters),
+		TotalResults: int32(entry.TotalResults),
+		ResultIds:    entry.ResultIDs,
+		Occurrences:  int32(entry.Occurrences),
+	}
 }
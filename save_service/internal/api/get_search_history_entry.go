@@ -0,0 +1,23 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"gonews/protos/pb"
+	"gonews/save_service/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) GetSearchHistoryEntry(ctx context.Context, req *pb.GetSearchHistoryEntryRequest) (*pb.GetSearchHistoryEntryResponse, error) {
+	entry, err := s.saveService.GetSearchHistoryEntry(ctx, req.UserId, req.SearchId)
+	if errors.Is(err, models.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "search history entry not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.GetSearchHistoryEntryResponse{Entry: historyEntryToProto(entry)}, nil
+}
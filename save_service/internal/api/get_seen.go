@@ -0,0 +1,28 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+	"gonews/save_service/internal/models"
+	"time"
+)
+
+func (s *GRPCServer) GetSeen(ctx context.Context, req *pb.GetSeenRequest) (*pb.GetSeenResponse, error) {
+	seen, nextPageToken, err := s.saveService.GetSeenNews(ctx, req.UserId, req.NewsIds, models.PageRequest{
+		Token: req.PageToken,
+		Size:  int(req.PageSize),
+	})
+	if err != nil {
+		return nil, storageError(err, pageErrors)
+	}
+
+	protoSeen := make([]*pb.SeenNews, len(seen))
+	for i, s := range seen {
+		protoSeen[i] = &pb.SeenNews{
+			NewsId: s.NewsID,
+			SeenAt: s.SeenAt.Format(time.RFC3339),
+		}
+	}
+
+	return &pb.GetSeenResponse{Seen: protoSeen, NextPageToken: nextPageToken}, nil
+}
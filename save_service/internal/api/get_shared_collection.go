@@ -0,0 +1,28 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+	"gonews/save_service/internal/models"
+)
+
+func (s *GRPCServer) GetSharedCollection(ctx context.Context, req *pb.GetSharedCollectionRequest) (*pb.CollectionItemsResponse, error) {
+	collection, news, nextPageToken, err := s.saveService.GetSharedCollection(ctx, req.ShareToken, models.PageRequest{
+		Token: req.PageToken,
+		Size:  int(req.PageSize),
+	})
+	if err != nil {
+		return nil, storageError(err, collectionErrors)
+	}
+
+	// Владелец и токен не раскрываются по публичной ссылке
+	protoCollection := collectionToProto(collection)
+	protoCollection.UserId = 0
+	protoCollection.ShareToken = ""
+
+	return &pb.CollectionItemsResponse{
+		Collection:    protoCollection,
+		News:          newsListToProto(news),
+		NextPageToken: nextPageToken,
+	}, nil
+}
@@ -3,25 +3,30 @@ package api
 import (
 	"context"
 	"gonews/protos/pb"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"gonews/save_service/internal/models"
 )
 
 func (s *GRPCServer) GetSubscriptions(ctx context.Context, req *pb.GetSubscriptionsRequest) (*pb.GetSubscriptionsResponse, error) {
-	subscriptions, err := s.saveService.GetSubscriptions(ctx)
+	subscriptions, nextPageToken, err := s.saveService.GetSubscriptions(ctx, req.UserId, models.PageRequest{
+		Token: req.PageToken,
+		Size:  int(req.PageSize),
+	})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, storageError(err, pageErrors)
 	}
 
+	return &pb.GetSubscriptionsResponse{Subscriptions: subscriptionsToProto(subscriptions), NextPageToken: nextPageToken}, nil
+}
+
+func subscriptionsToProto(subscriptions []*models.Subscription) []*pb.Subscription {
 	protoSubs := make([]*pb.Subscription, len(subscriptions))
 	for i, sub := range subscriptions {
 		protoSubs[i] = &pb.Subscription{
-			Id:      sub.ID,
-			UserId:  sub.UserID,
-			Keyword: sub.Keyword,
+			Id:       sub.ID,
+			UserId:   sub.UserID,
+			Keyword:  sub.Keyword,
+			Schedule: sub.Schedule,
 		}
 	}
-
-	return &pb.GetSubscriptionsResponse{Subscriptions: protoSubs}, nil
+	return protoSubs
 }
@@ -0,0 +1,22 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+	"gonews/save_service/internal/models"
+)
+
+func (s *GRPCServer) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.UserResponse, error) {
+	var user *models.User
+	var err error
+	if req.UserId != 0 {
+		user, err = s.saveService.GetUser(ctx, req.UserId)
+	} else {
+		user, err = s.saveService.GetUserByHandle(ctx, req.Handle)
+	}
+	if err != nil {
+		return nil, storageError(err, userErrors)
+	}
+
+	return &pb.UserResponse{User: userToProto(user)}, nil
+}
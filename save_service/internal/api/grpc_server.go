@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"errors"
 	"gonews/protos/pb"
 	"gonews/save_service/internal/models"
 
@@ -11,16 +12,56 @@ import (
 )
 
 type SaveService interface {
-	CreateUser(ctx context.Context, name string) (uint64, error)
+	CreateUser(ctx context.Context, user *models.User) (*models.User, error)
+	GetUser(ctx context.Context, userID uint64) (*models.User, error)
+	GetUserByHandle(ctx context.Context, handle string) (*models.User, error)
+	ListUsers(ctx context.Context, page models.PageRequest) ([]*models.User, string, error)
+	RunMigrations(ctx context.Context) error
+	UpdateUser(ctx context.Context, userID uint64, update *models.UserUpdate) (*models.User, error)
 	AddFavourite(ctx context.Context, userID, newsID uint64) error
-	GetFavourites(ctx context.Context, userID uint64) ([]*models.News, error)
-	AddToSearchHistory(ctx context.Context, userID uint64, query string, results []uint64) error
-	GetSearchHistory(ctx context.Context, userID uint64) ([]string, error)
-	Subscribe(ctx context.Context, userID uint64, keyword string) error
-	GetSubscriptions(ctx context.Context) ([]*models.Subscription, error)
-	MarkNewsAsSeen(ctx context.Context, userID, newsID uint64) error
-	SaveNews(ctx context.Context, news []*models.News) error
+	GetFavourites(ctx context.Context, userID uint64, page models.PageRequest, unseenOnly bool) ([]*models.News, string, error)
+	AddToSearchHistory(ctx context.Context, entry *models.SearchHistoryEntry) error
+	GetSearchHistory(ctx context.Context, userID uint64, page models.PageRequest, uniqueQueries bool) ([]*models.SearchHistoryEntry, string, error)
+	GetSearchHistoryEntry(ctx context.Context, userID, searchID uint64) (*models.SearchHistoryEntry, error)
+	Subscribe(ctx context.Context, userID uint64, keyword, schedule string) error
+	UpdateSubscription(ctx context.Context, userID, id uint64, schedule string) (*models.Subscription, error)
+	GetSubscriptions(ctx context.Context, userID uint64, page models.PageRequest) ([]*models.Subscription, string, error)
+	MarkNewsAsSeen(ctx context.Context, userID uint64, newsIDs []uint64) error
+	MarkNewsAsUnseen(ctx context.Context, userID uint64, newsIDs []uint64) error
+	GetSeenNews(ctx context.Context, userID uint64, newsIDs []uint64, page models.PageRequest) ([]*models.UserToSeenNews, string, error)
+	SaveNews(ctx context.Context, news []*models.News) ([]uint64, error)
 	GetNewsByIDs(ctx context.Context, IDs []uint64) ([]*models.News, error)
+	CreateSavedSearch(ctx context.Context, savedSearch *models.SavedSearch) (*models.SavedSearch, error)
+	GetSavedSearch(ctx context.Context, userID, id uint64) (*models.SavedSearch, error)
+	ListSavedSearches(ctx context.Context, userID uint64, page models.PageRequest, dueOnly bool) ([]*models.SavedSearch, string, error)
+	UpdateSavedSearch(ctx context.Context, userID, id uint64, update *models.SavedSearchUpdate) (*models.SavedSearch, error)
+	DeleteSavedSearch(ctx context.Context, userID, id uint64) error
+	RecordSavedSearchRun(ctx context.Context, id uint64, resultIDs []uint64) (*models.SavedSearch, error)
+	CreateCollection(ctx context.Context, userID uint64, name string) (*models.Collection, error)
+	ListCollections(ctx context.Context, userID uint64, page models.PageRequest) ([]*models.Collection, string, error)
+	RenameCollection(ctx context.Context, userID, collectionID uint64, name string) (*models.Collection, error)
+	DeleteCollection(ctx context.Context, userID, collectionID uint64) error
+	GetCollectionItems(ctx context.Context, userID, collectionID uint64, page models.PageRequest) (*models.Collection, []*models.News, string, error)
+	AddToCollection(ctx context.Context, userID, collectionID, newsID uint64) error
+	RemoveFromCollection(ctx context.Context, userID, collectionID, newsID uint64) error
+	CopyCollectionItems(ctx context.Context, userID, fromID, toID uint64, newsIDs []uint64, move bool) error
+	ReorderCollection(ctx context.Context, userID, collectionID uint64, newsIDs []uint64) error
+	ShareCollection(ctx context.Context, userID, collectionID uint64, shared bool) (*models.Collection, error)
+	GetSharedCollection(ctx context.Context, shareToken string, page models.PageRequest) (*models.Collection, []*models.News, string, error)
+	GetFavouriteAnnotation(ctx context.Context, userID, newsID uint64) (*models.FavouriteAnnotation, error)
+	SetFavouriteTags(ctx context.Context, userID, newsID uint64, tags []string) (*models.FavouriteAnnotation, error)
+	SetFavouriteNote(ctx context.Context, userID, newsID uint64, note string) (*models.FavouriteAnnotation, error)
+	AddHighlight(ctx context.Context, highlight *models.Highlight) (*models.Highlight, error)
+	DeleteHighlight(ctx context.Context, userID, newsID, highlightID uint64) error
+	SearchFavourites(ctx context.Context, userID uint64, filter models.FavouriteFilter, page models.PageRequest) ([]*models.AnnotatedNews, string, error)
+	RecordNotification(ctx context.Context, notification *models.Notification) error
+	ExportUserData(ctx context.Context, userID uint64, format string) (*models.ExportFile, error)
+	DeleteUser(ctx context.Context, userID uint64) error
+	CreateAPIKey(ctx context.Context, name string, scopes []string, userID uint64) (*models.APIKey, string, error)
+	ListAPIKeys(ctx context.Context, page models.PageRequest, includeRevoked bool) ([]*models.APIKey, string, error)
+	RotateAPIKey(ctx context.Context, id uint64) (*models.APIKey, string, error)
+	RevokeAPIKey(ctx context.Context, id uint64) error
+	AuthenticateAPIKey(ctx context.Context, secret string) (*models.APIKey, error)
 }
 
 type GRPCServer struct {
@@ -35,11 +76,10 @@ func NewGRPCServer(saveService SaveService) *GRPCServer {
 }
 
 func (s *GRPCServer) AddFavourite(ctx context.Context, req *pb.AddFavouriteRequest) (*pb.AddFavouriteResponse, error) {
-	if req.UserId == 0 || req.NewsId == 0 {
-		return nil, status.Error(codes.InvalidArgument, "user_id and news_id are required")
-	}
-
 	err := s.saveService.AddFavourite(ctx, req.UserId, req.NewsId)
+	if errors.Is(err, models.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "user or news not found")
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -0,0 +1,28 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+	"gonews/save_service/internal/models"
+)
+
+// ListAllSavedSearches - сохранённые поиски всех пользователей; только для внутренних клиентов, в REST не публикуется
+func (s *GRPCServer) ListAllSavedSearches(ctx context.Context, req *pb.ListAllSavedSearchesRequest) (*pb.ListSavedSearchesResponse, error) {
+	savedSearches, nextPageToken, err := s.saveService.ListSavedSearches(ctx, 0, models.PageRequest{
+		Token: req.PageToken,
+		Size:  int(req.PageSize),
+	}, req.DueOnly)
+	if err != nil {
+		return nil, storageError(err, pageErrors)
+	}
+
+	protoSavedSearches := make([]*pb.SavedSearch, len(savedSearches))
+	for i, savedSearch := range savedSearches {
+		protoSavedSearches[i] = savedSearchToProto(savedSearch)
+	}
+
+	return &pb.ListSavedSearchesResponse{
+		SavedSearches: protoSavedSearches,
+		NextPageToken: nextPageToken,
+	}, nil
+}
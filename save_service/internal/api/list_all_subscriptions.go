@@ -0,0 +1,20 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+	"gonews/save_service/internal/models"
+)
+
+// ListAllSubscriptions - подписки всех пользователей; только для внутренних клиентов, в REST не публикуется
+func (s *GRPCServer) ListAllSubscriptions(ctx context.Context, req *pb.ListAllSubscriptionsRequest) (*pb.GetSubscriptionsResponse, error) {
+	subscriptions, nextPageToken, err := s.saveService.GetSubscriptions(ctx, 0, models.PageRequest{
+		Token: req.PageToken,
+		Size:  int(req.PageSize),
+	})
+	if err != nil {
+		return nil, storageError(err, pageErrors)
+	}
+
+	return &pb.GetSubscriptionsResponse{Subscriptions: subscriptionsToProto(subscriptions), NextPageToken: nextPageToken}, nil
+}
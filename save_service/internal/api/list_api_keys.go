@@ -0,0 +1,27 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+	"gonews/save_service/internal/models"
+)
+
+func (s *GRPCServer) ListAPIKeys(ctx context.Context, req *pb.ListAPIKeysRequest) (*pb.ListAPIKeysResponse, error) {
+	keys, nextPageToken, err := s.saveService.ListAPIKeys(ctx, models.PageRequest{
+		Token: req.PageToken,
+		Size:  int(req.PageSize),
+	}, req.IncludeRevoked)
+	if err != nil {
+		return nil, storageError(err, apiKeyErrors)
+	}
+
+	protoKeys := make([]*pb.APIKey, len(keys))
+	for i, key := range keys {
+		protoKeys[i] = apiKeyToProto(key)
+	}
+
+	return &pb.ListAPIKeysResponse{
+		ApiKeys:       protoKeys,
+		NextPageToken: nextPageToken,
+	}, nil
+}
@@ -0,0 +1,27 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+	"gonews/save_service/internal/models"
+)
+
+func (s *GRPCServer) ListCollections(ctx context.Context, req *pb.ListCollectionsRequest) (*pb.ListCollectionsResponse, error) {
+	collections, nextPageToken, err := s.saveService.ListCollections(ctx, req.UserId, models.PageRequest{
+		Token: req.PageToken,
+		Size:  int(req.PageSize),
+	})
+	if err != nil {
+		return nil, storageError(err, pageErrors)
+	}
+
+	protoCollections := make([]*pb.Collection, len(collections))
+	for i, collection := range collections {
+		protoCollections[i] = collectionToProto(collection)
+	}
+
+	return &pb.ListCollectionsResponse{
+		Collections:   protoCollections,
+		NextPageToken: nextPageToken,
+	}, nil
+}
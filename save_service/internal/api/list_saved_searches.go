@@ -0,0 +1,27 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+	"gonews/save_service/internal/models"
+)
+
+func (s *GRPCServer) ListSavedSearches(ctx context.Context, req *pb.ListSavedSearchesRequest) (*pb.ListSavedSearchesResponse, error) {
+	savedSearches, nextPageToken, err := s.saveService.ListSavedSearches(ctx, req.UserId, models.PageRequest{
+		Token: req.PageToken,
+		Size:  int(req.PageSize),
+	}, req.DueOnly)
+	if err != nil {
+		return nil, storageError(err, pageErrors)
+	}
+
+	protoSavedSearches := make([]*pb.SavedSearch, len(savedSearches))
+	for i, savedSearch := range savedSearches {
+		protoSavedSearches[i] = savedSearchToProto(savedSearch)
+	}
+
+	return &pb.ListSavedSearchesResponse{
+		SavedSearches: protoSavedSearches,
+		NextPageToken: nextPageToken,
+	}, nil
+}
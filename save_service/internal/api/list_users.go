@@ -0,0 +1,24 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+	"gonews/save_service/internal/models"
+)
+
+func (s *GRPCServer) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	users, nextPageToken, err := s.saveService.ListUsers(ctx, models.PageRequest{
+		Token: req.PageToken,
+		Size:  int(req.PageSize),
+	})
+	if err != nil {
+		return nil, storageError(err, pageErrors)
+	}
+
+	protoUsers := make([]*pb.User, len(users))
+	for i, user := range users {
+		protoUsers[i] = userToProto(user)
+	}
+
+	return &pb.ListUsersResponse{Users: protoUsers, NextPageToken: nextPageToken}, nil
+}
@@ -0,0 +1,23 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"gonews/protos/pb"
+	"gonews/save_service/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) MarkSeen(ctx context.Context, req *pb.MarkSeenRequest) (*pb.MarkSeenResponse, error) {
+	err := s.saveService.MarkNewsAsSeen(ctx, req.UserId, req.NewsIds)
+	if errors.Is(err, models.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "user or news not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.MarkSeenResponse{Success: true}, nil
+}
@@ -0,0 +1,18 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) MarkUnseen(ctx context.Context, req *pb.MarkUnseenRequest) (*pb.MarkUnseenResponse, error) {
+	err := s.saveService.MarkNewsAsUnseen(ctx, req.UserId, req.NewsIds)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.MarkUnseenResponse{Success: true}, nil
+}
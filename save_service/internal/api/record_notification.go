@@ -0,0 +1,27 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"gonews/protos/pb"
+	"gonews/save_service/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) RecordNotification(ctx context.Context, req *pb.RecordNotificationRequest) (*pb.RecordNotificationResponse, error) {
+	err := s.saveService.RecordNotification(ctx, &models.Notification{
+		UserID:  req.UserId,
+		Keyword: req.Keyword,
+		Article: *newsFromProto(req.Article),
+	})
+	if errors.Is(err, models.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.RecordNotificationResponse{Success: true}, nil
+}
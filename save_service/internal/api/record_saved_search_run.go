@@ -0,0 +1,15 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+)
+
+func (s *GRPCServer) RecordSavedSearchRun(ctx context.Context, req *pb.RecordSavedSearchRunRequest) (*pb.SavedSearchResponse, error) {
+	savedSearch, err := s.saveService.RecordSavedSearchRun(ctx, req.Id, req.ResultIds)
+	if err != nil {
+		return nil, storageError(err, savedSearchErrors)
+	}
+
+	return &pb.SavedSearchResponse{SavedSearch: savedSearchToProto(savedSearch)}, nil
+}
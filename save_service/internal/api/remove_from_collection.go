@@ -0,0 +1,15 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+)
+
+func (s *GRPCServer) RemoveFromCollection(ctx context.Context, req *pb.RemoveFromCollectionRequest) (*pb.RemoveFromCollectionResponse, error) {
+	err := s.saveService.RemoveFromCollection(ctx, req.UserId, req.CollectionId, req.NewsId)
+	if err != nil {
+		return nil, storageError(err, collectionErrors)
+	}
+
+	return &pb.RemoveFromCollectionResponse{Success: true}, nil
+}
@@ -0,0 +1,15 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+)
+
+func (s *GRPCServer) RenameCollection(ctx context.Context, req *pb.RenameCollectionRequest) (*pb.CollectionResponse, error) {
+	collection, err := s.saveService.RenameCollection(ctx, req.UserId, req.CollectionId, req.Name)
+	if err != nil {
+		return nil, storageError(err, collectionErrors)
+	}
+
+	return &pb.CollectionResponse{Collection: collectionToProto(collection)}, nil
+}
@@ -0,0 +1,15 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+)
+
+func (s *GRPCServer) ReorderCollection(ctx context.Context, req *pb.ReorderCollectionRequest) (*pb.ReorderCollectionResponse, error) {
+	err := s.saveService.ReorderCollection(ctx, req.UserId, req.CollectionId, req.NewsIds)
+	if err != nil {
+		return nil, storageError(err, collectionErrors)
+	}
+
+	return &pb.ReorderCollectionResponse{Success: true}, nil
+}
@@ -0,0 +1,14 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+)
+
+func (s *GRPCServer) RevokeAPIKey(ctx context.Context, req *pb.RevokeAPIKeyRequest) (*pb.RevokeAPIKeyResponse, error) {
+	if err := s.saveService.RevokeAPIKey(ctx, req.Id); err != nil {
+		return nil, storageError(err, apiKeyErrors)
+	}
+
+	return &pb.RevokeAPIKeyResponse{Success: true}, nil
+}
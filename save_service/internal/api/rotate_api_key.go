@@ -0,0 +1,15 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+)
+
+func (s *GRPCServer) RotateAPIKey(ctx context.Context, req *pb.RotateAPIKeyRequest) (*pb.APIKeySecretResponse, error) {
+	key, secret, err := s.saveService.RotateAPIKey(ctx, req.Id)
+	if err != nil {
+		return nil, storageError(err, apiKeyErrors)
+	}
+
+	return &pb.APIKeySecretResponse{ApiKey: apiKeyToProto(key), Key: secret}, nil
+}
@@ -0,0 +1,19 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) RunMigrations(ctx context.Context, req *pb.RunMigrationsRequest) (*pb.RunMigrationsResponse, error) {
+	if err := s.saveService.RunMigrations(ctx); err != nil {
+		return nil, status.Error(codes.Internal, "failed to run migrations: "+err.Error())
+	}
+
+	slog.InfoContext(ctx, "migrations applied")
+	return &pb.RunMigrationsResponse{}, nil
+}
@@ -11,29 +11,32 @@ import (
 )
 
 func (s *GRPCServer) SaveNews(ctx context.Context, req *pb.SaveNewsRequest) (*pb.SaveNewsResponse, error) {
-	if len(req.News) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "no news to save")
-	}
-
 	news := make([]*models.News, len(req.News))
 	for i, n := range req.News {
-		publishedAt, _ := time.Parse(time.RFC3339, n.PublishedAt)
-
-		news[i] = &models.News{
-			Source:      n.Source,
-			Author:      n.Author,
-			Title:       n.Title,
-			Description: n.Description,
-			URL:         n.Url,
-			ImageURL:    n.ImageUrl,
-			PublishedAt: publishedAt,
-		}
+		news[i] = newsFromProto(n)
 	}
 
-	err := s.saveService.SaveNews(ctx, news)
+	ids, err := s.saveService.SaveNews(ctx, news)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &pb.SaveNewsResponse{Success: true}, nil
+	return &pb.SaveNewsResponse{Success: true, Ids: ids}, nil
+}
+
+// newsFromProto - статья из запроса, некорректная дата публикации становится нулевой
+func newsFromProto(n *pb.News) *models.News {
+	publishedAt, _ := time.Parse(time.RFC3339, n.PublishedAt)
+
+	return &models.News{
+		ID:          n.Id,
+		Source:      n.Source,
+		Author:      n.Author,
+		Title:       n.Title,
+		Description: n.Description,
+		URL:         n.Url,
+		ImageURL:    n.ImageUrl,
+		PublishedAt: publishedAt,
+		Content:     n.Content,
+	}
 }
@@ -0,0 +1,43 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+	"gonews/save_service/internal/models"
+	"time"
+)
+
+func (s *GRPCServer) SearchFavourites(ctx context.Context, req *pb.SearchFavouritesRequest) (*pb.SearchFavouritesResponse, error) {
+	favourites, nextPageToken, err := s.saveService.SearchFavourites(ctx, req.UserId, models.FavouriteFilter{
+		Tag:       req.GetTag(),
+		NoteQuery: req.GetNoteQuery(),
+	}, models.PageRequest{
+		Token: req.PageToken,
+		Size:  int(req.PageSize),
+	})
+	if err != nil {
+		return nil, storageError(err, annotationErrors)
+	}
+
+	protoFavourites := make([]*pb.AnnotatedNews, len(favourites))
+	for i, f := range favourites {
+		protoFavourites[i] = &pb.AnnotatedNews{
+			News: &pb.News{
+				Id:          f.News.ID,
+				Source:      f.News.Source,
+				Author:      f.News.Author,
+				Title:       f.News.Title,
+				Description: f.News.Description,
+				Url:         f.News.URL,
+				ImageUrl:    f.News.ImageURL,
+				PublishedAt: f.News.PublishedAt.Format(time.RFC3339),
+			},
+			Annotation: annotationToProto(f.Annotation),
+		}
+	}
+
+	return &pb.SearchFavouritesResponse{
+		Favourites:    protoFavourites,
+		NextPageToken: nextPageToken,
+	}, nil
+}
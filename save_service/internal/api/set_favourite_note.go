@@ -0,0 +1,15 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+)
+
+func (s *GRPCServer) SetFavouriteNote(ctx context.Context, req *pb.SetFavouriteNoteRequest) (*pb.FavouriteAnnotationResponse, error) {
+	annotation, err := s.saveService.SetFavouriteNote(ctx, req.UserId, req.NewsId, req.Note)
+	if err != nil {
+		return nil, storageError(err, annotationErrors)
+	}
+
+	return &pb.FavouriteAnnotationResponse{Annotation: annotationToProto(annotation)}, nil
+}
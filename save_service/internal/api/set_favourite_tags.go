@@ -0,0 +1,15 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+)
+
+func (s *GRPCServer) SetFavouriteTags(ctx context.Context, req *pb.SetFavouriteTagsRequest) (*pb.FavouriteAnnotationResponse, error) {
+	annotation, err := s.saveService.SetFavouriteTags(ctx, req.UserId, req.NewsId, req.Tags)
+	if err != nil {
+		return nil, storageError(err, annotationErrors)
+	}
+
+	return &pb.FavouriteAnnotationResponse{Annotation: annotationToProto(annotation)}, nil
+}
@@ -0,0 +1,15 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+)
+
+func (s *GRPCServer) ShareCollection(ctx context.Context, req *pb.ShareCollectionRequest) (*pb.CollectionResponse, error) {
+	collection, err := s.saveService.ShareCollection(ctx, req.UserId, req.CollectionId, req.Shared)
+	if err != nil {
+		return nil, storageError(err, collectionErrors)
+	}
+
+	return &pb.CollectionResponse{Collection: collectionToProto(collection)}, nil
+}
@@ -3,19 +3,12 @@ package api
 import (
 	"context"
 	"gonews/protos/pb"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 func (s *GRPCServer) Subscribe(ctx context.Context, req *pb.SubscribeRequest) (*pb.SubscribeResponse, error) {
-	if req.UserId == 0 || req.Keyword == "" {
-		return nil, status.Error(codes.InvalidArgument, "user_id and keyword are required")
-	}
-
-	err := s.saveService.Subscribe(ctx, req.UserId, req.Keyword)
+	err := s.saveService.Subscribe(ctx, req.UserId, req.Keyword, req.Schedule)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, storageError(err, subscriptionErrors)
 	}
 
 	return &pb.SubscribeResponse{Success: true}, nil
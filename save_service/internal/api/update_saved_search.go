@@ -0,0 +1,30 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+	"gonews/save_service/internal/models"
+)
+
+func (s *GRPCServer) UpdateSavedSearch(ctx context.Context, req *pb.UpdateSavedSearchRequest) (*pb.SavedSearchResponse, error) {
+	update := &models.SavedSearchUpdate{
+		Name:   req.Name,
+		Query:  req.Query,
+		Notify: req.Notify,
+	}
+	if req.Filters != nil {
+		filters := filtersFromProto(req.Filters)
+		update.Filters = &filters
+	}
+	if req.RefreshIntervalMinutes != nil {
+		interval := int(*req.RefreshIntervalMinutes)
+		update.RefreshIntervalMinutes = &interval
+	}
+
+	savedSearch, err := s.saveService.UpdateSavedSearch(ctx, req.UserId, req.Id, update)
+	if err != nil {
+		return nil, storageError(err, savedSearchErrors)
+	}
+
+	return &pb.SavedSearchResponse{SavedSearch: savedSearchToProto(savedSearch)}, nil
+}
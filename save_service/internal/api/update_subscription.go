@@ -0,0 +1,20 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+)
+
+func (s *GRPCServer) UpdateSubscription(ctx context.Context, req *pb.UpdateSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	sub, err := s.saveService.UpdateSubscription(ctx, req.UserId, req.SubscriptionId, req.Schedule)
+	if err != nil {
+		return nil, storageError(err, subscriptionErrors)
+	}
+
+	return &pb.SubscriptionResponse{Subscription: &pb.Subscription{
+		Id:       sub.ID,
+		UserId:   sub.UserID,
+		Keyword:  sub.Keyword,
+		Schedule: sub.Schedule,
+	}}, nil
+}
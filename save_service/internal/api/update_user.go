@@ -0,0 +1,29 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+	"gonews/save_service/internal/models"
+)
+
+func (s *GRPCServer) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UserResponse, error) {
+	update := &models.UserUpdate{
+		Handle:      req.Handle,
+		Email:       req.Email,
+		DisplayName: req.DisplayName,
+		Language:    req.Language,
+		Country:     req.Country,
+		Timezone:    req.Timezone,
+	}
+	if req.DefaultFilters != nil {
+		filters := filtersFromProto(req.DefaultFilters)
+		update.DefaultFilters = &filters
+	}
+
+	user, err := s.saveService.UpdateUser(ctx, req.UserId, update)
+	if err != nil {
+		return nil, storageError(err, userErrors)
+	}
+
+	return &pb.UserResponse{User: userToProto(user)}, nil
+}
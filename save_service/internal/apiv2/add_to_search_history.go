@@ -0,0 +1,31 @@
+package apiv2
+
+import (
+	"context"
+	"errors"
+	pbv2 "gonews/protos/pb/v2"
+	"gonews/save_service/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) AddToSearchHistory(ctx context.Context, req *pbv2.AddToSearchHistoryRequest) (*pbv2.AddToSearchHistoryResponse, error) {
+	if req.UserId == 0 || req.Query == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and query are required")
+	}
+
+	err := s.saveService.AddToSearchHistory(ctx, &models.SearchHistoryEntry{
+		UserID:    req.UserId,
+		Query:     req.Query,
+		ResultIDs: req.Results,
+	})
+	if errors.Is(err, models.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pbv2.AddToSearchHistoryResponse{Success: true}, nil
+}
@@ -0,0 +1,30 @@
+package apiv2
+
+import (
+	"context"
+	"errors"
+	pbv2 "gonews/protos/pb/v2"
+	"gonews/save_service/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) CreateUser(ctx context.Context, req *pbv2.CreateUserRequest) (*pbv2.CreateUserResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	user, err := s.saveService.CreateUser(ctx, &models.User{DisplayName: req.Name})
+	if errors.Is(err, models.ErrInvalidProfile) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, models.ErrAlreadyExists) {
+		return nil, status.Error(codes.AlreadyExists, "user with this name already exists")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pbv2.CreateUserResponse{UserId: user.ID}, nil
+}
@@ -0,0 +1,17 @@
+package apiv2
+
+import (
+	"errors"
+	"gonews/save_service/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// pageError - некорректный курсор - ошибка клиента, остальное - внутренняя ошибка
+func pageError(err error) error {
+	if errors.Is(err, models.ErrInvalidPageToken) {
+		return status.Error(codes.InvalidArgument, models.ErrInvalidPageToken.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
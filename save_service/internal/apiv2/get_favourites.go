@@ -0,0 +1,35 @@
+package apiv2
+
+import (
+	"context"
+	pbv2 "gonews/protos/pb/v2"
+	"gonews/save_service/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) GetFavourites(ctx context.Context, req *pbv2.GetFavouritesRequest) (*pbv2.GetFavouritesResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if req.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	news, nextPageToken, err := s.saveService.GetFavourites(ctx, req.UserId, models.PageRequest{
+		Token: req.PageToken,
+		Size:  int(req.PageSize),
+	}, req.UnseenOnly)
+	if err != nil {
+		return nil, pageError(err)
+	}
+
+	protoNews := make([]*pbv2.News, len(news))
+	for i, n := range news {
+		protoNews[i] = newsToProto(n)
+	}
+
+	return &pbv2.GetFavouritesResponse{News: protoNews, NextPageToken: nextPageToken}, nil
+}
@@ -0,0 +1,27 @@
+package apiv2
+
+import (
+	"context"
+	pbv2 "gonews/protos/pb/v2"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) GetNewsByIDs(ctx context.Context, req *pbv2.GetNewsByIDsRequest) (*pbv2.GetNewsByIDsResponse, error) {
+	if len(req.Ids) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no ids provided")
+	}
+
+	news, err := s.saveService.GetNewsByIDs(ctx, req.Ids)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbNews := make([]*pbv2.News, len(news))
+	for i, n := range news {
+		pbNews[i] = newsToProto(n)
+	}
+
+	return &pbv2.GetNewsByIDsResponse{News: pbNews}, nil
+}
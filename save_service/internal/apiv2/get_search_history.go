@@ -0,0 +1,35 @@
+package apiv2
+
+import (
+	"context"
+	pbv2 "gonews/protos/pb/v2"
+	"gonews/save_service/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) GetSearchHistory(ctx context.Context, req *pbv2.GetSearchHistoryRequest) (*pbv2.GetSearchHistoryResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if req.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	entries, nextPageToken, err := s.saveService.GetSearchHistory(ctx, req.UserId, models.PageRequest{
+		Token: req.PageToken,
+		Size:  int(req.PageSize),
+	}, false)
+	if err != nil {
+		return nil, pageError(err)
+	}
+
+	queries := make([]string, len(entries))
+	for i, entry := range entries {
+		queries[i] = entry.Query
+	}
+
+	return &pbv2.GetSearchHistoryResponse{Queries: queries, NextPageToken: nextPageToken}, nil
+}
@@ -0,0 +1,36 @@
+package apiv2
+
+import (
+	"context"
+	pbv2 "gonews/protos/pb/v2"
+	"gonews/save_service/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) GetSubscriptions(ctx context.Context, req *pbv2.GetSubscriptionsRequest) (*pbv2.GetSubscriptionsResponse, error) {
+	if req.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	subscriptions, nextPageToken, err := s.saveService.GetSubscriptions(ctx, req.UserId, models.PageRequest{
+		Token: req.PageToken,
+		Size:  int(req.PageSize),
+	})
+	if err != nil {
+		return nil, pageError(err)
+	}
+
+	protoSubs := make([]*pbv2.Subscription, len(subscriptions))
+	for i, sub := range subscriptions {
+		protoSubs[i] = &pbv2.Subscription{
+			Id:       sub.ID,
+			UserId:   sub.UserID,
+			Keyword:  sub.Keyword,
+			Schedule: sub.Schedule,
+		}
+	}
+
+	return &pbv2.GetSubscriptionsResponse{Subscriptions: protoSubs, NextPageToken: nextPageToken}, nil
+}
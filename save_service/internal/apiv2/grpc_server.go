@@ -0,0 +1,75 @@
+package apiv2
+
+import (
+	"context"
+	"errors"
+	pbv2 "gonews/protos/pb/v2"
+	"gonews/save_service/internal/models"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type SaveService interface {
+	CreateUser(ctx context.Context, user *models.User) (*models.User, error)
+	AddFavourite(ctx context.Context, userID, newsID uint64) error
+	GetFavourites(ctx context.Context, userID uint64, page models.PageRequest, unseenOnly bool) ([]*models.News, string, error)
+	AddToSearchHistory(ctx context.Context, entry *models.SearchHistoryEntry) error
+	GetSearchHistory(ctx context.Context, userID uint64, page models.PageRequest, uniqueQueries bool) ([]*models.SearchHistoryEntry, string, error)
+	Subscribe(ctx context.Context, userID uint64, keyword, schedule string) error
+	GetSubscriptions(ctx context.Context, userID uint64, page models.PageRequest) ([]*models.Subscription, string, error)
+	SaveNews(ctx context.Context, news []*models.News) ([]uint64, error)
+	GetNewsByIDs(ctx context.Context, IDs []uint64) ([]*models.News, error)
+}
+
+// GRPCServer - реализация news.v2.SaveService поверх того же SaveService, что и v1
+type GRPCServer struct {
+	pbv2.UnimplementedSaveServiceServer
+	saveService SaveService
+}
+
+func NewGRPCServer(saveService SaveService) *GRPCServer {
+	return &GRPCServer{
+		saveService: saveService,
+	}
+}
+
+func (s *GRPCServer) AddFavourite(ctx context.Context, req *pbv2.AddFavouriteRequest) (*pbv2.AddFavouriteResponse, error) {
+	if req.UserId == 0 || req.NewsId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id and news_id are required")
+	}
+
+	err := s.saveService.AddFavourite(ctx, req.UserId, req.NewsId)
+	if errors.Is(err, models.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "user or news not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pbv2.AddFavouriteResponse{Success: true}, nil
+}
+
+func (s *GRPCServer) Register(server *grpc.Server) {
+	pbv2.RegisterSaveServiceServer(server, s)
+}
+
+func newsToProto(n *models.News) *pbv2.News {
+	var publishedAt *timestamppb.Timestamp
+	if !n.PublishedAt.IsZero() {
+		publishedAt = timestamppb.New(n.PublishedAt)
+	}
+
+	return &pbv2.News{
+		Id:          n.ID,
+		Source:      n.Source,
+		Author:      n.Author,
+		Title:       n.Title,
+		Description: n.Description,
+		Url:         n.URL,
+		ImageUrl:    n.ImageURL,
+		PublishedAt: publishedAt,
+	}
+}
@@ -0,0 +1,46 @@
+package apiv2
+
+import (
+	"context"
+	"fmt"
+	pbv2 "gonews/protos/pb/v2"
+	"gonews/save_service/internal/models"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) SaveNews(ctx context.Context, req *pbv2.SaveNewsRequest) (*pbv2.SaveNewsResponse, error) {
+	if len(req.News) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no news to save")
+	}
+
+	news := make([]*models.News, len(req.News))
+	for i, n := range req.News {
+		var publishedAt time.Time
+		if n.PublishedAt != nil {
+			if err := n.PublishedAt.CheckValid(); err != nil {
+				return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("news[%d].published_at: %v", i, err))
+			}
+			publishedAt = n.PublishedAt.AsTime()
+		}
+
+		news[i] = &models.News{
+			Source:      n.Source,
+			Author:      n.Author,
+			Title:       n.Title,
+			Description: n.Description,
+			URL:         n.Url,
+			ImageURL:    n.ImageUrl,
+			PublishedAt: publishedAt,
+		}
+	}
+
+	ids, err := s.saveService.SaveNews(ctx, news)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pbv2.SaveNewsResponse{Success: true, Ids: ids}, nil
+}
@@ -0,0 +1,33 @@
+package apiv2
+
+import (
+	"context"
+	"errors"
+	pbv2 "gonews/protos/pb/v2"
+	"gonews/save_service/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) Subscribe(ctx context.Context, req *pbv2.SubscribeRequest) (*pbv2.SubscribeResponse, error) {
+	if req.UserId == 0 || req.Keyword == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and keyword are required")
+	}
+
+	err := s.saveService.Subscribe(ctx, req.UserId, req.Keyword, req.Schedule)
+	if errors.Is(err, models.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if errors.Is(err, models.ErrAlreadyExists) {
+		return nil, status.Error(codes.AlreadyExists, "already subscribed to this keyword")
+	}
+	if errors.Is(err, models.ErrInvalidSchedule) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pbv2.SubscribeResponse{Success: true}, nil
+}
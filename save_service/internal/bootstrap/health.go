@@ -0,0 +1,19 @@
+package bootstrap
+
+import (
+	"context"
+	"gonews/pkg/health"
+	"gonews/save_service/config"
+	"gonews/save_service/internal/storage/pgstorage"
+	"time"
+)
+
+func InitHealthServer(storage *pgstorage.PGStorage, cfg *config.Config) *health.Server {
+	healthServer := health.NewServer(
+		time.Duration(cfg.Health.IntervalSeconds)*time.Second,
+		time.Duration(cfg.Health.TimeoutSeconds)*time.Second,
+	)
+	healthServer.AddCheck("postgres", storage.Ping)
+	healthServer.Start(context.Background())
+	return healthServer
+}
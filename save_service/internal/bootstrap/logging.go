@@ -0,0 +1,17 @@
+package bootstrap
+
+import (
+	"gonews/pkg/configloader"
+	"gonews/pkg/logging"
+	"gonews/save_service/config"
+	"log"
+	"log/slog"
+)
+
+func InitLogging(cfg *config.Config) {
+	if err := logging.Init("save-service", cfg.Logging); err != nil {
+		log.Fatalf("Failed to initialize logging: %v", err)
+	}
+
+	slog.Info("Effective config", "config", configloader.Redact(cfg))
+}
@@ -0,0 +1,17 @@
+package bootstrap
+
+import (
+	"context"
+	"gonews/pkg/logging"
+	"gonews/save_service/config"
+	"log/slog"
+)
+
+// InitConfigReload - по SIGHUP и при изменении config.yaml применяет уровень логов
+func InitConfigReload(cfg *config.Config, args []string) {
+	config.WatchConfig(context.Background(), cfg, args, func(cfg *config.Config) {
+		if err := logging.SetLevel(cfg.Logging.Level); err != nil {
+			slog.Warn("Failed to change log level", logging.Err(err))
+		}
+	})
+}
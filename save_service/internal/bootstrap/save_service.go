@@ -8,5 +8,10 @@ import (
 )
 
 func InitSaveService(storage *pgstorage.PGStorage, cfg *config.Config) *saveService.SaveService {
-	return saveService.NewSaveService(context.Background(), storage)
+	return saveService.NewSaveService(
+		context.Background(),
+		storage,
+		cfg.Pagination.DefaultPageSize,
+		cfg.Pagination.MaxPageSize,
+	)
 }
@@ -1,35 +1,75 @@
 package bootstrap
 
 import (
+	"context"
 	"fmt"
+	"gonews/pkg/grpcserver"
+	"gonews/pkg/health"
+	"gonews/pkg/logging"
+	"gonews/pkg/metrics"
+	"gonews/pkg/tracing"
 	"gonews/protos/pb"
+	pbv2 "gonews/protos/pb/v2"
 	"gonews/save_service/config"
 	"gonews/save_service/internal/api"
+	"gonews/save_service/internal/apiv2"
 	"gonews/save_service/internal/services/saveService"
 	"log"
+	"log/slog"
 	"net"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"google.golang.org/grpc"
 )
 
-func InitGRPCServer(saveService *saveService.SaveService, cfg *config.Config) *grpc.Server {
-	grpcServer := grpc.NewServer()
+func InitGRPCServer(saveService *saveService.SaveService, healthServer *health.Server, cfg *config.Config) *grpc.Server {
+	grpcServer, err := grpcserver.New(grpcserver.Config{
+		DefaultDeadline: time.Duration(cfg.GRPC.DefaultDeadlineSeconds) * time.Second,
+		TLS:             cfg.TLS,
+	})
+	if err != nil {
+		log.Fatalf("Failed to create gRPC server: %v", err)
+	}
+	healthServer.Register(grpcServer)
 	newsServer := api.NewGRPCServer(saveService)
 	pb.RegisterSaveServiceServer(grpcServer, newsServer)
+	pbv2.RegisterSaveServiceServer(grpcServer, apiv2.NewGRPCServer(saveService))
+	metrics.InitServer(grpcServer)
 	return grpcServer
 }
 
-func AppRun(grpcServer *grpc.Server, cfg *config.Config) {
+func AppRun(grpcServer *grpc.Server, shutdownTracing tracing.Shutdown, cfg *config.Config) {
 	lis, err := net.Listen("tcp", ":"+fmt.Sprint(cfg.GRPC.Port))
 	if err != nil {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	log.Printf("Save service gRPC server listening on port %d", cfg.GRPC.Port)
+	go func() {
+		slog.Info("Save service gRPC server listening", "port", cfg.GRPC.Port)
+
+		if err := grpcServer.Serve(lis); err != nil {
+			log.Fatalf("Failed to serve: %v", err)
+		}
+	}()
+
+	// Graceful shutdown
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
 
-	if err := grpcServer.Serve(lis); err != nil {
-		log.Fatalf("Failed to serve: %v", err)
-		os.Exit(1)
+	<-stop
+	slog.Info("Shutting down save service")
+
+	grpcServer.GracefulStop()
+
+	// Отправляем оставшиеся спаны
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := shutdownTracing(ctx); err != nil {
+		slog.Error("Tracing shutdown error", logging.Err(err))
 	}
+
+	slog.Info("Save service stopped")
 }
@@ -0,0 +1,16 @@
+package bootstrap
+
+import (
+	"context"
+	"gonews/pkg/tracing"
+	"gonews/save_service/config"
+	"log"
+)
+
+func InitTracing(cfg *config.Config) tracing.Shutdown {
+	shutdown, err := tracing.Init(context.Background(), "save-service", cfg.Tracing)
+	if err != nil {
+		log.Fatalf("Failed to initialize tracing: %v", err)
+	}
+	return shutdown
+}
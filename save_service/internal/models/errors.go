@@ -0,0 +1,15 @@
+package models
+
+import "errors"
+
+var (
+	ErrInvalidPageToken      = errors.New("invalid page token")
+	ErrNotFound              = errors.New("not found")
+	ErrAlreadyExists         = errors.New("already exists")
+	ErrDefaultCollection     = errors.New("default collection cannot be deleted")
+	ErrInvalidHighlight      = errors.New("highlight is out of article content")
+	ErrInvalidProfile        = errors.New("invalid profile")
+	ErrInvalidAPIKeySettings = errors.New("invalid API key settings")
+	ErrInvalidAPIKey         = errors.New("invalid or revoked API key")
+	ErrInvalidSchedule       = errors.New("invalid schedule")
+)
@@ -0,0 +1,49 @@
+package models
+
+import "time"
+
+// Notification - отправленное пользователю уведомление о новой статье
+type Notification struct {
+	ID      uint64    `json:"id"`
+	UserID  uint64    `json:"user_id"`
+	Keyword string    `json:"keyword"`
+	Article News      `json:"article"`
+	SentAt  time.Time `json:"sent_at"`
+}
+
+// CollectionExport - коллекция вместе со статьями в ней
+type CollectionExport struct {
+	Collection *Collection `json:"collection"`
+	Items      []*News     `json:"items"`
+}
+
+// SearchHistoryExport - запись истории поиска вместе с найденными статьями
+type SearchHistoryExport struct {
+	Entry   *SearchHistoryEntry `json:"entry"`
+	Results []*News             `json:"results"`
+}
+
+// UserExport - все данные, которые хранятся о пользователе
+type UserExport struct {
+	ExportedAt    time.Time              `json:"exported_at"`
+	Profile       *User                  `json:"profile"`
+	Collections   []*CollectionExport    `json:"collections"`
+	Annotations   []*FavouriteAnnotation `json:"annotations"`
+	SearchHistory []*SearchHistoryExport `json:"search_history"`
+	SavedSearches []*SavedSearch         `json:"saved_searches"`
+	Subscriptions []*Subscription        `json:"subscriptions"`
+	SeenNews      []*UserToSeenNews      `json:"seen_news"`
+	Notifications []*Notification        `json:"notifications"`
+}
+
+const (
+	ExportFormatJSON = "json"
+	ExportFormatZIP  = "zip"
+)
+
+// ExportFile - готовая к отдаче выгрузка данных пользователя
+type ExportFile struct {
+	Data        []byte
+	ContentType string
+	Filename    string
+}
@@ -2,20 +2,41 @@ package models
 
 import "time"
 
+// User - профиль пользователя; handle уникален без учёта регистра
 type User struct {
-	ID   uint64
-	Name string
+	ID             uint64        `json:"id"`
+	Handle         string        `json:"handle"`
+	Email          string        `json:"email"`
+	DisplayName    string        `json:"display_name"`
+	Language       string        `json:"language"`
+	Country        string        `json:"country"`
+	Timezone       string        `json:"timezone"`
+	DefaultFilters SearchFilters `json:"default_filters"` // подставляются в поиск, если поле не задано в запросе
+	CreatedAt      time.Time     `json:"created_at"`
+	UpdatedAt      time.Time     `json:"updated_at"`
+}
+
+// UserUpdate - частичное изменение профиля, nil - поле не меняется
+type UserUpdate struct {
+	Handle         *string
+	Email          *string
+	DisplayName    *string
+	Language       *string
+	Country        *string
+	Timezone       *string
+	DefaultFilters *SearchFilters
 }
 
 type News struct {
-	ID          uint64
-	Source      string
-	Author      string
-	Title       string
-	Description string
-	URL         string
-	ImageURL    string
-	PublishedAt time.Time
+	ID          uint64    `json:"id"`
+	Source      string    `json:"source"`
+	Author      string    `json:"author"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	URL         string    `json:"url"`
+	ImageURL    string    `json:"image_url"`
+	PublishedAt time.Time `json:"published_at"`
+	Content     string    `json:"content"`
 }
 
 type UserToFavouriteNews struct {
@@ -25,14 +46,133 @@ type UserToFavouriteNews struct {
 }
 
 type UserToSeenNews struct {
-	ID     uint64
-	UserID uint64
-	NewsID uint64
-	SeenAt time.Time
+	ID     uint64    `json:"id"`
+	UserID uint64    `json:"user_id"`
+	NewsID uint64    `json:"news_id"`
+	SeenAt time.Time `json:"seen_at"`
 }
 
 type Subscription struct {
-	ID      uint64
-	UserID  uint64
-	Keyword string
+	ID      uint64 `json:"id"`
+	UserID  uint64 `json:"user_id"`
+	Keyword string `json:"keyword"`
+	// Schedule - cron или @every; пусто - общий интервал notify service
+	Schedule string `json:"schedule"`
+}
+
+// Collection - именованная коллекция избранных новостей
+type Collection struct {
+	ID         uint64    `json:"id"`
+	UserID     uint64    `json:"user_id"`
+	Name       string    `json:"name"`
+	IsDefault  bool      `json:"is_default"`  // избранное пользователя
+	ShareToken string    `json:"share_token"` // пусто - коллекция не опубликована
+	ItemCount  int       `json:"item_count"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// Highlight - выделенный фрагмент статьи, смещения в символах News.Content
+type Highlight struct {
+	ID          uint64    `json:"id"`
+	UserID      uint64    `json:"user_id"`
+	NewsID      uint64    `json:"news_id"`
+	StartOffset int       `json:"start_offset"`
+	EndOffset   int       `json:"end_offset"` // не включается
+	Text        string    `json:"text"`       // выделенный текст на момент создания
+	Comment     string    `json:"comment"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// FavouriteAnnotation - теги, заметка и выделения пользователя к сохранённой статье
+type FavouriteAnnotation struct {
+	NewsID     uint64       `json:"news_id"`
+	Tags       []string     `json:"tags"`
+	Note       string       `json:"note"`
+	Highlights []*Highlight `json:"highlights"`
+}
+
+// AnnotatedNews - сохранённая статья вместе с пометками пользователя
+type AnnotatedNews struct {
+	News       *News
+	Annotation *FavouriteAnnotation
+}
+
+// FavouriteFilter - отбор сохранённых статей, пустые поля не учитываются
+type FavouriteFilter struct {
+	Tag       string
+	NoteQuery string
+}
+
+// SearchFilters - параметры поиска помимо самого запроса
+type SearchFilters struct {
+	Sources  *string `json:"sources,omitempty"`
+	Domains  *string `json:"domains,omitempty"`
+	From     *string `json:"from,omitempty"`
+	To       *string `json:"to,omitempty"`
+	Language *string `json:"language,omitempty"`
+	SortBy   *string `json:"sort_by,omitempty"`
+	PageSize *int32  `json:"page_size,omitempty"`
+	Page     *int32  `json:"page,omitempty"`
+}
+
+type SearchHistoryEntry struct {
+	ID           uint64        `json:"id"`
+	UserID       uint64        `json:"user_id"`
+	Query        string        `json:"query"`
+	SearchedAt   time.Time     `json:"searched_at"`
+	Filters      SearchFilters `json:"filters"`
+	TotalResults int           `json:"total_results"`
+	ResultIDs    []uint64      `json:"result_ids"`
+	Occurrences  int           `json:"occurrences,omitempty"` // число одинаковых поисков, только для выборки уникальных запросов
+}
+
+// SavedSearch - именованный поиск с необязательным расписанием обновления
+type SavedSearch struct {
+	ID                     uint64        `json:"id"`
+	UserID                 uint64        `json:"user_id"`
+	Name                   string        `json:"name"`
+	Query                  string        `json:"query"`
+	Filters                SearchFilters `json:"filters"`
+	RefreshIntervalMinutes int           `json:"refresh_interval_minutes"` // 0 - без расписания
+	Notify                 bool          `json:"notify"`
+	LastRunAt              *time.Time    `json:"last_run_at,omitempty"`
+	LastResultIDs          []uint64      `json:"last_result_ids"`
+	NewResultIDs           []uint64      `json:"new_result_ids"` // результаты последнего запуска, которых не было в предыдущем
+	CreatedAt              time.Time     `json:"created_at"`
+}
+
+// SavedSearchUpdate - частичное изменение сохранённого поиска, nil - поле не меняется
+type SavedSearchUpdate struct {
+	Name                   *string
+	Query                  *string
+	Filters                *SearchFilters
+	RefreshIntervalMinutes *int
+	Notify                 *bool
+}
+
+// Права API ключей машинных клиентов
+const (
+	ScopeReadSearch      = "read:search"
+	ScopeReadUser        = "read:user"
+	ScopeWriteFavourites = "write:favourites"
+	ScopeAdmin           = "admin"
+)
+
+// APIKey - ключ машинного клиента; сам ключ не хранится, только его SHA-256
+type APIKey struct {
+	ID         uint64     `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"` // начало ключа, чтобы узнать его в списке
+	Scopes     []string   `json:"scopes"`
+	CreatedAt  time.Time  `json:"created_at"`
+	RotatedAt  *time.Time `json:"rotated_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	UserID     *uint64    `json:"user_id,omitempty"` // владелец ключа, nil - ключ сервиса
+}
+
+// PageRequest - параметры keyset-пагинации
+type PageRequest struct {
+	Token string // непрозрачный курсор из предыдущего ответа, пусто - первая страница
+	Size  int
 }
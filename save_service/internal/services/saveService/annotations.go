@@ -0,0 +1,53 @@
+package saveService
+
+import (
+	"context"
+	"gonews/save_service/internal/models"
+	"strings"
+)
+
+func (s *SaveService) GetFavouriteAnnotation(ctx context.Context, userID, newsID uint64) (*models.FavouriteAnnotation, error) {
+	return s.newsStorage.GetFavouriteAnnotation(ctx, userID, newsID)
+}
+
+func (s *SaveService) SetFavouriteTags(ctx context.Context, userID, newsID uint64, tags []string) (*models.FavouriteAnnotation, error) {
+	return s.newsStorage.SetFavouriteTags(ctx, userID, newsID, normalizeTags(tags))
+}
+
+func (s *SaveService) SetFavouriteNote(ctx context.Context, userID, newsID uint64, note string) (*models.FavouriteAnnotation, error) {
+	return s.newsStorage.SetFavouriteNote(ctx, userID, newsID, strings.TrimSpace(note))
+}
+
+func (s *SaveService) AddHighlight(ctx context.Context, highlight *models.Highlight) (*models.Highlight, error) {
+	return s.newsStorage.AddHighlight(ctx, highlight)
+}
+
+func (s *SaveService) DeleteHighlight(ctx context.Context, userID, newsID, highlightID uint64) error {
+	return s.newsStorage.DeleteHighlight(ctx, userID, newsID, highlightID)
+}
+
+func (s *SaveService) SearchFavourites(ctx context.Context, userID uint64, filter models.FavouriteFilter, page models.PageRequest) ([]*models.AnnotatedNews, string, error) {
+	filter.Tag = normalizeTag(filter.Tag)
+	filter.NoteQuery = strings.TrimSpace(filter.NoteQuery)
+	return s.newsStorage.SearchFavourites(ctx, userID, filter, s.pageRequest(page))
+}
+
+// normalizeTag - теги сравниваются без учёта регистра и пробелов по краям
+func normalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
+
+// normalizeTags - нормализует теги, убирает пустые и повторы с сохранением порядка
+func normalizeTags(tags []string) []string {
+	result := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = normalizeTag(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		result = append(result, tag)
+	}
+	return result
+}
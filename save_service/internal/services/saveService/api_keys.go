@@ -0,0 +1,138 @@
+package saveService
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"gonews/pkg/logging"
+	"gonews/save_service/internal/models"
+	"log/slog"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+)
+
+const (
+	apiKeyTokenPrefix = "gnk_"
+	// apiKeyPrefixLength - сколько первых символов ключа храним открыто для списка ключей
+	apiKeyPrefixLength  = 12
+	maxAPIKeyNameLength = 255
+)
+
+var apiKeyScopes = []string{models.ScopeReadSearch, models.ScopeReadUser, models.ScopeWriteFavourites, models.ScopeAdmin}
+
+// CreateAPIKey - выпускаем ключ; открытый ключ возвращается только здесь и при ротации.
+// userID - владелец ключа, 0 - ключ сервиса
+func (s *SaveService) CreateAPIKey(ctx context.Context, name string, scopes []string, userID uint64) (*models.APIKey, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > maxAPIKeyNameLength {
+		return nil, "", fmt.Errorf("%w: name must be 1-%d characters", models.ErrInvalidAPIKeySettings, maxAPIKeyNameLength)
+	}
+
+	scopes, err := normalizeScopes(scopes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	secret, err := newAPIKeySecret()
+	if err != nil {
+		return nil, "", err
+	}
+
+	key := &models.APIKey{
+		Name:   name,
+		Prefix: secret[:apiKeyPrefixLength],
+		Scopes: scopes,
+	}
+	if userID != 0 {
+		key.UserID = &userID
+	}
+
+	key, err = s.newsStorage.CreateAPIKey(ctx, key, hashAPIKey(secret))
+	if err != nil {
+		return nil, "", err
+	}
+
+	return key, secret, nil
+}
+
+func (s *SaveService) ListAPIKeys(ctx context.Context, page models.PageRequest, includeRevoked bool) ([]*models.APIKey, string, error) {
+	return s.newsStorage.ListAPIKeys(ctx, s.pageRequest(page), includeRevoked)
+}
+
+// RotateAPIKey - новый секрет для того же ключа; старый перестаёт работать сразу
+func (s *SaveService) RotateAPIKey(ctx context.Context, id uint64) (*models.APIKey, string, error) {
+	secret, err := newAPIKeySecret()
+	if err != nil {
+		return nil, "", err
+	}
+
+	key, err := s.newsStorage.RotateAPIKey(ctx, id, secret[:apiKeyPrefixLength], hashAPIKey(secret))
+	if err != nil {
+		return nil, "", err
+	}
+
+	return key, secret, nil
+}
+
+func (s *SaveService) RevokeAPIKey(ctx context.Context, id uint64) error {
+	return s.newsStorage.RevokeAPIKey(ctx, id)
+}
+
+// AuthenticateAPIKey - действующий ключ по открытому значению; отмечает время использования
+func (s *SaveService) AuthenticateAPIKey(ctx context.Context, secret string) (*models.APIKey, error) {
+	if !strings.HasPrefix(secret, apiKeyTokenPrefix) {
+		return nil, models.ErrInvalidAPIKey
+	}
+
+	key, err := s.newsStorage.GetAPIKeyByHash(ctx, hashAPIKey(secret))
+	if errors.Is(err, models.ErrNotFound) {
+		return nil, models.ErrInvalidAPIKey
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Неудачная отметка не должна отклонять запрос с верным ключом
+	if err := s.newsStorage.TouchAPIKey(ctx, key.ID); err != nil {
+		slog.WarnContext(ctx, "failed to record API key usage", "api_key_id", key.ID, logging.Err(err))
+	}
+
+	return key, nil
+}
+
+// normalizeScopes - известные права без повторов
+func normalizeScopes(scopes []string) ([]string, error) {
+	scopes = lo.Uniq(lo.Map(scopes, func(scope string, _ int) string {
+		return strings.ToLower(strings.TrimSpace(scope))
+	}))
+	if len(scopes) == 0 {
+		return nil, fmt.Errorf("%w: at least one scope is required", models.ErrInvalidAPIKeySettings)
+	}
+
+	for _, scope := range scopes {
+		if !lo.Contains(apiKeyScopes, scope) {
+			return nil, fmt.Errorf("%w: unknown scope %q, allowed: %s",
+				models.ErrInvalidAPIKeySettings, scope, strings.Join(apiKeyScopes, ", "))
+		}
+	}
+
+	return scopes, nil
+}
+
+func newAPIKeySecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", errors.Wrap(err, "failed to generate API key")
+	}
+	return apiKeyTokenPrefix + base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func hashAPIKey(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
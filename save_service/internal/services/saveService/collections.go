@@ -0,0 +1,73 @@
+package saveService
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"gonews/save_service/internal/models"
+
+	"github.com/pkg/errors"
+)
+
+func (s *SaveService) CreateCollection(ctx context.Context, userID uint64, name string) (*models.Collection, error) {
+	return s.newsStorage.CreateCollection(ctx, userID, name)
+}
+
+func (s *SaveService) ListCollections(ctx context.Context, userID uint64, page models.PageRequest) ([]*models.Collection, string, error) {
+	return s.newsStorage.ListCollections(ctx, userID, s.pageRequest(page))
+}
+
+func (s *SaveService) RenameCollection(ctx context.Context, userID, collectionID uint64, name string) (*models.Collection, error) {
+	return s.newsStorage.RenameCollection(ctx, userID, collectionID, name)
+}
+
+func (s *SaveService) DeleteCollection(ctx context.Context, userID, collectionID uint64) error {
+	return s.newsStorage.DeleteCollection(ctx, userID, collectionID)
+}
+
+func (s *SaveService) GetCollectionItems(ctx context.Context, userID, collectionID uint64, page models.PageRequest) (*models.Collection, []*models.News, string, error) {
+	return s.newsStorage.GetCollectionItems(ctx, userID, collectionID, s.pageRequest(page))
+}
+
+func (s *SaveService) AddToCollection(ctx context.Context, userID, collectionID, newsID uint64) error {
+	return s.newsStorage.AddToCollection(ctx, userID, collectionID, newsID)
+}
+
+func (s *SaveService) RemoveFromCollection(ctx context.Context, userID, collectionID, newsID uint64) error {
+	return s.newsStorage.RemoveFromCollection(ctx, userID, collectionID, newsID)
+}
+
+func (s *SaveService) CopyCollectionItems(ctx context.Context, userID, fromID, toID uint64, newsIDs []uint64, move bool) error {
+	return s.newsStorage.CopyCollectionItems(ctx, userID, fromID, toID, newsIDs, move)
+}
+
+func (s *SaveService) ReorderCollection(ctx context.Context, userID, collectionID uint64, newsIDs []uint64) error {
+	return s.newsStorage.ReorderCollection(ctx, userID, collectionID, newsIDs)
+}
+
+// ShareCollection - выдаёт коллекции новый токен ссылки только для чтения или отзывает его
+func (s *SaveService) ShareCollection(ctx context.Context, userID, collectionID uint64, shared bool) (*models.Collection, error) {
+	var token string
+	if shared {
+		var err error
+		token, err = newShareToken()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s.newsStorage.SetCollectionShareToken(ctx, userID, collectionID, token)
+}
+
+func (s *SaveService) GetSharedCollection(ctx context.Context, shareToken string, page models.PageRequest) (*models.Collection, []*models.News, string, error) {
+	return s.newsStorage.GetSharedCollection(ctx, shareToken, s.pageRequest(page))
+}
+
+// newShareToken - случайный токен, который нельзя подобрать перебором
+func newShareToken() (string, error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", errors.Wrap(err, "failed to generate share token")
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
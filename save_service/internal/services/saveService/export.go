@@ -0,0 +1,84 @@
+package saveService
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"gonews/save_service/internal/models"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func (s *SaveService) RecordNotification(ctx context.Context, notification *models.Notification) error {
+	return s.newsStorage.RecordNotification(ctx, notification)
+}
+
+// ExportUserData - выгрузка всех данных пользователя одним JSON-документом или ZIP-архивом по разделам
+func (s *SaveService) ExportUserData(ctx context.Context, userID uint64, format string) (*models.ExportFile, error) {
+	export, err := s.newsStorage.ExportUserData(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	name := fmt.Sprintf("user-%d-export-%s", userID, export.ExportedAt.Format("20060102T150405Z"))
+
+	if format == models.ExportFormatZIP {
+		data, err := exportZIP(export)
+		if err != nil {
+			return nil, err
+		}
+		return &models.ExportFile{Data: data, ContentType: "application/zip", Filename: name + ".zip"}, nil
+	}
+
+	data, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal export")
+	}
+	return &models.ExportFile{Data: data, ContentType: "application/json", Filename: name + ".json"}, nil
+}
+
+// exportZIP - архив с отдельным JSON-файлом на каждый раздел выгрузки
+func exportZIP(export *models.UserExport) ([]byte, error) {
+	sections := []struct {
+		name string
+		data any
+	}{
+		{"profile.json", struct {
+			ExportedAt time.Time    `json:"exported_at"`
+			Profile    *models.User `json:"profile"`
+		}{export.ExportedAt, export.Profile}},
+		{"collections.json", export.Collections},
+		{"annotations.json", export.Annotations},
+		{"search_history.json", export.SearchHistory},
+		{"saved_searches.json", export.SavedSearches},
+		{"subscriptions.json", export.Subscriptions},
+		{"seen_news.json", export.SeenNews},
+		{"notifications.json", export.Notifications},
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for _, section := range sections {
+		w, err := archive.Create(section.name)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create archive entry")
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(section.data); err != nil {
+			return nil, errors.Wrapf(err, "failed to write %s", section.name)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to close archive")
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (s *SaveService) DeleteUser(ctx context.Context, userID uint64) error {
+	return s.newsStorage.DeleteUser(ctx, userID)
+}
@@ -70,17 +70,2228 @@ func (_c *MockNewsStorage_AddFavourite_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
-// AddToSearchHistory provides a mock function with given fields: ctx, userID, query, results
-func (_m *MockNewsStorage) AddToSearchHistory(ctx context.Context, userID uint64, query string, results []uint64) error {
-	ret := _m.Called(ctx, userID, query, results)
+// AddHighlight provides a mock function with given fields: ctx, highlight
+func (_m *MockNewsStorage) AddHighlight(ctx context.Context, highlight *models.Highlight) (*models.Highlight, error) {
+	ret := _m.Called(ctx, highlight)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddHighlight")
+	}
+
+	var r0 *models.Highlight
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Highlight) (*models.Highlight, error)); ok {
+		return rf(ctx, highlight)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Highlight) *models.Highlight); ok {
+		r0 = rf(ctx, highlight)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Highlight)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.Highlight) error); ok {
+		r1 = rf(ctx, highlight)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNewsStorage_AddHighlight_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddHighlight'
+type MockNewsStorage_AddHighlight_Call struct {
+	*mock.Call
+}
+
+// AddHighlight is a helper method to define mock.On call
+//   - ctx context.Context
+//   - highlight *models.Highlight
+func (_e *MockNewsStorage_Expecter) AddHighlight(ctx interface{}, highlight interface{}) *MockNewsStorage_AddHighlight_Call {
+	return &MockNewsStorage_AddHighlight_Call{Call: _e.mock.On("AddHighlight", ctx, highlight)}
+}
+
+func (_c *MockNewsStorage_AddHighlight_Call) Run(run func(ctx context.Context, highlight *models.Highlight)) *MockNewsStorage_AddHighlight_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.Highlight))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_AddHighlight_Call) Return(_a0 *models.Highlight, _a1 error) *MockNewsStorage_AddHighlight_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNewsStorage_AddHighlight_Call) RunAndReturn(run func(context.Context, *models.Highlight) (*models.Highlight, error)) *MockNewsStorage_AddHighlight_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddToCollection provides a mock function with given fields: ctx, userID, collectionID, newsID
+func (_m *MockNewsStorage) AddToCollection(ctx context.Context, userID uint64, collectionID uint64, newsID uint64) error {
+	ret := _m.Called(ctx, userID, collectionID, newsID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddToCollection")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, uint64) error); ok {
+		r0 = rf(ctx, userID, collectionID, newsID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNewsStorage_AddToCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddToCollection'
+type MockNewsStorage_AddToCollection_Call struct {
+	*mock.Call
+}
+
+// AddToCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - collectionID uint64
+//   - newsID uint64
+func (_e *MockNewsStorage_Expecter) AddToCollection(ctx interface{}, userID interface{}, collectionID interface{}, newsID interface{}) *MockNewsStorage_AddToCollection_Call {
+	return &MockNewsStorage_AddToCollection_Call{Call: _e.mock.On("AddToCollection", ctx, userID, collectionID, newsID)}
+}
+
+func (_c *MockNewsStorage_AddToCollection_Call) Run(run func(ctx context.Context, userID uint64, collectionID uint64, newsID uint64)) *MockNewsStorage_AddToCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_AddToCollection_Call) Return(_a0 error) *MockNewsStorage_AddToCollection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNewsStorage_AddToCollection_Call) RunAndReturn(run func(context.Context, uint64, uint64, uint64) error) *MockNewsStorage_AddToCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddToSearchHistory provides a mock function with given fields: ctx, entry
+func (_m *MockNewsStorage) AddToSearchHistory(ctx context.Context, entry *models.SearchHistoryEntry) error {
+	ret := _m.Called(ctx, entry)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AddToSearchHistory")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, []uint64) error); ok {
-		r0 = rf(ctx, userID, query, results)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.SearchHistoryEntry) error); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNewsStorage_AddToSearchHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddToSearchHistory'
+type MockNewsStorage_AddToSearchHistory_Call struct {
+	*mock.Call
+}
+
+// AddToSearchHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry *models.SearchHistoryEntry
+func (_e *MockNewsStorage_Expecter) AddToSearchHistory(ctx interface{}, entry interface{}) *MockNewsStorage_AddToSearchHistory_Call {
+	return &MockNewsStorage_AddToSearchHistory_Call{Call: _e.mock.On("AddToSearchHistory", ctx, entry)}
+}
+
+func (_c *MockNewsStorage_AddToSearchHistory_Call) Run(run func(ctx context.Context, entry *models.SearchHistoryEntry)) *MockNewsStorage_AddToSearchHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.SearchHistoryEntry))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_AddToSearchHistory_Call) Return(_a0 error) *MockNewsStorage_AddToSearchHistory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNewsStorage_AddToSearchHistory_Call) RunAndReturn(run func(context.Context, *models.SearchHistoryEntry) error) *MockNewsStorage_AddToSearchHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CopyCollectionItems provides a mock function with given fields: ctx, userID, fromID, toID, newsIDs, move
+func (_m *MockNewsStorage) CopyCollectionItems(ctx context.Context, userID uint64, fromID uint64, toID uint64, newsIDs []uint64, move bool) error {
+	ret := _m.Called(ctx, userID, fromID, toID, newsIDs, move)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CopyCollectionItems")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, uint64, []uint64, bool) error); ok {
+		r0 = rf(ctx, userID, fromID, toID, newsIDs, move)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNewsStorage_CopyCollectionItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyCollectionItems'
+type MockNewsStorage_CopyCollectionItems_Call struct {
+	*mock.Call
+}
+
+// CopyCollectionItems is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - fromID uint64
+//   - toID uint64
+//   - newsIDs []uint64
+//   - move bool
+func (_e *MockNewsStorage_Expecter) CopyCollectionItems(ctx interface{}, userID interface{}, fromID interface{}, toID interface{}, newsIDs interface{}, move interface{}) *MockNewsStorage_CopyCollectionItems_Call {
+	return &MockNewsStorage_CopyCollectionItems_Call{Call: _e.mock.On("CopyCollectionItems", ctx, userID, fromID, toID, newsIDs, move)}
+}
+
+func (_c *MockNewsStorage_CopyCollectionItems_Call) Run(run func(ctx context.Context, userID uint64, fromID uint64, toID uint64, newsIDs []uint64, move bool)) *MockNewsStorage_CopyCollectionItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(uint64), args[4].([]uint64), args[5].(bool))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_CopyCollectionItems_Call) Return(_a0 error) *MockNewsStorage_CopyCollectionItems_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNewsStorage_CopyCollectionItems_Call) RunAndReturn(run func(context.Context, uint64, uint64, uint64, []uint64, bool) error) *MockNewsStorage_CopyCollectionItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateAPIKey provides a mock function with given fields: ctx, key, keyHash
+func (_m *MockNewsStorage) CreateAPIKey(ctx context.Context, key *models.APIKey, keyHash string) (*models.APIKey, error) {
+	ret := _m.Called(ctx, key, keyHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAPIKey")
+	}
+
+	var r0 *models.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.APIKey, string) (*models.APIKey, error)); ok {
+		return rf(ctx, key, keyHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.APIKey, string) *models.APIKey); ok {
+		r0 = rf(ctx, key, keyHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.APIKey, string) error); ok {
+		r1 = rf(ctx, key, keyHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNewsStorage_CreateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAPIKey'
+type MockNewsStorage_CreateAPIKey_Call struct {
+	*mock.Call
+}
+
+// CreateAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key *models.APIKey
+//   - keyHash string
+func (_e *MockNewsStorage_Expecter) CreateAPIKey(ctx interface{}, key interface{}, keyHash interface{}) *MockNewsStorage_CreateAPIKey_Call {
+	return &MockNewsStorage_CreateAPIKey_Call{Call: _e.mock.On("CreateAPIKey", ctx, key, keyHash)}
+}
+
+func (_c *MockNewsStorage_CreateAPIKey_Call) Run(run func(ctx context.Context, key *models.APIKey, keyHash string)) *MockNewsStorage_CreateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.APIKey), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_CreateAPIKey_Call) Return(_a0 *models.APIKey, _a1 error) *MockNewsStorage_CreateAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNewsStorage_CreateAPIKey_Call) RunAndReturn(run func(context.Context, *models.APIKey, string) (*models.APIKey, error)) *MockNewsStorage_CreateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCollection provides a mock function with given fields: ctx, userID, name
+func (_m *MockNewsStorage) CreateCollection(ctx context.Context, userID uint64, name string) (*models.Collection, error) {
+	ret := _m.Called(ctx, userID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCollection")
+	}
+
+	var r0 *models.Collection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string) (*models.Collection, error)); ok {
+		return rf(ctx, userID, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string) *models.Collection); ok {
+		r0 = rf(ctx, userID, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, string) error); ok {
+		r1 = rf(ctx, userID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNewsStorage_CreateCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCollection'
+type MockNewsStorage_CreateCollection_Call struct {
+	*mock.Call
+}
+
+// CreateCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - name string
+func (_e *MockNewsStorage_Expecter) CreateCollection(ctx interface{}, userID interface{}, name interface{}) *MockNewsStorage_CreateCollection_Call {
+	return &MockNewsStorage_CreateCollection_Call{Call: _e.mock.On("CreateCollection", ctx, userID, name)}
+}
+
+func (_c *MockNewsStorage_CreateCollection_Call) Run(run func(ctx context.Context, userID uint64, name string)) *MockNewsStorage_CreateCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_CreateCollection_Call) Return(_a0 *models.Collection, _a1 error) *MockNewsStorage_CreateCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNewsStorage_CreateCollection_Call) RunAndReturn(run func(context.Context, uint64, string) (*models.Collection, error)) *MockNewsStorage_CreateCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSavedSearch provides a mock function with given fields: ctx, savedSearch
+func (_m *MockNewsStorage) CreateSavedSearch(ctx context.Context, savedSearch *models.SavedSearch) (*models.SavedSearch, error) {
+	ret := _m.Called(ctx, savedSearch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSavedSearch")
+	}
+
+	var r0 *models.SavedSearch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.SavedSearch) (*models.SavedSearch, error)); ok {
+		return rf(ctx, savedSearch)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.SavedSearch) *models.SavedSearch); ok {
+		r0 = rf(ctx, savedSearch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SavedSearch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.SavedSearch) error); ok {
+		r1 = rf(ctx, savedSearch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNewsStorage_CreateSavedSearch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSavedSearch'
+type MockNewsStorage_CreateSavedSearch_Call struct {
+	*mock.Call
+}
+
+// CreateSavedSearch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - savedSearch *models.SavedSearch
+func (_e *MockNewsStorage_Expecter) CreateSavedSearch(ctx interface{}, savedSearch interface{}) *MockNewsStorage_CreateSavedSearch_Call {
+	return &MockNewsStorage_CreateSavedSearch_Call{Call: _e.mock.On("CreateSavedSearch", ctx, savedSearch)}
+}
+
+func (_c *MockNewsStorage_CreateSavedSearch_Call) Run(run func(ctx context.Context, savedSearch *models.SavedSearch)) *MockNewsStorage_CreateSavedSearch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.SavedSearch))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_CreateSavedSearch_Call) Return(_a0 *models.SavedSearch, _a1 error) *MockNewsStorage_CreateSavedSearch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNewsStorage_CreateSavedSearch_Call) RunAndReturn(run func(context.Context, *models.SavedSearch) (*models.SavedSearch, error)) *MockNewsStorage_CreateSavedSearch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateUser provides a mock function with given fields: ctx, user
+func (_m *MockNewsStorage) CreateUser(ctx context.Context, user *models.User) (*models.User, error) {
+	ret := _m.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUser")
+	}
+
+	var r0 *models.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.User) (*models.User, error)); ok {
+		return rf(ctx, user)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.User) *models.User); ok {
+		r0 = rf(ctx, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.User) error); ok {
+		r1 = rf(ctx, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNewsStorage_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
+type MockNewsStorage_CreateUser_Call struct {
+	*mock.Call
+}
+
+// CreateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *models.User
+func (_e *MockNewsStorage_Expecter) CreateUser(ctx interface{}, user interface{}) *MockNewsStorage_CreateUser_Call {
+	return &MockNewsStorage_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, user)}
+}
+
+func (_c *MockNewsStorage_CreateUser_Call) Run(run func(ctx context.Context, user *models.User)) *MockNewsStorage_CreateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.User))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_CreateUser_Call) Return(_a0 *models.User, _a1 error) *MockNewsStorage_CreateUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNewsStorage_CreateUser_Call) RunAndReturn(run func(context.Context, *models.User) (*models.User, error)) *MockNewsStorage_CreateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCollection provides a mock function with given fields: ctx, userID, collectionID
+func (_m *MockNewsStorage) DeleteCollection(ctx context.Context, userID uint64, collectionID uint64) error {
+	ret := _m.Called(ctx, userID, collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCollection")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) error); ok {
+		r0 = rf(ctx, userID, collectionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNewsStorage_DeleteCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCollection'
+type MockNewsStorage_DeleteCollection_Call struct {
+	*mock.Call
+}
+
+// DeleteCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - collectionID uint64
+func (_e *MockNewsStorage_Expecter) DeleteCollection(ctx interface{}, userID interface{}, collectionID interface{}) *MockNewsStorage_DeleteCollection_Call {
+	return &MockNewsStorage_DeleteCollection_Call{Call: _e.mock.On("DeleteCollection", ctx, userID, collectionID)}
+}
+
+func (_c *MockNewsStorage_DeleteCollection_Call) Run(run func(ctx context.Context, userID uint64, collectionID uint64)) *MockNewsStorage_DeleteCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_DeleteCollection_Call) Return(_a0 error) *MockNewsStorage_DeleteCollection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNewsStorage_DeleteCollection_Call) RunAndReturn(run func(context.Context, uint64, uint64) error) *MockNewsStorage_DeleteCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteHighlight provides a mock function with given fields: ctx, userID, newsID, highlightID
+func (_m *MockNewsStorage) DeleteHighlight(ctx context.Context, userID uint64, newsID uint64, highlightID uint64) error {
+	ret := _m.Called(ctx, userID, newsID, highlightID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteHighlight")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, uint64) error); ok {
+		r0 = rf(ctx, userID, newsID, highlightID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNewsStorage_DeleteHighlight_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteHighlight'
+type MockNewsStorage_DeleteHighlight_Call struct {
+	*mock.Call
+}
+
+// DeleteHighlight is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - newsID uint64
+//   - highlightID uint64
+func (_e *MockNewsStorage_Expecter) DeleteHighlight(ctx interface{}, userID interface{}, newsID interface{}, highlightID interface{}) *MockNewsStorage_DeleteHighlight_Call {
+	return &MockNewsStorage_DeleteHighlight_Call{Call: _e.mock.On("DeleteHighlight", ctx, userID, newsID, highlightID)}
+}
+
+func (_c *MockNewsStorage_DeleteHighlight_Call) Run(run func(ctx context.Context, userID uint64, newsID uint64, highlightID uint64)) *MockNewsStorage_DeleteHighlight_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_DeleteHighlight_Call) Return(_a0 error) *MockNewsStorage_DeleteHighlight_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNewsStorage_DeleteHighlight_Call) RunAndReturn(run func(context.Context, uint64, uint64, uint64) error) *MockNewsStorage_DeleteHighlight_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSavedSearch provides a mock function with given fields: ctx, userID, id
+func (_m *MockNewsStorage) DeleteSavedSearch(ctx context.Context, userID uint64, id uint64) error {
+	ret := _m.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSavedSearch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) error); ok {
+		r0 = rf(ctx, userID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNewsStorage_DeleteSavedSearch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSavedSearch'
+type MockNewsStorage_DeleteSavedSearch_Call struct {
+	*mock.Call
+}
+
+// DeleteSavedSearch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - id uint64
+func (_e *MockNewsStorage_Expecter) DeleteSavedSearch(ctx interface{}, userID interface{}, id interface{}) *MockNewsStorage_DeleteSavedSearch_Call {
+	return &MockNewsStorage_DeleteSavedSearch_Call{Call: _e.mock.On("DeleteSavedSearch", ctx, userID, id)}
+}
+
+func (_c *MockNewsStorage_DeleteSavedSearch_Call) Run(run func(ctx context.Context, userID uint64, id uint64)) *MockNewsStorage_DeleteSavedSearch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_DeleteSavedSearch_Call) Return(_a0 error) *MockNewsStorage_DeleteSavedSearch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNewsStorage_DeleteSavedSearch_Call) RunAndReturn(run func(context.Context, uint64, uint64) error) *MockNewsStorage_DeleteSavedSearch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUser provides a mock function with given fields: ctx, userID
+func (_m *MockNewsStorage) DeleteUser(ctx context.Context, userID uint64) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNewsStorage_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
+type MockNewsStorage_DeleteUser_Call struct {
+	*mock.Call
+}
+
+// DeleteUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+func (_e *MockNewsStorage_Expecter) DeleteUser(ctx interface{}, userID interface{}) *MockNewsStorage_DeleteUser_Call {
+	return &MockNewsStorage_DeleteUser_Call{Call: _e.mock.On("DeleteUser", ctx, userID)}
+}
+
+func (_c *MockNewsStorage_DeleteUser_Call) Run(run func(ctx context.Context, userID uint64)) *MockNewsStorage_DeleteUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_DeleteUser_Call) Return(_a0 error) *MockNewsStorage_DeleteUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNewsStorage_DeleteUser_Call) RunAndReturn(run func(context.Context, uint64) error) *MockNewsStorage_DeleteUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportUserData provides a mock function with given fields: ctx, userID
+func (_m *MockNewsStorage) ExportUserData(ctx context.Context, userID uint64) (*models.UserExport, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportUserData")
+	}
+
+	var r0 *models.UserExport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (*models.UserExport, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *models.UserExport); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.UserExport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNewsStorage_ExportUserData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportUserData'
+type MockNewsStorage_ExportUserData_Call struct {
+	*mock.Call
+}
+
+// ExportUserData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+func (_e *MockNewsStorage_Expecter) ExportUserData(ctx interface{}, userID interface{}) *MockNewsStorage_ExportUserData_Call {
+	return &MockNewsStorage_ExportUserData_Call{Call: _e.mock.On("ExportUserData", ctx, userID)}
+}
+
+func (_c *MockNewsStorage_ExportUserData_Call) Run(run func(ctx context.Context, userID uint64)) *MockNewsStorage_ExportUserData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_ExportUserData_Call) Return(_a0 *models.UserExport, _a1 error) *MockNewsStorage_ExportUserData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNewsStorage_ExportUserData_Call) RunAndReturn(run func(context.Context, uint64) (*models.UserExport, error)) *MockNewsStorage_ExportUserData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAPIKeyByHash provides a mock function with given fields: ctx, keyHash
+func (_m *MockNewsStorage) GetAPIKeyByHash(ctx context.Context, keyHash string) (*models.APIKey, error) {
+	ret := _m.Called(ctx, keyHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAPIKeyByHash")
+	}
+
+	var r0 *models.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.APIKey, error)); ok {
+		return rf(ctx, keyHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.APIKey); ok {
+		r0 = rf(ctx, keyHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, keyHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNewsStorage_GetAPIKeyByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAPIKeyByHash'
+type MockNewsStorage_GetAPIKeyByHash_Call struct {
+	*mock.Call
+}
+
+// GetAPIKeyByHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keyHash string
+func (_e *MockNewsStorage_Expecter) GetAPIKeyByHash(ctx interface{}, keyHash interface{}) *MockNewsStorage_GetAPIKeyByHash_Call {
+	return &MockNewsStorage_GetAPIKeyByHash_Call{Call: _e.mock.On("GetAPIKeyByHash", ctx, keyHash)}
+}
+
+func (_c *MockNewsStorage_GetAPIKeyByHash_Call) Run(run func(ctx context.Context, keyHash string)) *MockNewsStorage_GetAPIKeyByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_GetAPIKeyByHash_Call) Return(_a0 *models.APIKey, _a1 error) *MockNewsStorage_GetAPIKeyByHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNewsStorage_GetAPIKeyByHash_Call) RunAndReturn(run func(context.Context, string) (*models.APIKey, error)) *MockNewsStorage_GetAPIKeyByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCollectionItems provides a mock function with given fields: ctx, userID, collectionID, page
+func (_m *MockNewsStorage) GetCollectionItems(ctx context.Context, userID uint64, collectionID uint64, page models.PageRequest) (*models.Collection, []*models.News, string, error) {
+	ret := _m.Called(ctx, userID, collectionID, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionItems")
+	}
+
+	var r0 *models.Collection
+	var r1 []*models.News
+	var r2 string
+	var r3 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, models.PageRequest) (*models.Collection, []*models.News, string, error)); ok {
+		return rf(ctx, userID, collectionID, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, models.PageRequest) *models.Collection); ok {
+		r0 = rf(ctx, userID, collectionID, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, models.PageRequest) []*models.News); ok {
+		r1 = rf(ctx, userID, collectionID, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]*models.News)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uint64, uint64, models.PageRequest) string); ok {
+		r2 = rf(ctx, userID, collectionID, page)
+	} else {
+		r2 = ret.Get(2).(string)
+	}
+
+	if rf, ok := ret.Get(3).(func(context.Context, uint64, uint64, models.PageRequest) error); ok {
+		r3 = rf(ctx, userID, collectionID, page)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// MockNewsStorage_GetCollectionItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionItems'
+type MockNewsStorage_GetCollectionItems_Call struct {
+	*mock.Call
+}
+
+// GetCollectionItems is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - collectionID uint64
+//   - page models.PageRequest
+func (_e *MockNewsStorage_Expecter) GetCollectionItems(ctx interface{}, userID interface{}, collectionID interface{}, page interface{}) *MockNewsStorage_GetCollectionItems_Call {
+	return &MockNewsStorage_GetCollectionItems_Call{Call: _e.mock.On("GetCollectionItems", ctx, userID, collectionID, page)}
+}
+
+func (_c *MockNewsStorage_GetCollectionItems_Call) Run(run func(ctx context.Context, userID uint64, collectionID uint64, page models.PageRequest)) *MockNewsStorage_GetCollectionItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(models.PageRequest))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_GetCollectionItems_Call) Return(_a0 *models.Collection, _a1 []*models.News, _a2 string, _a3 error) *MockNewsStorage_GetCollectionItems_Call {
+	_c.Call.Return(_a0, _a1, _a2, _a3)
+	return _c
+}
+
+func (_c *MockNewsStorage_GetCollectionItems_Call) RunAndReturn(run func(context.Context, uint64, uint64, models.PageRequest) (*models.Collection, []*models.News, string, error)) *MockNewsStorage_GetCollectionItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFavouriteAnnotation provides a mock function with given fields: ctx, userID, newsID
+func (_m *MockNewsStorage) GetFavouriteAnnotation(ctx context.Context, userID uint64, newsID uint64) (*models.FavouriteAnnotation, error) {
+	ret := _m.Called(ctx, userID, newsID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFavouriteAnnotation")
+	}
+
+	var r0 *models.FavouriteAnnotation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) (*models.FavouriteAnnotation, error)); ok {
+		return rf(ctx, userID, newsID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) *models.FavouriteAnnotation); ok {
+		r0 = rf(ctx, userID, newsID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.FavouriteAnnotation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, userID, newsID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNewsStorage_GetFavouriteAnnotation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFavouriteAnnotation'
+type MockNewsStorage_GetFavouriteAnnotation_Call struct {
+	*mock.Call
+}
+
+// GetFavouriteAnnotation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - newsID uint64
+func (_e *MockNewsStorage_Expecter) GetFavouriteAnnotation(ctx interface{}, userID interface{}, newsID interface{}) *MockNewsStorage_GetFavouriteAnnotation_Call {
+	return &MockNewsStorage_GetFavouriteAnnotation_Call{Call: _e.mock.On("GetFavouriteAnnotation", ctx, userID, newsID)}
+}
+
+func (_c *MockNewsStorage_GetFavouriteAnnotation_Call) Run(run func(ctx context.Context, userID uint64, newsID uint64)) *MockNewsStorage_GetFavouriteAnnotation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_GetFavouriteAnnotation_Call) Return(_a0 *models.FavouriteAnnotation, _a1 error) *MockNewsStorage_GetFavouriteAnnotation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNewsStorage_GetFavouriteAnnotation_Call) RunAndReturn(run func(context.Context, uint64, uint64) (*models.FavouriteAnnotation, error)) *MockNewsStorage_GetFavouriteAnnotation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFavourites provides a mock function with given fields: ctx, userID, page, unseenOnly
+func (_m *MockNewsStorage) GetFavourites(ctx context.Context, userID uint64, page models.PageRequest, unseenOnly bool) ([]*models.News, string, error) {
+	ret := _m.Called(ctx, userID, page, unseenOnly)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFavourites")
+	}
+
+	var r0 []*models.News
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, models.PageRequest, bool) ([]*models.News, string, error)); ok {
+		return rf(ctx, userID, page, unseenOnly)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, models.PageRequest, bool) []*models.News); ok {
+		r0 = rf(ctx, userID, page, unseenOnly)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.News)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, models.PageRequest, bool) string); ok {
+		r1 = rf(ctx, userID, page, unseenOnly)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uint64, models.PageRequest, bool) error); ok {
+		r2 = rf(ctx, userID, page, unseenOnly)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockNewsStorage_GetFavourites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFavourites'
+type MockNewsStorage_GetFavourites_Call struct {
+	*mock.Call
+}
+
+// GetFavourites is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - page models.PageRequest
+//   - unseenOnly bool
+func (_e *MockNewsStorage_Expecter) GetFavourites(ctx interface{}, userID interface{}, page interface{}, unseenOnly interface{}) *MockNewsStorage_GetFavourites_Call {
+	return &MockNewsStorage_GetFavourites_Call{Call: _e.mock.On("GetFavourites", ctx, userID, page, unseenOnly)}
+}
+
+func (_c *MockNewsStorage_GetFavourites_Call) Run(run func(ctx context.Context, userID uint64, page models.PageRequest, unseenOnly bool)) *MockNewsStorage_GetFavourites_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(models.PageRequest), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_GetFavourites_Call) Return(_a0 []*models.News, _a1 string, _a2 error) *MockNewsStorage_GetFavourites_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockNewsStorage_GetFavourites_Call) RunAndReturn(run func(context.Context, uint64, models.PageRequest, bool) ([]*models.News, string, error)) *MockNewsStorage_GetFavourites_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNewsByIDs provides a mock function with given fields: ctx, IDs
+func (_m *MockNewsStorage) GetNewsByIDs(ctx context.Context, IDs []uint64) ([]*models.News, error) {
+	ret := _m.Called(ctx, IDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNewsByIDs")
+	}
+
+	var r0 []*models.News
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uint64) ([]*models.News, error)); ok {
+		return rf(ctx, IDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uint64) []*models.News); ok {
+		r0 = rf(ctx, IDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.News)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uint64) error); ok {
+		r1 = rf(ctx, IDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNewsStorage_GetNewsByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNewsByIDs'
+type MockNewsStorage_GetNewsByIDs_Call struct {
+	*mock.Call
+}
+
+// GetNewsByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - IDs []uint64
+func (_e *MockNewsStorage_Expecter) GetNewsByIDs(ctx interface{}, IDs interface{}) *MockNewsStorage_GetNewsByIDs_Call {
+	return &MockNewsStorage_GetNewsByIDs_Call{Call: _e.mock.On("GetNewsByIDs", ctx, IDs)}
+}
+
+func (_c *MockNewsStorage_GetNewsByIDs_Call) Run(run func(ctx context.Context, IDs []uint64)) *MockNewsStorage_GetNewsByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uint64))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_GetNewsByIDs_Call) Return(_a0 []*models.News, _a1 error) *MockNewsStorage_GetNewsByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNewsStorage_GetNewsByIDs_Call) RunAndReturn(run func(context.Context, []uint64) ([]*models.News, error)) *MockNewsStorage_GetNewsByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSavedSearch provides a mock function with given fields: ctx, userID, id
+func (_m *MockNewsStorage) GetSavedSearch(ctx context.Context, userID uint64, id uint64) (*models.SavedSearch, error) {
+	ret := _m.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSavedSearch")
+	}
+
+	var r0 *models.SavedSearch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) (*models.SavedSearch, error)); ok {
+		return rf(ctx, userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) *models.SavedSearch); ok {
+		r0 = rf(ctx, userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SavedSearch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, userID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNewsStorage_GetSavedSearch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSavedSearch'
+type MockNewsStorage_GetSavedSearch_Call struct {
+	*mock.Call
+}
+
+// GetSavedSearch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - id uint64
+func (_e *MockNewsStorage_Expecter) GetSavedSearch(ctx interface{}, userID interface{}, id interface{}) *MockNewsStorage_GetSavedSearch_Call {
+	return &MockNewsStorage_GetSavedSearch_Call{Call: _e.mock.On("GetSavedSearch", ctx, userID, id)}
+}
+
+func (_c *MockNewsStorage_GetSavedSearch_Call) Run(run func(ctx context.Context, userID uint64, id uint64)) *MockNewsStorage_GetSavedSearch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_GetSavedSearch_Call) Return(_a0 *models.SavedSearch, _a1 error) *MockNewsStorage_GetSavedSearch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNewsStorage_GetSavedSearch_Call) RunAndReturn(run func(context.Context, uint64, uint64) (*models.SavedSearch, error)) *MockNewsStorage_GetSavedSearch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSearchHistory provides a mock function with given fields: ctx, userID, page, uniqueQueries
+func (_m *MockNewsStorage) GetSearchHistory(ctx context.Context, userID uint64, page models.PageRequest, uniqueQueries bool) ([]*models.SearchHistoryEntry, string, error) {
+	ret := _m.Called(ctx, userID, page, uniqueQueries)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSearchHistory")
+	}
+
+	var r0 []*models.SearchHistoryEntry
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, models.PageRequest, bool) ([]*models.SearchHistoryEntry, string, error)); ok {
+		return rf(ctx, userID, page, uniqueQueries)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, models.PageRequest, bool) []*models.SearchHistoryEntry); ok {
+		r0 = rf(ctx, userID, page, uniqueQueries)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.SearchHistoryEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, models.PageRequest, bool) string); ok {
+		r1 = rf(ctx, userID, page, uniqueQueries)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uint64, models.PageRequest, bool) error); ok {
+		r2 = rf(ctx, userID, page, uniqueQueries)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockNewsStorage_GetSearchHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSearchHistory'
+type MockNewsStorage_GetSearchHistory_Call struct {
+	*mock.Call
+}
+
+// GetSearchHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - page models.PageRequest
+//   - uniqueQueries bool
+func (_e *MockNewsStorage_Expecter) GetSearchHistory(ctx interface{}, userID interface{}, page interface{}, uniqueQueries interface{}) *MockNewsStorage_GetSearchHistory_Call {
+	return &MockNewsStorage_GetSearchHistory_Call{Call: _e.mock.On("GetSearchHistory", ctx, userID, page, uniqueQueries)}
+}
+
+func (_c *MockNewsStorage_GetSearchHistory_Call) Run(run func(ctx context.Context, userID uint64, page models.PageRequest, uniqueQueries bool)) *MockNewsStorage_GetSearchHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(models.PageRequest), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_GetSearchHistory_Call) Return(_a0 []*models.SearchHistoryEntry, _a1 string, _a2 error) *MockNewsStorage_GetSearchHistory_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockNewsStorage_GetSearchHistory_Call) RunAndReturn(run func(context.Context, uint64, models.PageRequest, bool) ([]*models.SearchHistoryEntry, string, error)) *MockNewsStorage_GetSearchHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSearchHistoryEntry provides a mock function with given fields: ctx, userID, searchID
+func (_m *MockNewsStorage) GetSearchHistoryEntry(ctx context.Context, userID uint64, searchID uint64) (*models.SearchHistoryEntry, error) {
+	ret := _m.Called(ctx, userID, searchID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSearchHistoryEntry")
+	}
+
+	var r0 *models.SearchHistoryEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) (*models.SearchHistoryEntry, error)); ok {
+		return rf(ctx, userID, searchID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) *models.SearchHistoryEntry); ok {
+		r0 = rf(ctx, userID, searchID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SearchHistoryEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, userID, searchID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNewsStorage_GetSearchHistoryEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSearchHistoryEntry'
+type MockNewsStorage_GetSearchHistoryEntry_Call struct {
+	*mock.Call
+}
+
+// GetSearchHistoryEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - searchID uint64
+func (_e *MockNewsStorage_Expecter) GetSearchHistoryEntry(ctx interface{}, userID interface{}, searchID interface{}) *MockNewsStorage_GetSearchHistoryEntry_Call {
+	return &MockNewsStorage_GetSearchHistoryEntry_Call{Call: _e.mock.On("GetSearchHistoryEntry", ctx, userID, searchID)}
+}
+
+func (_c *MockNewsStorage_GetSearchHistoryEntry_Call) Run(run func(ctx context.Context, userID uint64, searchID uint64)) *MockNewsStorage_GetSearchHistoryEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_GetSearchHistoryEntry_Call) Return(_a0 *models.SearchHistoryEntry, _a1 error) *MockNewsStorage_GetSearchHistoryEntry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNewsStorage_GetSearchHistoryEntry_Call) RunAndReturn(run func(context.Context, uint64, uint64) (*models.SearchHistoryEntry, error)) *MockNewsStorage_GetSearchHistoryEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSeenNews provides a mock function with given fields: ctx, userID, newsIDs, page
+func (_m *MockNewsStorage) GetSeenNews(ctx context.Context, userID uint64, newsIDs []uint64, page models.PageRequest) ([]*models.UserToSeenNews, string, error) {
+	ret := _m.Called(ctx, userID, newsIDs, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSeenNews")
+	}
+
+	var r0 []*models.UserToSeenNews
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, []uint64, models.PageRequest) ([]*models.UserToSeenNews, string, error)); ok {
+		return rf(ctx, userID, newsIDs, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, []uint64, models.PageRequest) []*models.UserToSeenNews); ok {
+		r0 = rf(ctx, userID, newsIDs, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.UserToSeenNews)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, []uint64, models.PageRequest) string); ok {
+		r1 = rf(ctx, userID, newsIDs, page)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uint64, []uint64, models.PageRequest) error); ok {
+		r2 = rf(ctx, userID, newsIDs, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockNewsStorage_GetSeenNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeenNews'
+type MockNewsStorage_GetSeenNews_Call struct {
+	*mock.Call
+}
+
+// GetSeenNews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - newsIDs []uint64
+//   - page models.PageRequest
+func (_e *MockNewsStorage_Expecter) GetSeenNews(ctx interface{}, userID interface{}, newsIDs interface{}, page interface{}) *MockNewsStorage_GetSeenNews_Call {
+	return &MockNewsStorage_GetSeenNews_Call{Call: _e.mock.On("GetSeenNews", ctx, userID, newsIDs, page)}
+}
+
+func (_c *MockNewsStorage_GetSeenNews_Call) Run(run func(ctx context.Context, userID uint64, newsIDs []uint64, page models.PageRequest)) *MockNewsStorage_GetSeenNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].([]uint64), args[3].(models.PageRequest))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_GetSeenNews_Call) Return(_a0 []*models.UserToSeenNews, _a1 string, _a2 error) *MockNewsStorage_GetSeenNews_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockNewsStorage_GetSeenNews_Call) RunAndReturn(run func(context.Context, uint64, []uint64, models.PageRequest) ([]*models.UserToSeenNews, string, error)) *MockNewsStorage_GetSeenNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSharedCollection provides a mock function with given fields: ctx, shareToken, page
+func (_m *MockNewsStorage) GetSharedCollection(ctx context.Context, shareToken string, page models.PageRequest) (*models.Collection, []*models.News, string, error) {
+	ret := _m.Called(ctx, shareToken, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSharedCollection")
+	}
+
+	var r0 *models.Collection
+	var r1 []*models.News
+	var r2 string
+	var r3 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.PageRequest) (*models.Collection, []*models.News, string, error)); ok {
+		return rf(ctx, shareToken, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.PageRequest) *models.Collection); ok {
+		r0 = rf(ctx, shareToken, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, models.PageRequest) []*models.News); ok {
+		r1 = rf(ctx, shareToken, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]*models.News)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, models.PageRequest) string); ok {
+		r2 = rf(ctx, shareToken, page)
+	} else {
+		r2 = ret.Get(2).(string)
+	}
+
+	if rf, ok := ret.Get(3).(func(context.Context, string, models.PageRequest) error); ok {
+		r3 = rf(ctx, shareToken, page)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// MockNewsStorage_GetSharedCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSharedCollection'
+type MockNewsStorage_GetSharedCollection_Call struct {
+	*mock.Call
+}
+
+// GetSharedCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - shareToken string
+//   - page models.PageRequest
+func (_e *MockNewsStorage_Expecter) GetSharedCollection(ctx interface{}, shareToken interface{}, page interface{}) *MockNewsStorage_GetSharedCollection_Call {
+	return &MockNewsStorage_GetSharedCollection_Call{Call: _e.mock.On("GetSharedCollection", ctx, shareToken, page)}
+}
+
+func (_c *MockNewsStorage_GetSharedCollection_Call) Run(run func(ctx context.Context, shareToken string, page models.PageRequest)) *MockNewsStorage_GetSharedCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(models.PageRequest))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_GetSharedCollection_Call) Return(_a0 *models.Collection, _a1 []*models.News, _a2 string, _a3 error) *MockNewsStorage_GetSharedCollection_Call {
+	_c.Call.Return(_a0, _a1, _a2, _a3)
+	return _c
+}
+
+func (_c *MockNewsStorage_GetSharedCollection_Call) RunAndReturn(run func(context.Context, string, models.PageRequest) (*models.Collection, []*models.News, string, error)) *MockNewsStorage_GetSharedCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSubscriptions provides a mock function with given fields: ctx, userID, page
+func (_m *MockNewsStorage) GetSubscriptions(ctx context.Context, userID uint64, page models.PageRequest) ([]*models.Subscription, string, error) {
+	ret := _m.Called(ctx, userID, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubscriptions")
+	}
+
+	var r0 []*models.Subscription
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, models.PageRequest) ([]*models.Subscription, string, error)); ok {
+		return rf(ctx, userID, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, models.PageRequest) []*models.Subscription); ok {
+		r0 = rf(ctx, userID, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, models.PageRequest) string); ok {
+		r1 = rf(ctx, userID, page)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uint64, models.PageRequest) error); ok {
+		r2 = rf(ctx, userID, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockNewsStorage_GetSubscriptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubscriptions'
+type MockNewsStorage_GetSubscriptions_Call struct {
+	*mock.Call
+}
+
+// GetSubscriptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - page models.PageRequest
+func (_e *MockNewsStorage_Expecter) GetSubscriptions(ctx interface{}, userID interface{}, page interface{}) *MockNewsStorage_GetSubscriptions_Call {
+	return &MockNewsStorage_GetSubscriptions_Call{Call: _e.mock.On("GetSubscriptions", ctx, userID, page)}
+}
+
+func (_c *MockNewsStorage_GetSubscriptions_Call) Run(run func(ctx context.Context, userID uint64, page models.PageRequest)) *MockNewsStorage_GetSubscriptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(models.PageRequest))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_GetSubscriptions_Call) Return(_a0 []*models.Subscription, _a1 string, _a2 error) *MockNewsStorage_GetSubscriptions_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockNewsStorage_GetSubscriptions_Call) RunAndReturn(run func(context.Context, uint64, models.PageRequest) ([]*models.Subscription, string, error)) *MockNewsStorage_GetSubscriptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUser provides a mock function with given fields: ctx, userID
+func (_m *MockNewsStorage) GetUser(ctx context.Context, userID uint64) (*models.User, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUser")
+	}
+
+	var r0 *models.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (*models.User, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *models.User); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNewsStorage_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
+type MockNewsStorage_GetUser_Call struct {
+	*mock.Call
+}
+
+// GetUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+func (_e *MockNewsStorage_Expecter) GetUser(ctx interface{}, userID interface{}) *MockNewsStorage_GetUser_Call {
+	return &MockNewsStorage_GetUser_Call{Call: _e.mock.On("GetUser", ctx, userID)}
+}
+
+func (_c *MockNewsStorage_GetUser_Call) Run(run func(ctx context.Context, userID uint64)) *MockNewsStorage_GetUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_GetUser_Call) Return(_a0 *models.User, _a1 error) *MockNewsStorage_GetUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNewsStorage_GetUser_Call) RunAndReturn(run func(context.Context, uint64) (*models.User, error)) *MockNewsStorage_GetUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByHandle provides a mock function with given fields: ctx, handle
+func (_m *MockNewsStorage) GetUserByHandle(ctx context.Context, handle string) (*models.User, error) {
+	ret := _m.Called(ctx, handle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByHandle")
+	}
+
+	var r0 *models.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.User, error)); ok {
+		return rf(ctx, handle)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.User); ok {
+		r0 = rf(ctx, handle)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, handle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNewsStorage_GetUserByHandle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByHandle'
+type MockNewsStorage_GetUserByHandle_Call struct {
+	*mock.Call
+}
+
+// GetUserByHandle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - handle string
+func (_e *MockNewsStorage_Expecter) GetUserByHandle(ctx interface{}, handle interface{}) *MockNewsStorage_GetUserByHandle_Call {
+	return &MockNewsStorage_GetUserByHandle_Call{Call: _e.mock.On("GetUserByHandle", ctx, handle)}
+}
+
+func (_c *MockNewsStorage_GetUserByHandle_Call) Run(run func(ctx context.Context, handle string)) *MockNewsStorage_GetUserByHandle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_GetUserByHandle_Call) Return(_a0 *models.User, _a1 error) *MockNewsStorage_GetUserByHandle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNewsStorage_GetUserByHandle_Call) RunAndReturn(run func(context.Context, string) (*models.User, error)) *MockNewsStorage_GetUserByHandle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InitTables provides a mock function with given fields: ctx
+func (_m *MockNewsStorage) InitTables(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InitTables")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNewsStorage_InitTables_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InitTables'
+type MockNewsStorage_InitTables_Call struct {
+	*mock.Call
+}
+
+// InitTables is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockNewsStorage_Expecter) InitTables(ctx interface{}) *MockNewsStorage_InitTables_Call {
+	return &MockNewsStorage_InitTables_Call{Call: _e.mock.On("InitTables", ctx)}
+}
+
+func (_c *MockNewsStorage_InitTables_Call) Run(run func(ctx context.Context)) *MockNewsStorage_InitTables_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_InitTables_Call) Return(_a0 error) *MockNewsStorage_InitTables_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNewsStorage_InitTables_Call) RunAndReturn(run func(context.Context) error) *MockNewsStorage_InitTables_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAPIKeys provides a mock function with given fields: ctx, page, includeRevoked
+func (_m *MockNewsStorage) ListAPIKeys(ctx context.Context, page models.PageRequest, includeRevoked bool) ([]*models.APIKey, string, error) {
+	ret := _m.Called(ctx, page, includeRevoked)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAPIKeys")
+	}
+
+	var r0 []*models.APIKey
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.PageRequest, bool) ([]*models.APIKey, string, error)); ok {
+		return rf(ctx, page, includeRevoked)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.PageRequest, bool) []*models.APIKey); ok {
+		r0 = rf(ctx, page, includeRevoked)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.PageRequest, bool) string); ok {
+		r1 = rf(ctx, page, includeRevoked)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, models.PageRequest, bool) error); ok {
+		r2 = rf(ctx, page, includeRevoked)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockNewsStorage_ListAPIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAPIKeys'
+type MockNewsStorage_ListAPIKeys_Call struct {
+	*mock.Call
+}
+
+// ListAPIKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - page models.PageRequest
+//   - includeRevoked bool
+func (_e *MockNewsStorage_Expecter) ListAPIKeys(ctx interface{}, page interface{}, includeRevoked interface{}) *MockNewsStorage_ListAPIKeys_Call {
+	return &MockNewsStorage_ListAPIKeys_Call{Call: _e.mock.On("ListAPIKeys", ctx, page, includeRevoked)}
+}
+
+func (_c *MockNewsStorage_ListAPIKeys_Call) Run(run func(ctx context.Context, page models.PageRequest, includeRevoked bool)) *MockNewsStorage_ListAPIKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.PageRequest), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_ListAPIKeys_Call) Return(_a0 []*models.APIKey, _a1 string, _a2 error) *MockNewsStorage_ListAPIKeys_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockNewsStorage_ListAPIKeys_Call) RunAndReturn(run func(context.Context, models.PageRequest, bool) ([]*models.APIKey, string, error)) *MockNewsStorage_ListAPIKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCollections provides a mock function with given fields: ctx, userID, page
+func (_m *MockNewsStorage) ListCollections(ctx context.Context, userID uint64, page models.PageRequest) ([]*models.Collection, string, error) {
+	ret := _m.Called(ctx, userID, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCollections")
+	}
+
+	var r0 []*models.Collection
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, models.PageRequest) ([]*models.Collection, string, error)); ok {
+		return rf(ctx, userID, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, models.PageRequest) []*models.Collection); ok {
+		r0 = rf(ctx, userID, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, models.PageRequest) string); ok {
+		r1 = rf(ctx, userID, page)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uint64, models.PageRequest) error); ok {
+		r2 = rf(ctx, userID, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockNewsStorage_ListCollections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCollections'
+type MockNewsStorage_ListCollections_Call struct {
+	*mock.Call
+}
+
+// ListCollections is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - page models.PageRequest
+func (_e *MockNewsStorage_Expecter) ListCollections(ctx interface{}, userID interface{}, page interface{}) *MockNewsStorage_ListCollections_Call {
+	return &MockNewsStorage_ListCollections_Call{Call: _e.mock.On("ListCollections", ctx, userID, page)}
+}
+
+func (_c *MockNewsStorage_ListCollections_Call) Run(run func(ctx context.Context, userID uint64, page models.PageRequest)) *MockNewsStorage_ListCollections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(models.PageRequest))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_ListCollections_Call) Return(_a0 []*models.Collection, _a1 string, _a2 error) *MockNewsStorage_ListCollections_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockNewsStorage_ListCollections_Call) RunAndReturn(run func(context.Context, uint64, models.PageRequest) ([]*models.Collection, string, error)) *MockNewsStorage_ListCollections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSavedSearches provides a mock function with given fields: ctx, userID, page, dueOnly
+func (_m *MockNewsStorage) ListSavedSearches(ctx context.Context, userID uint64, page models.PageRequest, dueOnly bool) ([]*models.SavedSearch, string, error) {
+	ret := _m.Called(ctx, userID, page, dueOnly)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSavedSearches")
+	}
+
+	var r0 []*models.SavedSearch
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, models.PageRequest, bool) ([]*models.SavedSearch, string, error)); ok {
+		return rf(ctx, userID, page, dueOnly)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, models.PageRequest, bool) []*models.SavedSearch); ok {
+		r0 = rf(ctx, userID, page, dueOnly)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.SavedSearch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, models.PageRequest, bool) string); ok {
+		r1 = rf(ctx, userID, page, dueOnly)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uint64, models.PageRequest, bool) error); ok {
+		r2 = rf(ctx, userID, page, dueOnly)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockNewsStorage_ListSavedSearches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSavedSearches'
+type MockNewsStorage_ListSavedSearches_Call struct {
+	*mock.Call
+}
+
+// ListSavedSearches is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - page models.PageRequest
+//   - dueOnly bool
+func (_e *MockNewsStorage_Expecter) ListSavedSearches(ctx interface{}, userID interface{}, page interface{}, dueOnly interface{}) *MockNewsStorage_ListSavedSearches_Call {
+	return &MockNewsStorage_ListSavedSearches_Call{Call: _e.mock.On("ListSavedSearches", ctx, userID, page, dueOnly)}
+}
+
+func (_c *MockNewsStorage_ListSavedSearches_Call) Run(run func(ctx context.Context, userID uint64, page models.PageRequest, dueOnly bool)) *MockNewsStorage_ListSavedSearches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(models.PageRequest), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_ListSavedSearches_Call) Return(_a0 []*models.SavedSearch, _a1 string, _a2 error) *MockNewsStorage_ListSavedSearches_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockNewsStorage_ListSavedSearches_Call) RunAndReturn(run func(context.Context, uint64, models.PageRequest, bool) ([]*models.SavedSearch, string, error)) *MockNewsStorage_ListSavedSearches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUsers provides a mock function with given fields: ctx, page
+func (_m *MockNewsStorage) ListUsers(ctx context.Context, page models.PageRequest) ([]*models.User, string, error) {
+	ret := _m.Called(ctx, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUsers")
+	}
+
+	var r0 []*models.User
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.PageRequest) ([]*models.User, string, error)); ok {
+		return rf(ctx, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.PageRequest) []*models.User); ok {
+		r0 = rf(ctx, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.PageRequest) string); ok {
+		r1 = rf(ctx, page)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, models.PageRequest) error); ok {
+		r2 = rf(ctx, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockNewsStorage_ListUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUsers'
+type MockNewsStorage_ListUsers_Call struct {
+	*mock.Call
+}
+
+// ListUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - page models.PageRequest
+func (_e *MockNewsStorage_Expecter) ListUsers(ctx interface{}, page interface{}) *MockNewsStorage_ListUsers_Call {
+	return &MockNewsStorage_ListUsers_Call{Call: _e.mock.On("ListUsers", ctx, page)}
+}
+
+func (_c *MockNewsStorage_ListUsers_Call) Run(run func(ctx context.Context, page models.PageRequest)) *MockNewsStorage_ListUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.PageRequest))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_ListUsers_Call) Return(_a0 []*models.User, _a1 string, _a2 error) *MockNewsStorage_ListUsers_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockNewsStorage_ListUsers_Call) RunAndReturn(run func(context.Context, models.PageRequest) ([]*models.User, string, error)) *MockNewsStorage_ListUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkNewsAsSeen provides a mock function with given fields: ctx, userID, newsIDs
+func (_m *MockNewsStorage) MarkNewsAsSeen(ctx context.Context, userID uint64, newsIDs []uint64) error {
+	ret := _m.Called(ctx, userID, newsIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkNewsAsSeen")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, []uint64) error); ok {
+		r0 = rf(ctx, userID, newsIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNewsStorage_MarkNewsAsSeen_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkNewsAsSeen'
+type MockNewsStorage_MarkNewsAsSeen_Call struct {
+	*mock.Call
+}
+
+// MarkNewsAsSeen is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - newsIDs []uint64
+func (_e *MockNewsStorage_Expecter) MarkNewsAsSeen(ctx interface{}, userID interface{}, newsIDs interface{}) *MockNewsStorage_MarkNewsAsSeen_Call {
+	return &MockNewsStorage_MarkNewsAsSeen_Call{Call: _e.mock.On("MarkNewsAsSeen", ctx, userID, newsIDs)}
+}
+
+func (_c *MockNewsStorage_MarkNewsAsSeen_Call) Run(run func(ctx context.Context, userID uint64, newsIDs []uint64)) *MockNewsStorage_MarkNewsAsSeen_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].([]uint64))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_MarkNewsAsSeen_Call) Return(_a0 error) *MockNewsStorage_MarkNewsAsSeen_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNewsStorage_MarkNewsAsSeen_Call) RunAndReturn(run func(context.Context, uint64, []uint64) error) *MockNewsStorage_MarkNewsAsSeen_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkNewsAsUnseen provides a mock function with given fields: ctx, userID, newsIDs
+func (_m *MockNewsStorage) MarkNewsAsUnseen(ctx context.Context, userID uint64, newsIDs []uint64) error {
+	ret := _m.Called(ctx, userID, newsIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkNewsAsUnseen")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, []uint64) error); ok {
+		r0 = rf(ctx, userID, newsIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNewsStorage_MarkNewsAsUnseen_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkNewsAsUnseen'
+type MockNewsStorage_MarkNewsAsUnseen_Call struct {
+	*mock.Call
+}
+
+// MarkNewsAsUnseen is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - newsIDs []uint64
+func (_e *MockNewsStorage_Expecter) MarkNewsAsUnseen(ctx interface{}, userID interface{}, newsIDs interface{}) *MockNewsStorage_MarkNewsAsUnseen_Call {
+	return &MockNewsStorage_MarkNewsAsUnseen_Call{Call: _e.mock.On("MarkNewsAsUnseen", ctx, userID, newsIDs)}
+}
+
+func (_c *MockNewsStorage_MarkNewsAsUnseen_Call) Run(run func(ctx context.Context, userID uint64, newsIDs []uint64)) *MockNewsStorage_MarkNewsAsUnseen_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].([]uint64))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_MarkNewsAsUnseen_Call) Return(_a0 error) *MockNewsStorage_MarkNewsAsUnseen_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNewsStorage_MarkNewsAsUnseen_Call) RunAndReturn(run func(context.Context, uint64, []uint64) error) *MockNewsStorage_MarkNewsAsUnseen_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordNotification provides a mock function with given fields: ctx, notification
+func (_m *MockNewsStorage) RecordNotification(ctx context.Context, notification *models.Notification) error {
+	ret := _m.Called(ctx, notification)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordNotification")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Notification) error); ok {
+		r0 = rf(ctx, notification)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNewsStorage_RecordNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordNotification'
+type MockNewsStorage_RecordNotification_Call struct {
+	*mock.Call
+}
+
+// RecordNotification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - notification *models.Notification
+func (_e *MockNewsStorage_Expecter) RecordNotification(ctx interface{}, notification interface{}) *MockNewsStorage_RecordNotification_Call {
+	return &MockNewsStorage_RecordNotification_Call{Call: _e.mock.On("RecordNotification", ctx, notification)}
+}
+
+func (_c *MockNewsStorage_RecordNotification_Call) Run(run func(ctx context.Context, notification *models.Notification)) *MockNewsStorage_RecordNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.Notification))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_RecordNotification_Call) Return(_a0 error) *MockNewsStorage_RecordNotification_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNewsStorage_RecordNotification_Call) RunAndReturn(run func(context.Context, *models.Notification) error) *MockNewsStorage_RecordNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordSavedSearchRun provides a mock function with given fields: ctx, id, resultIDs
+func (_m *MockNewsStorage) RecordSavedSearchRun(ctx context.Context, id uint64, resultIDs []uint64) (*models.SavedSearch, error) {
+	ret := _m.Called(ctx, id, resultIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordSavedSearchRun")
+	}
+
+	var r0 *models.SavedSearch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, []uint64) (*models.SavedSearch, error)); ok {
+		return rf(ctx, id, resultIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, []uint64) *models.SavedSearch); ok {
+		r0 = rf(ctx, id, resultIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SavedSearch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, []uint64) error); ok {
+		r1 = rf(ctx, id, resultIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNewsStorage_RecordSavedSearchRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordSavedSearchRun'
+type MockNewsStorage_RecordSavedSearchRun_Call struct {
+	*mock.Call
+}
+
+// RecordSavedSearchRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint64
+//   - resultIDs []uint64
+func (_e *MockNewsStorage_Expecter) RecordSavedSearchRun(ctx interface{}, id interface{}, resultIDs interface{}) *MockNewsStorage_RecordSavedSearchRun_Call {
+	return &MockNewsStorage_RecordSavedSearchRun_Call{Call: _e.mock.On("RecordSavedSearchRun", ctx, id, resultIDs)}
+}
+
+func (_c *MockNewsStorage_RecordSavedSearchRun_Call) Run(run func(ctx context.Context, id uint64, resultIDs []uint64)) *MockNewsStorage_RecordSavedSearchRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].([]uint64))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_RecordSavedSearchRun_Call) Return(_a0 *models.SavedSearch, _a1 error) *MockNewsStorage_RecordSavedSearchRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNewsStorage_RecordSavedSearchRun_Call) RunAndReturn(run func(context.Context, uint64, []uint64) (*models.SavedSearch, error)) *MockNewsStorage_RecordSavedSearchRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveFromCollection provides a mock function with given fields: ctx, userID, collectionID, newsID
+func (_m *MockNewsStorage) RemoveFromCollection(ctx context.Context, userID uint64, collectionID uint64, newsID uint64) error {
+	ret := _m.Called(ctx, userID, collectionID, newsID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveFromCollection")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, uint64) error); ok {
+		r0 = rf(ctx, userID, collectionID, newsID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNewsStorage_RemoveFromCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveFromCollection'
+type MockNewsStorage_RemoveFromCollection_Call struct {
+	*mock.Call
+}
+
+// RemoveFromCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - collectionID uint64
+//   - newsID uint64
+func (_e *MockNewsStorage_Expecter) RemoveFromCollection(ctx interface{}, userID interface{}, collectionID interface{}, newsID interface{}) *MockNewsStorage_RemoveFromCollection_Call {
+	return &MockNewsStorage_RemoveFromCollection_Call{Call: _e.mock.On("RemoveFromCollection", ctx, userID, collectionID, newsID)}
+}
+
+func (_c *MockNewsStorage_RemoveFromCollection_Call) Run(run func(ctx context.Context, userID uint64, collectionID uint64, newsID uint64)) *MockNewsStorage_RemoveFromCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_RemoveFromCollection_Call) Return(_a0 error) *MockNewsStorage_RemoveFromCollection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNewsStorage_RemoveFromCollection_Call) RunAndReturn(run func(context.Context, uint64, uint64, uint64) error) *MockNewsStorage_RemoveFromCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenameCollection provides a mock function with given fields: ctx, userID, collectionID, name
+func (_m *MockNewsStorage) RenameCollection(ctx context.Context, userID uint64, collectionID uint64, name string) (*models.Collection, error) {
+	ret := _m.Called(ctx, userID, collectionID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameCollection")
+	}
+
+	var r0 *models.Collection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, string) (*models.Collection, error)); ok {
+		return rf(ctx, userID, collectionID, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, string) *models.Collection); ok {
+		r0 = rf(ctx, userID, collectionID, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, string) error); ok {
+		r1 = rf(ctx, userID, collectionID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNewsStorage_RenameCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameCollection'
+type MockNewsStorage_RenameCollection_Call struct {
+	*mock.Call
+}
+
+// RenameCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - collectionID uint64
+//   - name string
+func (_e *MockNewsStorage_Expecter) RenameCollection(ctx interface{}, userID interface{}, collectionID interface{}, name interface{}) *MockNewsStorage_RenameCollection_Call {
+	return &MockNewsStorage_RenameCollection_Call{Call: _e.mock.On("RenameCollection", ctx, userID, collectionID, name)}
+}
+
+func (_c *MockNewsStorage_RenameCollection_Call) Run(run func(ctx context.Context, userID uint64, collectionID uint64, name string)) *MockNewsStorage_RenameCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_RenameCollection_Call) Return(_a0 *models.Collection, _a1 error) *MockNewsStorage_RenameCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNewsStorage_RenameCollection_Call) RunAndReturn(run func(context.Context, uint64, uint64, string) (*models.Collection, error)) *MockNewsStorage_RenameCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReorderCollection provides a mock function with given fields: ctx, userID, collectionID, newsIDs
+func (_m *MockNewsStorage) ReorderCollection(ctx context.Context, userID uint64, collectionID uint64, newsIDs []uint64) error {
+	ret := _m.Called(ctx, userID, collectionID, newsIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReorderCollection")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, []uint64) error); ok {
+		r0 = rf(ctx, userID, collectionID, newsIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNewsStorage_ReorderCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReorderCollection'
+type MockNewsStorage_ReorderCollection_Call struct {
+	*mock.Call
+}
+
+// ReorderCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - collectionID uint64
+//   - newsIDs []uint64
+func (_e *MockNewsStorage_Expecter) ReorderCollection(ctx interface{}, userID interface{}, collectionID interface{}, newsIDs interface{}) *MockNewsStorage_ReorderCollection_Call {
+	return &MockNewsStorage_ReorderCollection_Call{Call: _e.mock.On("ReorderCollection", ctx, userID, collectionID, newsIDs)}
+}
+
+func (_c *MockNewsStorage_ReorderCollection_Call) Run(run func(ctx context.Context, userID uint64, collectionID uint64, newsIDs []uint64)) *MockNewsStorage_ReorderCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].([]uint64))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_ReorderCollection_Call) Return(_a0 error) *MockNewsStorage_ReorderCollection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNewsStorage_ReorderCollection_Call) RunAndReturn(run func(context.Context, uint64, uint64, []uint64) error) *MockNewsStorage_ReorderCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAPIKey provides a mock function with given fields: ctx, id
+func (_m *MockNewsStorage) RevokeAPIKey(ctx context.Context, id uint64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAPIKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -88,58 +2299,58 @@ func (_m *MockNewsStorage) AddToSearchHistory(ctx context.Context, userID uint64
 	return r0
 }
 
-// MockNewsStorage_AddToSearchHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddToSearchHistory'
-type MockNewsStorage_AddToSearchHistory_Call struct {
+// MockNewsStorage_RevokeAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAPIKey'
+type MockNewsStorage_RevokeAPIKey_Call struct {
 	*mock.Call
 }
 
-// AddToSearchHistory is a helper method to define mock.On call
+// RevokeAPIKey is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID uint64
-//   - query string
-//   - results []uint64
-func (_e *MockNewsStorage_Expecter) AddToSearchHistory(ctx interface{}, userID interface{}, query interface{}, results interface{}) *MockNewsStorage_AddToSearchHistory_Call {
-	return &MockNewsStorage_AddToSearchHistory_Call{Call: _e.mock.On("AddToSearchHistory", ctx, userID, query, results)}
+//   - id uint64
+func (_e *MockNewsStorage_Expecter) RevokeAPIKey(ctx interface{}, id interface{}) *MockNewsStorage_RevokeAPIKey_Call {
+	return &MockNewsStorage_RevokeAPIKey_Call{Call: _e.mock.On("RevokeAPIKey", ctx, id)}
 }
 
-func (_c *MockNewsStorage_AddToSearchHistory_Call) Run(run func(ctx context.Context, userID uint64, query string, results []uint64)) *MockNewsStorage_AddToSearchHistory_Call {
+func (_c *MockNewsStorage_RevokeAPIKey_Call) Run(run func(ctx context.Context, id uint64)) *MockNewsStorage_RevokeAPIKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64), args[2].(string), args[3].([]uint64))
+		run(args[0].(context.Context), args[1].(uint64))
 	})
 	return _c
 }
 
-func (_c *MockNewsStorage_AddToSearchHistory_Call) Return(_a0 error) *MockNewsStorage_AddToSearchHistory_Call {
+func (_c *MockNewsStorage_RevokeAPIKey_Call) Return(_a0 error) *MockNewsStorage_RevokeAPIKey_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockNewsStorage_AddToSearchHistory_Call) RunAndReturn(run func(context.Context, uint64, string, []uint64) error) *MockNewsStorage_AddToSearchHistory_Call {
+func (_c *MockNewsStorage_RevokeAPIKey_Call) RunAndReturn(run func(context.Context, uint64) error) *MockNewsStorage_RevokeAPIKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateUser provides a mock function with given fields: ctx, name
-func (_m *MockNewsStorage) CreateUser(ctx context.Context, name string) (uint64, error) {
-	ret := _m.Called(ctx, name)
+// RotateAPIKey provides a mock function with given fields: ctx, id, prefix, keyHash
+func (_m *MockNewsStorage) RotateAPIKey(ctx context.Context, id uint64, prefix string, keyHash string) (*models.APIKey, error) {
+	ret := _m.Called(ctx, id, prefix, keyHash)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateUser")
+		panic("no return value specified for RotateAPIKey")
 	}
 
-	var r0 uint64
+	var r0 *models.APIKey
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (uint64, error)); ok {
-		return rf(ctx, name)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, string) (*models.APIKey, error)); ok {
+		return rf(ctx, id, prefix, keyHash)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) uint64); ok {
-		r0 = rf(ctx, name)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, string) *models.APIKey); ok {
+		r0 = rf(ctx, id, prefix, keyHash)
 	} else {
-		r0 = ret.Get(0).(uint64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.APIKey)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, name)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, string, string) error); ok {
+		r1 = rf(ctx, id, prefix, keyHash)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -147,117 +2358,128 @@ func (_m *MockNewsStorage) CreateUser(ctx context.Context, name string) (uint64,
 	return r0, r1
 }
 
-// MockNewsStorage_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
-type MockNewsStorage_CreateUser_Call struct {
+// MockNewsStorage_RotateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateAPIKey'
+type MockNewsStorage_RotateAPIKey_Call struct {
 	*mock.Call
 }
 
-// CreateUser is a helper method to define mock.On call
+// RotateAPIKey is a helper method to define mock.On call
 //   - ctx context.Context
-//   - name string
-func (_e *MockNewsStorage_Expecter) CreateUser(ctx interface{}, name interface{}) *MockNewsStorage_CreateUser_Call {
-	return &MockNewsStorage_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, name)}
+//   - id uint64
+//   - prefix string
+//   - keyHash string
+func (_e *MockNewsStorage_Expecter) RotateAPIKey(ctx interface{}, id interface{}, prefix interface{}, keyHash interface{}) *MockNewsStorage_RotateAPIKey_Call {
+	return &MockNewsStorage_RotateAPIKey_Call{Call: _e.mock.On("RotateAPIKey", ctx, id, prefix, keyHash)}
 }
 
-func (_c *MockNewsStorage_CreateUser_Call) Run(run func(ctx context.Context, name string)) *MockNewsStorage_CreateUser_Call {
+func (_c *MockNewsStorage_RotateAPIKey_Call) Run(run func(ctx context.Context, id uint64, prefix string, keyHash string)) *MockNewsStorage_RotateAPIKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(string), args[3].(string))
 	})
 	return _c
 }
 
-func (_c *MockNewsStorage_CreateUser_Call) Return(_a0 uint64, _a1 error) *MockNewsStorage_CreateUser_Call {
+func (_c *MockNewsStorage_RotateAPIKey_Call) Return(_a0 *models.APIKey, _a1 error) *MockNewsStorage_RotateAPIKey_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockNewsStorage_CreateUser_Call) RunAndReturn(run func(context.Context, string) (uint64, error)) *MockNewsStorage_CreateUser_Call {
+func (_c *MockNewsStorage_RotateAPIKey_Call) RunAndReturn(run func(context.Context, uint64, string, string) (*models.APIKey, error)) *MockNewsStorage_RotateAPIKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFavourites provides a mock function with given fields: ctx, userID
-func (_m *MockNewsStorage) GetFavourites(ctx context.Context, userID uint64) ([]*models.News, error) {
-	ret := _m.Called(ctx, userID)
+// SearchFavourites provides a mock function with given fields: ctx, userID, filter, page
+func (_m *MockNewsStorage) SearchFavourites(ctx context.Context, userID uint64, filter models.FavouriteFilter, page models.PageRequest) ([]*models.AnnotatedNews, string, error) {
+	ret := _m.Called(ctx, userID, filter, page)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFavourites")
+		panic("no return value specified for SearchFavourites")
 	}
 
-	var r0 []*models.News
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]*models.News, error)); ok {
-		return rf(ctx, userID)
+	var r0 []*models.AnnotatedNews
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, models.FavouriteFilter, models.PageRequest) ([]*models.AnnotatedNews, string, error)); ok {
+		return rf(ctx, userID, filter, page)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint64) []*models.News); ok {
-		r0 = rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, models.FavouriteFilter, models.PageRequest) []*models.AnnotatedNews); ok {
+		r0 = rf(ctx, userID, filter, page)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*models.News)
+			r0 = ret.Get(0).([]*models.AnnotatedNews)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
-		r1 = rf(ctx, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, models.FavouriteFilter, models.PageRequest) string); ok {
+		r1 = rf(ctx, userID, filter, page)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(string)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, uint64, models.FavouriteFilter, models.PageRequest) error); ok {
+		r2 = rf(ctx, userID, filter, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
-// MockNewsStorage_GetFavourites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFavourites'
-type MockNewsStorage_GetFavourites_Call struct {
+// MockNewsStorage_SearchFavourites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchFavourites'
+type MockNewsStorage_SearchFavourites_Call struct {
 	*mock.Call
 }
 
-// GetFavourites is a helper method to define mock.On call
+// SearchFavourites is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID uint64
-func (_e *MockNewsStorage_Expecter) GetFavourites(ctx interface{}, userID interface{}) *MockNewsStorage_GetFavourites_Call {
-	return &MockNewsStorage_GetFavourites_Call{Call: _e.mock.On("GetFavourites", ctx, userID)}
+//   - filter models.FavouriteFilter
+//   - page models.PageRequest
+func (_e *MockNewsStorage_Expecter) SearchFavourites(ctx interface{}, userID interface{}, filter interface{}, page interface{}) *MockNewsStorage_SearchFavourites_Call {
+	return &MockNewsStorage_SearchFavourites_Call{Call: _e.mock.On("SearchFavourites", ctx, userID, filter, page)}
 }
 
-func (_c *MockNewsStorage_GetFavourites_Call) Run(run func(ctx context.Context, userID uint64)) *MockNewsStorage_GetFavourites_Call {
+func (_c *MockNewsStorage_SearchFavourites_Call) Run(run func(ctx context.Context, userID uint64, filter models.FavouriteFilter, page models.PageRequest)) *MockNewsStorage_SearchFavourites_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(models.FavouriteFilter), args[3].(models.PageRequest))
 	})
 	return _c
 }
 
-func (_c *MockNewsStorage_GetFavourites_Call) Return(_a0 []*models.News, _a1 error) *MockNewsStorage_GetFavourites_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockNewsStorage_SearchFavourites_Call) Return(_a0 []*models.AnnotatedNews, _a1 string, _a2 error) *MockNewsStorage_SearchFavourites_Call {
+	_c.Call.Return(_a0, _a1, _a2)
 	return _c
 }
 
-func (_c *MockNewsStorage_GetFavourites_Call) RunAndReturn(run func(context.Context, uint64) ([]*models.News, error)) *MockNewsStorage_GetFavourites_Call {
+func (_c *MockNewsStorage_SearchFavourites_Call) RunAndReturn(run func(context.Context, uint64, models.FavouriteFilter, models.PageRequest) ([]*models.AnnotatedNews, string, error)) *MockNewsStorage_SearchFavourites_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetNewsByIDs provides a mock function with given fields: ctx, IDs
-func (_m *MockNewsStorage) GetNewsByIDs(ctx context.Context, IDs []uint64) ([]*models.News, error) {
-	ret := _m.Called(ctx, IDs)
+// SetCollectionShareToken provides a mock function with given fields: ctx, userID, collectionID, shareToken
+func (_m *MockNewsStorage) SetCollectionShareToken(ctx context.Context, userID uint64, collectionID uint64, shareToken string) (*models.Collection, error) {
+	ret := _m.Called(ctx, userID, collectionID, shareToken)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetNewsByIDs")
+		panic("no return value specified for SetCollectionShareToken")
 	}
 
-	var r0 []*models.News
+	var r0 *models.Collection
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []uint64) ([]*models.News, error)); ok {
-		return rf(ctx, IDs)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, string) (*models.Collection, error)); ok {
+		return rf(ctx, userID, collectionID, shareToken)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []uint64) []*models.News); ok {
-		r0 = rf(ctx, IDs)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, string) *models.Collection); ok {
+		r0 = rf(ctx, userID, collectionID, shareToken)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*models.News)
+			r0 = ret.Get(0).(*models.Collection)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []uint64) error); ok {
-		r1 = rf(ctx, IDs)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, string) error); ok {
+		r1 = rf(ctx, userID, collectionID, shareToken)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -265,58 +2487,60 @@ func (_m *MockNewsStorage) GetNewsByIDs(ctx context.Context, IDs []uint64) ([]*m
 	return r0, r1
 }
 
-// MockNewsStorage_GetNewsByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNewsByIDs'
-type MockNewsStorage_GetNewsByIDs_Call struct {
+// MockNewsStorage_SetCollectionShareToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCollectionShareToken'
+type MockNewsStorage_SetCollectionShareToken_Call struct {
 	*mock.Call
 }
 
-// GetNewsByIDs is a helper method to define mock.On call
+// SetCollectionShareToken is a helper method to define mock.On call
 //   - ctx context.Context
-//   - IDs []uint64
-func (_e *MockNewsStorage_Expecter) GetNewsByIDs(ctx interface{}, IDs interface{}) *MockNewsStorage_GetNewsByIDs_Call {
-	return &MockNewsStorage_GetNewsByIDs_Call{Call: _e.mock.On("GetNewsByIDs", ctx, IDs)}
+//   - userID uint64
+//   - collectionID uint64
+//   - shareToken string
+func (_e *MockNewsStorage_Expecter) SetCollectionShareToken(ctx interface{}, userID interface{}, collectionID interface{}, shareToken interface{}) *MockNewsStorage_SetCollectionShareToken_Call {
+	return &MockNewsStorage_SetCollectionShareToken_Call{Call: _e.mock.On("SetCollectionShareToken", ctx, userID, collectionID, shareToken)}
 }
 
-func (_c *MockNewsStorage_GetNewsByIDs_Call) Run(run func(ctx context.Context, IDs []uint64)) *MockNewsStorage_GetNewsByIDs_Call {
+func (_c *MockNewsStorage_SetCollectionShareToken_Call) Run(run func(ctx context.Context, userID uint64, collectionID uint64, shareToken string)) *MockNewsStorage_SetCollectionShareToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]uint64))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(string))
 	})
 	return _c
 }
 
-func (_c *MockNewsStorage_GetNewsByIDs_Call) Return(_a0 []*models.News, _a1 error) *MockNewsStorage_GetNewsByIDs_Call {
+func (_c *MockNewsStorage_SetCollectionShareToken_Call) Return(_a0 *models.Collection, _a1 error) *MockNewsStorage_SetCollectionShareToken_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockNewsStorage_GetNewsByIDs_Call) RunAndReturn(run func(context.Context, []uint64) ([]*models.News, error)) *MockNewsStorage_GetNewsByIDs_Call {
+func (_c *MockNewsStorage_SetCollectionShareToken_Call) RunAndReturn(run func(context.Context, uint64, uint64, string) (*models.Collection, error)) *MockNewsStorage_SetCollectionShareToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSearchHistory provides a mock function with given fields: ctx, userID
-func (_m *MockNewsStorage) GetSearchHistory(ctx context.Context, userID uint64) ([]string, error) {
-	ret := _m.Called(ctx, userID)
+// SetFavouriteNote provides a mock function with given fields: ctx, userID, newsID, note
+func (_m *MockNewsStorage) SetFavouriteNote(ctx context.Context, userID uint64, newsID uint64, note string) (*models.FavouriteAnnotation, error) {
+	ret := _m.Called(ctx, userID, newsID, note)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSearchHistory")
+		panic("no return value specified for SetFavouriteNote")
 	}
 
-	var r0 []string
+	var r0 *models.FavouriteAnnotation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]string, error)); ok {
-		return rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, string) (*models.FavouriteAnnotation, error)); ok {
+		return rf(ctx, userID, newsID, note)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint64) []string); ok {
-		r0 = rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, string) *models.FavouriteAnnotation); ok {
+		r0 = rf(ctx, userID, newsID, note)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
+			r0 = ret.Get(0).(*models.FavouriteAnnotation)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
-		r1 = rf(ctx, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, string) error); ok {
+		r1 = rf(ctx, userID, newsID, note)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -324,58 +2548,60 @@ func (_m *MockNewsStorage) GetSearchHistory(ctx context.Context, userID uint64)
 	return r0, r1
 }
 
-// MockNewsStorage_GetSearchHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSearchHistory'
-type MockNewsStorage_GetSearchHistory_Call struct {
+// MockNewsStorage_SetFavouriteNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetFavouriteNote'
+type MockNewsStorage_SetFavouriteNote_Call struct {
 	*mock.Call
 }
 
-// GetSearchHistory is a helper method to define mock.On call
+// SetFavouriteNote is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID uint64
-func (_e *MockNewsStorage_Expecter) GetSearchHistory(ctx interface{}, userID interface{}) *MockNewsStorage_GetSearchHistory_Call {
-	return &MockNewsStorage_GetSearchHistory_Call{Call: _e.mock.On("GetSearchHistory", ctx, userID)}
+//   - newsID uint64
+//   - note string
+func (_e *MockNewsStorage_Expecter) SetFavouriteNote(ctx interface{}, userID interface{}, newsID interface{}, note interface{}) *MockNewsStorage_SetFavouriteNote_Call {
+	return &MockNewsStorage_SetFavouriteNote_Call{Call: _e.mock.On("SetFavouriteNote", ctx, userID, newsID, note)}
 }
 
-func (_c *MockNewsStorage_GetSearchHistory_Call) Run(run func(ctx context.Context, userID uint64)) *MockNewsStorage_GetSearchHistory_Call {
+func (_c *MockNewsStorage_SetFavouriteNote_Call) Run(run func(ctx context.Context, userID uint64, newsID uint64, note string)) *MockNewsStorage_SetFavouriteNote_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(string))
 	})
 	return _c
 }
 
-func (_c *MockNewsStorage_GetSearchHistory_Call) Return(_a0 []string, _a1 error) *MockNewsStorage_GetSearchHistory_Call {
+func (_c *MockNewsStorage_SetFavouriteNote_Call) Return(_a0 *models.FavouriteAnnotation, _a1 error) *MockNewsStorage_SetFavouriteNote_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockNewsStorage_GetSearchHistory_Call) RunAndReturn(run func(context.Context, uint64) ([]string, error)) *MockNewsStorage_GetSearchHistory_Call {
+func (_c *MockNewsStorage_SetFavouriteNote_Call) RunAndReturn(run func(context.Context, uint64, uint64, string) (*models.FavouriteAnnotation, error)) *MockNewsStorage_SetFavouriteNote_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSubscriptions provides a mock function with given fields: ctx
-func (_m *MockNewsStorage) GetSubscriptions(ctx context.Context) ([]*models.Subscription, error) {
-	ret := _m.Called(ctx)
+// SetFavouriteTags provides a mock function with given fields: ctx, userID, newsID, tags
+func (_m *MockNewsStorage) SetFavouriteTags(ctx context.Context, userID uint64, newsID uint64, tags []string) (*models.FavouriteAnnotation, error) {
+	ret := _m.Called(ctx, userID, newsID, tags)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSubscriptions")
+		panic("no return value specified for SetFavouriteTags")
 	}
 
-	var r0 []*models.Subscription
+	var r0 *models.FavouriteAnnotation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]*models.Subscription, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, []string) (*models.FavouriteAnnotation, error)); ok {
+		return rf(ctx, userID, newsID, tags)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []*models.Subscription); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, []string) *models.FavouriteAnnotation); ok {
+		r0 = rf(ctx, userID, newsID, tags)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*models.Subscription)
+			r0 = ret.Get(0).(*models.FavouriteAnnotation)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, []string) error); ok {
+		r1 = rf(ctx, userID, newsID, tags)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -383,45 +2609,48 @@ func (_m *MockNewsStorage) GetSubscriptions(ctx context.Context) ([]*models.Subs
 	return r0, r1
 }
 
-// MockNewsStorage_GetSubscriptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubscriptions'
-type MockNewsStorage_GetSubscriptions_Call struct {
+// MockNewsStorage_SetFavouriteTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetFavouriteTags'
+type MockNewsStorage_SetFavouriteTags_Call struct {
 	*mock.Call
 }
 
-// GetSubscriptions is a helper method to define mock.On call
+// SetFavouriteTags is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockNewsStorage_Expecter) GetSubscriptions(ctx interface{}) *MockNewsStorage_GetSubscriptions_Call {
-	return &MockNewsStorage_GetSubscriptions_Call{Call: _e.mock.On("GetSubscriptions", ctx)}
+//   - userID uint64
+//   - newsID uint64
+//   - tags []string
+func (_e *MockNewsStorage_Expecter) SetFavouriteTags(ctx interface{}, userID interface{}, newsID interface{}, tags interface{}) *MockNewsStorage_SetFavouriteTags_Call {
+	return &MockNewsStorage_SetFavouriteTags_Call{Call: _e.mock.On("SetFavouriteTags", ctx, userID, newsID, tags)}
 }
 
-func (_c *MockNewsStorage_GetSubscriptions_Call) Run(run func(ctx context.Context)) *MockNewsStorage_GetSubscriptions_Call {
+func (_c *MockNewsStorage_SetFavouriteTags_Call) Run(run func(ctx context.Context, userID uint64, newsID uint64, tags []string)) *MockNewsStorage_SetFavouriteTags_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].([]string))
 	})
 	return _c
 }
 
-func (_c *MockNewsStorage_GetSubscriptions_Call) Return(_a0 []*models.Subscription, _a1 error) *MockNewsStorage_GetSubscriptions_Call {
+func (_c *MockNewsStorage_SetFavouriteTags_Call) Return(_a0 *models.FavouriteAnnotation, _a1 error) *MockNewsStorage_SetFavouriteTags_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockNewsStorage_GetSubscriptions_Call) RunAndReturn(run func(context.Context) ([]*models.Subscription, error)) *MockNewsStorage_GetSubscriptions_Call {
+func (_c *MockNewsStorage_SetFavouriteTags_Call) RunAndReturn(run func(context.Context, uint64, uint64, []string) (*models.FavouriteAnnotation, error)) *MockNewsStorage_SetFavouriteTags_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// MarkNewsAsSeen provides a mock function with given fields: ctx, userID, newsID
-func (_m *MockNewsStorage) MarkNewsAsSeen(ctx context.Context, userID uint64, newsID uint64) error {
-	ret := _m.Called(ctx, userID, newsID)
+// Subscribe provides a mock function with given fields: ctx, userID, keyword, schedule
+func (_m *MockNewsStorage) Subscribe(ctx context.Context, userID uint64, keyword string, schedule string) error {
+	ret := _m.Called(ctx, userID, keyword, schedule)
 
 	if len(ret) == 0 {
-		panic("no return value specified for MarkNewsAsSeen")
+		panic("no return value specified for Subscribe")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) error); ok {
-		r0 = rf(ctx, userID, newsID)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, string) error); ok {
+		r0 = rf(ctx, userID, keyword, schedule)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -429,47 +2658,48 @@ func (_m *MockNewsStorage) MarkNewsAsSeen(ctx context.Context, userID uint64, ne
 	return r0
 }
 
-// MockNewsStorage_MarkNewsAsSeen_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkNewsAsSeen'
-type MockNewsStorage_MarkNewsAsSeen_Call struct {
+// MockNewsStorage_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type MockNewsStorage_Subscribe_Call struct {
 	*mock.Call
 }
 
-// MarkNewsAsSeen is a helper method to define mock.On call
+// Subscribe is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID uint64
-//   - newsID uint64
-func (_e *MockNewsStorage_Expecter) MarkNewsAsSeen(ctx interface{}, userID interface{}, newsID interface{}) *MockNewsStorage_MarkNewsAsSeen_Call {
-	return &MockNewsStorage_MarkNewsAsSeen_Call{Call: _e.mock.On("MarkNewsAsSeen", ctx, userID, newsID)}
+//   - keyword string
+//   - schedule string
+func (_e *MockNewsStorage_Expecter) Subscribe(ctx interface{}, userID interface{}, keyword interface{}, schedule interface{}) *MockNewsStorage_Subscribe_Call {
+	return &MockNewsStorage_Subscribe_Call{Call: _e.mock.On("Subscribe", ctx, userID, keyword, schedule)}
 }
 
-func (_c *MockNewsStorage_MarkNewsAsSeen_Call) Run(run func(ctx context.Context, userID uint64, newsID uint64)) *MockNewsStorage_MarkNewsAsSeen_Call {
+func (_c *MockNewsStorage_Subscribe_Call) Run(run func(ctx context.Context, userID uint64, keyword string, schedule string)) *MockNewsStorage_Subscribe_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(string), args[3].(string))
 	})
 	return _c
 }
 
-func (_c *MockNewsStorage_MarkNewsAsSeen_Call) Return(_a0 error) *MockNewsStorage_MarkNewsAsSeen_Call {
+func (_c *MockNewsStorage_Subscribe_Call) Return(_a0 error) *MockNewsStorage_Subscribe_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockNewsStorage_MarkNewsAsSeen_Call) RunAndReturn(run func(context.Context, uint64, uint64) error) *MockNewsStorage_MarkNewsAsSeen_Call {
+func (_c *MockNewsStorage_Subscribe_Call) RunAndReturn(run func(context.Context, uint64, string, string) error) *MockNewsStorage_Subscribe_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Subscribe provides a mock function with given fields: ctx, userID, keyword
-func (_m *MockNewsStorage) Subscribe(ctx context.Context, userID uint64, keyword string) error {
-	ret := _m.Called(ctx, userID, keyword)
+// TouchAPIKey provides a mock function with given fields: ctx, id
+func (_m *MockNewsStorage) TouchAPIKey(ctx context.Context, id uint64) error {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Subscribe")
+		panic("no return value specified for TouchAPIKey")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, string) error); ok {
-		r0 = rf(ctx, userID, keyword)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -477,52 +2707,245 @@ func (_m *MockNewsStorage) Subscribe(ctx context.Context, userID uint64, keyword
 	return r0
 }
 
-// MockNewsStorage_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
-type MockNewsStorage_Subscribe_Call struct {
+// MockNewsStorage_TouchAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TouchAPIKey'
+type MockNewsStorage_TouchAPIKey_Call struct {
 	*mock.Call
 }
 
-// Subscribe is a helper method to define mock.On call
+// TouchAPIKey is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID uint64
-//   - keyword string
-func (_e *MockNewsStorage_Expecter) Subscribe(ctx interface{}, userID interface{}, keyword interface{}) *MockNewsStorage_Subscribe_Call {
-	return &MockNewsStorage_Subscribe_Call{Call: _e.mock.On("Subscribe", ctx, userID, keyword)}
+//   - id uint64
+func (_e *MockNewsStorage_Expecter) TouchAPIKey(ctx interface{}, id interface{}) *MockNewsStorage_TouchAPIKey_Call {
+	return &MockNewsStorage_TouchAPIKey_Call{Call: _e.mock.On("TouchAPIKey", ctx, id)}
 }
 
-func (_c *MockNewsStorage_Subscribe_Call) Run(run func(ctx context.Context, userID uint64, keyword string)) *MockNewsStorage_Subscribe_Call {
+func (_c *MockNewsStorage_TouchAPIKey_Call) Run(run func(ctx context.Context, id uint64)) *MockNewsStorage_TouchAPIKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64), args[2].(string))
+		run(args[0].(context.Context), args[1].(uint64))
 	})
 	return _c
 }
 
-func (_c *MockNewsStorage_Subscribe_Call) Return(_a0 error) *MockNewsStorage_Subscribe_Call {
+func (_c *MockNewsStorage_TouchAPIKey_Call) Return(_a0 error) *MockNewsStorage_TouchAPIKey_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockNewsStorage_Subscribe_Call) RunAndReturn(run func(context.Context, uint64, string) error) *MockNewsStorage_Subscribe_Call {
+func (_c *MockNewsStorage_TouchAPIKey_Call) RunAndReturn(run func(context.Context, uint64) error) *MockNewsStorage_TouchAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSavedSearch provides a mock function with given fields: ctx, userID, id, update
+func (_m *MockNewsStorage) UpdateSavedSearch(ctx context.Context, userID uint64, id uint64, update *models.SavedSearchUpdate) (*models.SavedSearch, error) {
+	ret := _m.Called(ctx, userID, id, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSavedSearch")
+	}
+
+	var r0 *models.SavedSearch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, *models.SavedSearchUpdate) (*models.SavedSearch, error)); ok {
+		return rf(ctx, userID, id, update)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, *models.SavedSearchUpdate) *models.SavedSearch); ok {
+		r0 = rf(ctx, userID, id, update)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SavedSearch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, *models.SavedSearchUpdate) error); ok {
+		r1 = rf(ctx, userID, id, update)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNewsStorage_UpdateSavedSearch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSavedSearch'
+type MockNewsStorage_UpdateSavedSearch_Call struct {
+	*mock.Call
+}
+
+// UpdateSavedSearch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - id uint64
+//   - update *models.SavedSearchUpdate
+func (_e *MockNewsStorage_Expecter) UpdateSavedSearch(ctx interface{}, userID interface{}, id interface{}, update interface{}) *MockNewsStorage_UpdateSavedSearch_Call {
+	return &MockNewsStorage_UpdateSavedSearch_Call{Call: _e.mock.On("UpdateSavedSearch", ctx, userID, id, update)}
+}
+
+func (_c *MockNewsStorage_UpdateSavedSearch_Call) Run(run func(ctx context.Context, userID uint64, id uint64, update *models.SavedSearchUpdate)) *MockNewsStorage_UpdateSavedSearch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(*models.SavedSearchUpdate))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_UpdateSavedSearch_Call) Return(_a0 *models.SavedSearch, _a1 error) *MockNewsStorage_UpdateSavedSearch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNewsStorage_UpdateSavedSearch_Call) RunAndReturn(run func(context.Context, uint64, uint64, *models.SavedSearchUpdate) (*models.SavedSearch, error)) *MockNewsStorage_UpdateSavedSearch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSubscription provides a mock function with given fields: ctx, userID, id, schedule
+func (_m *MockNewsStorage) UpdateSubscription(ctx context.Context, userID uint64, id uint64, schedule string) (*models.Subscription, error) {
+	ret := _m.Called(ctx, userID, id, schedule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSubscription")
+	}
+
+	var r0 *models.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, string) (*models.Subscription, error)); ok {
+		return rf(ctx, userID, id, schedule)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, string) *models.Subscription); ok {
+		r0 = rf(ctx, userID, id, schedule)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, string) error); ok {
+		r1 = rf(ctx, userID, id, schedule)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNewsStorage_UpdateSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSubscription'
+type MockNewsStorage_UpdateSubscription_Call struct {
+	*mock.Call
+}
+
+// UpdateSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - id uint64
+//   - schedule string
+func (_e *MockNewsStorage_Expecter) UpdateSubscription(ctx interface{}, userID interface{}, id interface{}, schedule interface{}) *MockNewsStorage_UpdateSubscription_Call {
+	return &MockNewsStorage_UpdateSubscription_Call{Call: _e.mock.On("UpdateSubscription", ctx, userID, id, schedule)}
+}
+
+func (_c *MockNewsStorage_UpdateSubscription_Call) Run(run func(ctx context.Context, userID uint64, id uint64, schedule string)) *MockNewsStorage_UpdateSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_UpdateSubscription_Call) Return(_a0 *models.Subscription, _a1 error) *MockNewsStorage_UpdateSubscription_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNewsStorage_UpdateSubscription_Call) RunAndReturn(run func(context.Context, uint64, uint64, string) (*models.Subscription, error)) *MockNewsStorage_UpdateSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUser provides a mock function with given fields: ctx, userID, update
+func (_m *MockNewsStorage) UpdateUser(ctx context.Context, userID uint64, update *models.UserUpdate) (*models.User, error) {
+	ret := _m.Called(ctx, userID, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUser")
+	}
+
+	var r0 *models.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *models.UserUpdate) (*models.User, error)); ok {
+		return rf(ctx, userID, update)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *models.UserUpdate) *models.User); ok {
+		r0 = rf(ctx, userID, update)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, *models.UserUpdate) error); ok {
+		r1 = rf(ctx, userID, update)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNewsStorage_UpdateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUser'
+type MockNewsStorage_UpdateUser_Call struct {
+	*mock.Call
+}
+
+// UpdateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - update *models.UserUpdate
+func (_e *MockNewsStorage_Expecter) UpdateUser(ctx interface{}, userID interface{}, update interface{}) *MockNewsStorage_UpdateUser_Call {
+	return &MockNewsStorage_UpdateUser_Call{Call: _e.mock.On("UpdateUser", ctx, userID, update)}
+}
+
+func (_c *MockNewsStorage_UpdateUser_Call) Run(run func(ctx context.Context, userID uint64, update *models.UserUpdate)) *MockNewsStorage_UpdateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(*models.UserUpdate))
+	})
+	return _c
+}
+
+func (_c *MockNewsStorage_UpdateUser_Call) Return(_a0 *models.User, _a1 error) *MockNewsStorage_UpdateUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNewsStorage_UpdateUser_Call) RunAndReturn(run func(context.Context, uint64, *models.UserUpdate) (*models.User, error)) *MockNewsStorage_UpdateUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // UpsertNews provides a mock function with given fields: ctx, news
-func (_m *MockNewsStorage) UpsertNews(ctx context.Context, news []*models.News) error {
+func (_m *MockNewsStorage) UpsertNews(ctx context.Context, news []*models.News) ([]uint64, error) {
 	ret := _m.Called(ctx, news)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpsertNews")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, []*models.News) error); ok {
+	var r0 []uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*models.News) ([]uint64, error)); ok {
+		return rf(ctx, news)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []*models.News) []uint64); ok {
 		r0 = rf(ctx, news)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint64)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, []*models.News) error); ok {
+		r1 = rf(ctx, news)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // MockNewsStorage_UpsertNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertNews'
@@ -544,12 +2967,12 @@ func (_c *MockNewsStorage_UpsertNews_Call) Run(run func(ctx context.Context, new
 	return _c
 }
 
-func (_c *MockNewsStorage_UpsertNews_Call) Return(_a0 error) *MockNewsStorage_UpsertNews_Call {
-	_c.Call.Return(_a0)
+func (_c *MockNewsStorage_UpsertNews_Call) Return(_a0 []uint64, _a1 error) *MockNewsStorage_UpsertNews_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockNewsStorage_UpsertNews_Call) RunAndReturn(run func(context.Context, []*models.News) error) *MockNewsStorage_UpsertNews_Call {
+func (_c *MockNewsStorage_UpsertNews_Call) RunAndReturn(run func(context.Context, []*models.News) ([]uint64, error)) *MockNewsStorage_UpsertNews_Call {
 	_c.Call.Return(run)
 	return _c
 }
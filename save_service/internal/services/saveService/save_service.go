@@ -2,66 +2,184 @@ package saveService
 
 import (
 	"context"
+	"fmt"
+	"gonews/pkg/schedule"
 	"gonews/save_service/internal/models"
+	"strings"
+
+	"github.com/samber/lo"
 )
 
 type NewsStorage interface {
 	GetNewsByIDs(ctx context.Context, IDs []uint64) ([]*models.News, error)
-	UpsertNews(ctx context.Context, news []*models.News) error
-	CreateUser(ctx context.Context, name string) (uint64, error)
+	UpsertNews(ctx context.Context, news []*models.News) ([]uint64, error)
+	CreateUser(ctx context.Context, user *models.User) (*models.User, error)
+	GetUser(ctx context.Context, userID uint64) (*models.User, error)
+	GetUserByHandle(ctx context.Context, handle string) (*models.User, error)
+	ListUsers(ctx context.Context, page models.PageRequest) ([]*models.User, string, error)
+	UpdateUser(ctx context.Context, userID uint64, update *models.UserUpdate) (*models.User, error)
 	AddFavourite(ctx context.Context, userID, newsID uint64) error
-	GetFavourites(ctx context.Context, userID uint64) ([]*models.News, error)
-	AddToSearchHistory(ctx context.Context, userID uint64, query string, results []uint64) error
-	GetSearchHistory(ctx context.Context, userID uint64) ([]string, error)
-	Subscribe(ctx context.Context, userID uint64, keyword string) error
-	GetSubscriptions(ctx context.Context) ([]*models.Subscription, error)
-	MarkNewsAsSeen(ctx context.Context, userID, newsID uint64) error
+	GetFavourites(ctx context.Context, userID uint64, page models.PageRequest, unseenOnly bool) ([]*models.News, string, error)
+	AddToSearchHistory(ctx context.Context, entry *models.SearchHistoryEntry) error
+	GetSearchHistory(ctx context.Context, userID uint64, page models.PageRequest, uniqueQueries bool) ([]*models.SearchHistoryEntry, string, error)
+	GetSearchHistoryEntry(ctx context.Context, userID, searchID uint64) (*models.SearchHistoryEntry, error)
+	Subscribe(ctx context.Context, userID uint64, keyword, schedule string) error
+	UpdateSubscription(ctx context.Context, userID, id uint64, schedule string) (*models.Subscription, error)
+	GetSubscriptions(ctx context.Context, userID uint64, page models.PageRequest) ([]*models.Subscription, string, error)
+	MarkNewsAsSeen(ctx context.Context, userID uint64, newsIDs []uint64) error
+	MarkNewsAsUnseen(ctx context.Context, userID uint64, newsIDs []uint64) error
+	GetSeenNews(ctx context.Context, userID uint64, newsIDs []uint64, page models.PageRequest) ([]*models.UserToSeenNews, string, error)
+	CreateSavedSearch(ctx context.Context, savedSearch *models.SavedSearch) (*models.SavedSearch, error)
+	GetSavedSearch(ctx context.Context, userID, id uint64) (*models.SavedSearch, error)
+	ListSavedSearches(ctx context.Context, userID uint64, page models.PageRequest, dueOnly bool) ([]*models.SavedSearch, string, error)
+	UpdateSavedSearch(ctx context.Context, userID, id uint64, update *models.SavedSearchUpdate) (*models.SavedSearch, error)
+	DeleteSavedSearch(ctx context.Context, userID, id uint64) error
+	RecordSavedSearchRun(ctx context.Context, id uint64, resultIDs []uint64) (*models.SavedSearch, error)
+	CreateCollection(ctx context.Context, userID uint64, name string) (*models.Collection, error)
+	ListCollections(ctx context.Context, userID uint64, page models.PageRequest) ([]*models.Collection, string, error)
+	RenameCollection(ctx context.Context, userID, collectionID uint64, name string) (*models.Collection, error)
+	DeleteCollection(ctx context.Context, userID, collectionID uint64) error
+	GetCollectionItems(ctx context.Context, userID, collectionID uint64, page models.PageRequest) (*models.Collection, []*models.News, string, error)
+	AddToCollection(ctx context.Context, userID, collectionID, newsID uint64) error
+	RemoveFromCollection(ctx context.Context, userID, collectionID, newsID uint64) error
+	CopyCollectionItems(ctx context.Context, userID, fromID, toID uint64, newsIDs []uint64, move bool) error
+	ReorderCollection(ctx context.Context, userID, collectionID uint64, newsIDs []uint64) error
+	SetCollectionShareToken(ctx context.Context, userID, collectionID uint64, shareToken string) (*models.Collection, error)
+	GetSharedCollection(ctx context.Context, shareToken string, page models.PageRequest) (*models.Collection, []*models.News, string, error)
+	GetFavouriteAnnotation(ctx context.Context, userID, newsID uint64) (*models.FavouriteAnnotation, error)
+	SetFavouriteTags(ctx context.Context, userID, newsID uint64, tags []string) (*models.FavouriteAnnotation, error)
+	SetFavouriteNote(ctx context.Context, userID, newsID uint64, note string) (*models.FavouriteAnnotation, error)
+	AddHighlight(ctx context.Context, highlight *models.Highlight) (*models.Highlight, error)
+	DeleteHighlight(ctx context.Context, userID, newsID, highlightID uint64) error
+	SearchFavourites(ctx context.Context, userID uint64, filter models.FavouriteFilter, page models.PageRequest) ([]*models.AnnotatedNews, string, error)
+	RecordNotification(ctx context.Context, notification *models.Notification) error
+	ExportUserData(ctx context.Context, userID uint64) (*models.UserExport, error)
+	DeleteUser(ctx context.Context, userID uint64) error
+	CreateAPIKey(ctx context.Context, key *models.APIKey, keyHash string) (*models.APIKey, error)
+	GetAPIKeyByHash(ctx context.Context, keyHash string) (*models.APIKey, error)
+	ListAPIKeys(ctx context.Context, page models.PageRequest, includeRevoked bool) ([]*models.APIKey, string, error)
+	RotateAPIKey(ctx context.Context, id uint64, prefix, keyHash string) (*models.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id uint64) error
+	TouchAPIKey(ctx context.Context, id uint64) error
+	InitTables(ctx context.Context) error
 }
 
 type SaveService struct {
-	newsStorage NewsStorage
+	newsStorage     NewsStorage
+	defaultPageSize int
+	maxPageSize     int
 }
 
-func NewSaveService(ctx context.Context, newsStorage NewsStorage) *SaveService {
-	return &SaveService{newsStorage: newsStorage}
+func NewSaveService(ctx context.Context, newsStorage NewsStorage, defaultPageSize, maxPageSize int) *SaveService {
+	return &SaveService{
+		newsStorage:     newsStorage,
+		defaultPageSize: defaultPageSize,
+		maxPageSize:     maxPageSize,
+	}
 }
 
-func (s *SaveService) CreateUser(ctx context.Context, name string) (uint64, error) {
-	return s.newsStorage.CreateUser(ctx, name)
+// pageRequest - подставляет размер страницы по умолчанию и ограничивает его максимумом
+func (s *SaveService) pageRequest(page models.PageRequest) models.PageRequest {
+	if page.Size <= 0 {
+		page.Size = s.defaultPageSize
+	}
+	if page.Size > s.maxPageSize {
+		page.Size = s.maxPageSize
+	}
+	return page
 }
 
 func (s *SaveService) AddFavourite(ctx context.Context, userID, newsID uint64) error {
 	return s.newsStorage.AddFavourite(ctx, userID, newsID)
 }
 
-func (s *SaveService) GetFavourites(ctx context.Context, userID uint64) ([]*models.News, error) {
-	return s.newsStorage.GetFavourites(ctx, userID)
+func (s *SaveService) GetFavourites(ctx context.Context, userID uint64, page models.PageRequest, unseenOnly bool) ([]*models.News, string, error) {
+	return s.newsStorage.GetFavourites(ctx, userID, s.pageRequest(page), unseenOnly)
+}
+
+func (s *SaveService) AddToSearchHistory(ctx context.Context, entry *models.SearchHistoryEntry) error {
+	return s.newsStorage.AddToSearchHistory(ctx, entry)
+}
+
+func (s *SaveService) GetSearchHistory(ctx context.Context, userID uint64, page models.PageRequest, uniqueQueries bool) ([]*models.SearchHistoryEntry, string, error) {
+	return s.newsStorage.GetSearchHistory(ctx, userID, s.pageRequest(page), uniqueQueries)
+}
+
+func (s *SaveService) GetSearchHistoryEntry(ctx context.Context, userID, searchID uint64) (*models.SearchHistoryEntry, error) {
+	return s.newsStorage.GetSearchHistoryEntry(ctx, userID, searchID)
+}
+
+// Subscribe - подписка на ключевое слово; schedule проверяется здесь, чтобы notify service
+// не встретил расписание, которое не может разобрать
+func (s *SaveService) Subscribe(ctx context.Context, userID uint64, keyword, schedule string) error {
+	schedule, err := normalizeSchedule(schedule)
+	if err != nil {
+		return err
+	}
+	return s.newsStorage.Subscribe(ctx, userID, keyword, schedule)
 }
 
-func (s *SaveService) AddToSearchHistory(ctx context.Context, userID uint64, query string, results []uint64) error {
-	return s.newsStorage.AddToSearchHistory(ctx, userID, query, results)
+// UpdateSubscription - новое расписание подписки; пустое - общий интервал
+func (s *SaveService) UpdateSubscription(ctx context.Context, userID, id uint64, schedule string) (*models.Subscription, error) {
+	schedule, err := normalizeSchedule(schedule)
+	if err != nil {
+		return nil, err
+	}
+	return s.newsStorage.UpdateSubscription(ctx, userID, id, schedule)
 }
 
-func (s *SaveService) GetSearchHistory(ctx context.Context, userID uint64) ([]string, error) {
-	return s.newsStorage.GetSearchHistory(ctx, userID)
+func normalizeSchedule(spec string) (string, error) {
+	spec = strings.TrimSpace(spec)
+	if _, err := schedule.Parse(spec); err != nil {
+		return "", fmt.Errorf("%w: %v", models.ErrInvalidSchedule, err)
+	}
+	return spec, nil
 }
 
-func (s *SaveService) Subscribe(ctx context.Context, userID uint64, keyword string) error {
-	return s.newsStorage.Subscribe(ctx, userID, keyword)
+func (s *SaveService) GetSubscriptions(ctx context.Context, userID uint64, page models.PageRequest) ([]*models.Subscription, string, error) {
+	return s.newsStorage.GetSubscriptions(ctx, userID, s.pageRequest(page))
 }
 
-func (s *SaveService) GetSubscriptions(ctx context.Context) ([]*models.Subscription, error) {
-	return s.newsStorage.GetSubscriptions(ctx)
+func (s *SaveService) MarkNewsAsSeen(ctx context.Context, userID uint64, newsIDs []uint64) error {
+	return s.newsStorage.MarkNewsAsSeen(ctx, userID, lo.Uniq(newsIDs))
 }
 
-func (s *SaveService) MarkNewsAsSeen(ctx context.Context, userID, newsID uint64) error {
-	return s.newsStorage.MarkNewsAsSeen(ctx, userID, newsID)
+func (s *SaveService) MarkNewsAsUnseen(ctx context.Context, userID uint64, newsIDs []uint64) error {
+	return s.newsStorage.MarkNewsAsUnseen(ctx, userID, newsIDs)
 }
 
-func (s *SaveService) SaveNews(ctx context.Context, news []*models.News) error {
+func (s *SaveService) GetSeenNews(ctx context.Context, userID uint64, newsIDs []uint64, page models.PageRequest) ([]*models.UserToSeenNews, string, error) {
+	return s.newsStorage.GetSeenNews(ctx, userID, newsIDs, s.pageRequest(page))
+}
+
+func (s *SaveService) SaveNews(ctx context.Context, news []*models.News) ([]uint64, error) {
 	return s.newsStorage.UpsertNews(ctx, news)
 }
 
 func (s *SaveService) GetNewsByIDs(ctx context.Context, IDs []uint64) ([]*models.News, error) {
 	return s.newsStorage.GetNewsByIDs(ctx, IDs)
 }
+
+func (s *SaveService) CreateSavedSearch(ctx context.Context, savedSearch *models.SavedSearch) (*models.SavedSearch, error) {
+	return s.newsStorage.CreateSavedSearch(ctx, savedSearch)
+}
+
+func (s *SaveService) GetSavedSearch(ctx context.Context, userID, id uint64) (*models.SavedSearch, error) {
+	return s.newsStorage.GetSavedSearch(ctx, userID, id)
+}
+
+func (s *SaveService) ListSavedSearches(ctx context.Context, userID uint64, page models.PageRequest, dueOnly bool) ([]*models.SavedSearch, string, error) {
+	return s.newsStorage.ListSavedSearches(ctx, userID, s.pageRequest(page), dueOnly)
+}
+
+func (s *SaveService) UpdateSavedSearch(ctx context.Context, userID, id uint64, update *models.SavedSearchUpdate) (*models.SavedSearch, error) {
+	return s.newsStorage.UpdateSavedSearch(ctx, userID, id, update)
+}
+
+func (s *SaveService) DeleteSavedSearch(ctx context.Context, userID, id uint64) error {
+	return s.newsStorage.DeleteSavedSearch(ctx, userID, id)
+}
+
+func (s *SaveService) RecordSavedSearchRun(ctx context.Context, id uint64, resultIDs []uint64) (*models.SavedSearch, error) {
+	return s.newsStorage.RecordSavedSearchRun(ctx, id, resultIDs)
+}
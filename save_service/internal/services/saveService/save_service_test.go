@@ -1,7 +1,10 @@
 package saveService
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 	"time"
@@ -9,10 +12,17 @@ import (
 	"gonews/save_service/internal/models"
 	"gonews/save_service/internal/services/saveService/mocks"
 
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"gotest.tools/v3/assert"
 )
 
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
 type SaveServiceSuite struct {
 	suite.Suite
 	ctx         context.Context
@@ -23,31 +33,90 @@ type SaveServiceSuite struct {
 func (s *SaveServiceSuite) SetupTest() {
 	s.newsStorage = mocks.NewMockNewsStorage(s.T())
 	s.ctx = context.Background()
-	s.saveService = NewSaveService(s.ctx, s.newsStorage)
+	s.saveService = NewSaveService(s.ctx, s.newsStorage, defaultPageSize, maxPageSize)
 }
 
 func (s *SaveServiceSuite) TestCreateUserSuccess() {
-	userName := "Test User"
-	expectedID := uint64(123)
+	s.newsStorage.EXPECT().CreateUser(s.ctx, mock.Anything).
+		RunAndReturn(func(_ context.Context, user *models.User) (*models.User, error) {
+			user.ID = 123
+			return user, nil
+		})
+
+	user, err := s.saveService.CreateUser(s.ctx, &models.User{
+		Handle:      " Alice ",
+		DisplayName: "Alice",
+		Email:       "alice@example.com",
+		Country:     "US",
+		Timezone:    "Europe/Berlin",
+	})
+
+	assert.NilError(s.T(), err)
+	assert.Equal(s.T(), uint64(123), user.ID)
+	assert.Equal(s.T(), "alice", user.Handle)
+	assert.Equal(s.T(), "us", user.Country)
+}
 
-	s.newsStorage.EXPECT().CreateUser(s.ctx, userName).Return(expectedID, nil)
+func (s *SaveServiceSuite) TestCreateUserHandleFromName() {
+	s.newsStorage.EXPECT().CreateUser(s.ctx, mock.Anything).
+		RunAndReturn(func(_ context.Context, user *models.User) (*models.User, error) {
+			return user, nil
+		})
 
-	actualID, err := s.saveService.CreateUser(s.ctx, userName)
+	user, err := s.saveService.CreateUser(s.ctx, &models.User{DisplayName: "Test User!"})
 
 	assert.NilError(s.T(), err)
-	assert.Equal(s.T(), expectedID, actualID)
+	assert.Equal(s.T(), "test_user", user.Handle)
+	assert.Equal(s.T(), "Test User!", user.DisplayName)
+}
+
+func (s *SaveServiceSuite) TestCreateUserInvalidProfile() {
+	cases := []*models.User{
+		{Handle: "a"},
+		{Handle: "alice", Email: "not an email"},
+		{Handle: "alice", Language: "english"},
+		{Handle: "alice", Timezone: "Mars/Olympus"},
+		{Handle: "alice", DefaultFilters: models.SearchFilters{SortBy: lo.ToPtr("newest")}},
+	}
+
+	for _, user := range cases {
+		_, err := s.saveService.CreateUser(s.ctx, user)
+		assert.ErrorIs(s.T(), err, models.ErrInvalidProfile)
+	}
 }
 
 func (s *SaveServiceSuite) TestCreateUserStorageError() {
-	userName := "Test User"
 	wantErr := errors.New("storage error")
 
-	s.newsStorage.EXPECT().CreateUser(s.ctx, userName).Return(uint64(0), wantErr)
+	s.newsStorage.EXPECT().CreateUser(s.ctx, mock.Anything).Return(nil, wantErr)
 
-	actualID, err := s.saveService.CreateUser(s.ctx, userName)
+	user, err := s.saveService.CreateUser(s.ctx, &models.User{DisplayName: "Test User"})
 
 	assert.ErrorIs(s.T(), err, wantErr)
-	assert.Equal(s.T(), uint64(0), actualID)
+	assert.Assert(s.T(), user == nil)
+}
+
+func (s *SaveServiceSuite) TestCreateUserAlreadyExists() {
+	s.newsStorage.EXPECT().CreateUser(s.ctx, mock.Anything).Return(nil, models.ErrAlreadyExists)
+
+	_, err := s.saveService.CreateUser(s.ctx, &models.User{DisplayName: "alice"})
+
+	assert.ErrorIs(s.T(), err, models.ErrAlreadyExists)
+}
+
+func (s *SaveServiceSuite) TestUpdateUserNormalizesFields() {
+	s.newsStorage.EXPECT().UpdateUser(s.ctx, uint64(1), &models.UserUpdate{
+		Handle:   lo.ToPtr("bob"),
+		Language: lo.ToPtr("de"),
+	}).Return(&models.User{ID: 1, Handle: "bob", Language: "de"}, nil)
+
+	user, err := s.saveService.UpdateUser(s.ctx, 1, &models.UserUpdate{
+		Handle:   lo.ToPtr(" Bob"),
+		Language: lo.ToPtr("DE"),
+	})
+
+	assert.NilError(s.T(), err)
+	assert.Equal(s.T(), "bob", user.Handle)
 }
 
 func (s *SaveServiceSuite) TestAddFavouriteSuccess() {
@@ -88,94 +157,156 @@ func (s *SaveServiceSuite) TestGetFavouritesSuccess() {
 		},
 	}
 
-	s.newsStorage.EXPECT().GetFavourites(s.ctx, userID).Return(expectedNews, nil)
+	page := models.PageRequest{Token: "cursor", Size: 10}
+	s.newsStorage.EXPECT().GetFavourites(s.ctx, userID, page, false).Return(expectedNews, "next", nil)
 
-	actualNews, err := s.saveService.GetFavourites(s.ctx, userID)
+	actualNews, nextToken, err := s.saveService.GetFavourites(s.ctx, userID, page, false)
 
 	assert.NilError(s.T(), err)
 	assert.DeepEqual(s.T(), expectedNews, actualNews)
+	assert.Equal(s.T(), "next", nextToken)
+}
+
+func (s *SaveServiceSuite) TestGetFavouritesDefaultPageSize() {
+	userID := uint64(1)
+
+	s.newsStorage.EXPECT().GetFavourites(s.ctx, userID, models.PageRequest{Size: defaultPageSize}, false).Return(nil, "", nil)
+
+	_, _, err := s.saveService.GetFavourites(s.ctx, userID, models.PageRequest{}, false)
+
+	assert.NilError(s.T(), err)
+}
+
+func (s *SaveServiceSuite) TestGetFavouritesMaxPageSize() {
+	userID := uint64(1)
+
+	s.newsStorage.EXPECT().GetFavourites(s.ctx, userID, models.PageRequest{Size: maxPageSize}, false).Return(nil, "", nil)
+
+	_, _, err := s.saveService.GetFavourites(s.ctx, userID, models.PageRequest{Size: maxPageSize + 1}, false)
+
+	assert.NilError(s.T(), err)
 }
 
 func (s *SaveServiceSuite) TestGetFavouritesStorageError() {
 	userID := uint64(1)
 	wantErr := errors.New("storage error")
 
-	s.newsStorage.EXPECT().GetFavourites(s.ctx, userID).Return(nil, wantErr)
+	s.newsStorage.EXPECT().GetFavourites(s.ctx, userID, models.PageRequest{Size: defaultPageSize}, false).Return(nil, "", wantErr)
 
-	actualNews, err := s.saveService.GetFavourites(s.ctx, userID)
+	actualNews, _, err := s.saveService.GetFavourites(s.ctx, userID, models.PageRequest{}, false)
 
 	assert.ErrorIs(s.T(), err, wantErr)
 	assert.Assert(s.T(), actualNews == nil)
 }
 
 func (s *SaveServiceSuite) TestAddToSearchHistorySuccess() {
-	userID := uint64(1)
-	query := "bitcoin news"
-	results := []uint64{100, 101}
+	language := "en"
+	entry := &models.SearchHistoryEntry{
+		UserID:       1,
+		Query:        "bitcoin news",
+		Filters:      models.SearchFilters{Language: &language},
+		TotalResults: 2,
+		ResultIDs:    []uint64{100, 101},
+	}
 
-	s.newsStorage.EXPECT().AddToSearchHistory(s.ctx, userID, query, results).Return(nil)
+	s.newsStorage.EXPECT().AddToSearchHistory(s.ctx, entry).Return(nil)
 
-	err := s.saveService.AddToSearchHistory(s.ctx, userID, query, results)
+	err := s.saveService.AddToSearchHistory(s.ctx, entry)
 
 	assert.NilError(s.T(), err)
 }
 
 func (s *SaveServiceSuite) TestAddToSearchHistoryStorageError() {
-	userID := uint64(1)
-	query := "bitcoin news"
-	results := []uint64{100, 101}
+	entry := &models.SearchHistoryEntry{
+		UserID:    1,
+		Query:     "bitcoin news",
+		ResultIDs: []uint64{100, 101},
+	}
 	wantErr := errors.New("storage error")
 
-	s.newsStorage.EXPECT().AddToSearchHistory(s.ctx, userID, query, results).Return(wantErr)
+	s.newsStorage.EXPECT().AddToSearchHistory(s.ctx, entry).Return(wantErr)
 
-	err := s.saveService.AddToSearchHistory(s.ctx, userID, query, results)
+	err := s.saveService.AddToSearchHistory(s.ctx, entry)
 
 	assert.ErrorIs(s.T(), err, wantErr)
 }
 
 func (s *SaveServiceSuite) TestGetSearchHistorySuccess() {
 	userID := uint64(1)
-	expectedQueries := []string{"bitcoin", "ethereum", "crypto"}
+	expectedEntries := []*models.SearchHistoryEntry{
+		{ID: 3, UserID: userID, Query: "bitcoin", SearchedAt: time.Now(), ResultIDs: []uint64{100}},
+		{ID: 2, UserID: userID, Query: "ethereum", SearchedAt: time.Now()},
+	}
+
+	s.newsStorage.EXPECT().GetSearchHistory(s.ctx, userID, models.PageRequest{Size: defaultPageSize}, false).Return(expectedEntries, "next", nil)
+
+	actualEntries, nextToken, err := s.saveService.GetSearchHistory(s.ctx, userID, models.PageRequest{}, false)
+
+	assert.NilError(s.T(), err)
+	assert.DeepEqual(s.T(), expectedEntries, actualEntries)
+	assert.Equal(s.T(), "next", nextToken)
+}
+
+func (s *SaveServiceSuite) TestGetSearchHistoryUniqueQueries() {
+	userID := uint64(1)
+	expectedEntries := []*models.SearchHistoryEntry{
+		{ID: 3, UserID: userID, Query: "bitcoin", Occurrences: 4},
+	}
 
-	s.newsStorage.EXPECT().GetSearchHistory(s.ctx, userID).Return(expectedQueries, nil)
+	s.newsStorage.EXPECT().GetSearchHistory(s.ctx, userID, models.PageRequest{Size: defaultPageSize}, true).Return(expectedEntries, "", nil)
 
-	actualQueries, err := s.saveService.GetSearchHistory(s.ctx, userID)
+	actualEntries, _, err := s.saveService.GetSearchHistory(s.ctx, userID, models.PageRequest{}, true)
 
 	assert.NilError(s.T(), err)
-	assert.DeepEqual(s.T(), expectedQueries, actualQueries)
+	assert.DeepEqual(s.T(), expectedEntries, actualEntries)
 }
 
 func (s *SaveServiceSuite) TestGetSearchHistoryStorageError() {
 	userID := uint64(1)
 	wantErr := errors.New("storage error")
 
-	s.newsStorage.EXPECT().GetSearchHistory(s.ctx, userID).Return(nil, wantErr)
+	s.newsStorage.EXPECT().GetSearchHistory(s.ctx, userID, models.PageRequest{Size: defaultPageSize}, false).Return(nil, "", wantErr)
 
-	actualQueries, err := s.saveService.GetSearchHistory(s.ctx, userID)
+	actualEntries, _, err := s.saveService.GetSearchHistory(s.ctx, userID, models.PageRequest{}, false)
 
 	assert.ErrorIs(s.T(), err, wantErr)
-	assert.Assert(s.T(), actualQueries == nil)
+	assert.Assert(s.T(), actualEntries == nil)
+}
+
+func (s *SaveServiceSuite) TestGetSearchHistoryEntryNotFound() {
+	s.newsStorage.EXPECT().GetSearchHistoryEntry(s.ctx, uint64(1), uint64(42)).Return(nil, models.ErrNotFound)
+
+	entry, err := s.saveService.GetSearchHistoryEntry(s.ctx, 1, 42)
+
+	assert.ErrorIs(s.T(), err, models.ErrNotFound)
+	assert.Assert(s.T(), entry == nil)
 }
 
 func (s *SaveServiceSuite) TestSubscribeSuccess() {
 	userID := uint64(1)
 	keyword := "technology"
 
-	s.newsStorage.EXPECT().Subscribe(s.ctx, userID, keyword).Return(nil)
+	s.newsStorage.EXPECT().Subscribe(s.ctx, userID, keyword, "@every 2h").Return(nil)
 
-	err := s.saveService.Subscribe(s.ctx, userID, keyword)
+	err := s.saveService.Subscribe(s.ctx, userID, keyword, " @every 2h ")
 
 	assert.NilError(s.T(), err)
 }
 
+func (s *SaveServiceSuite) TestSubscribeInvalidSchedule() {
+	err := s.saveService.Subscribe(s.ctx, 1, "technology", "*/5 * *")
+
+	assert.ErrorIs(s.T(), err, models.ErrInvalidSchedule)
+}
+
 func (s *SaveServiceSuite) TestSubscribeStorageError() {
 	userID := uint64(1)
 	keyword := "technology"
 	wantErr := errors.New("storage error")
 
-	s.newsStorage.EXPECT().Subscribe(s.ctx, userID, keyword).Return(wantErr)
+	s.newsStorage.EXPECT().Subscribe(s.ctx, userID, keyword, "").Return(wantErr)
 
-	err := s.saveService.Subscribe(s.ctx, userID, keyword)
+	err := s.saveService.Subscribe(s.ctx, userID, keyword, "")
 
 	assert.ErrorIs(s.T(), err, wantErr)
 }
@@ -194,9 +325,9 @@ func (s *SaveServiceSuite) TestGetSubscriptionsSuccess() {
 		},
 	}
 
-	s.newsStorage.EXPECT().GetSubscriptions(s.ctx).Return(expectedSubscriptions, nil)
+	s.newsStorage.EXPECT().GetSubscriptions(s.ctx, uint64(0), models.PageRequest{Size: defaultPageSize}).Return(expectedSubscriptions, "", nil)
 
-	actualSubscriptions, err := s.saveService.GetSubscriptions(s.ctx)
+	actualSubscriptions, _, err := s.saveService.GetSubscriptions(s.ctx, 0, models.PageRequest{})
 
 	assert.NilError(s.T(), err)
 	assert.DeepEqual(s.T(), expectedSubscriptions, actualSubscriptions)
@@ -205,9 +336,9 @@ func (s *SaveServiceSuite) TestGetSubscriptionsSuccess() {
 func (s *SaveServiceSuite) TestGetSubscriptionsStorageError() {
 	wantErr := errors.New("storage error")
 
-	s.newsStorage.EXPECT().GetSubscriptions(s.ctx).Return(nil, wantErr)
+	s.newsStorage.EXPECT().GetSubscriptions(s.ctx, uint64(0), models.PageRequest{Size: defaultPageSize}).Return(nil, "", wantErr)
 
-	actualSubscriptions, err := s.saveService.GetSubscriptions(s.ctx)
+	actualSubscriptions, _, err := s.saveService.GetSubscriptions(s.ctx, 0, models.PageRequest{})
 
 	assert.ErrorIs(s.T(), err, wantErr)
 	assert.Assert(s.T(), actualSubscriptions == nil)
@@ -217,9 +348,9 @@ func (s *SaveServiceSuite) TestMarkNewsAsSeenSuccess() {
 	userID := uint64(1)
 	newsID := uint64(100)
 
-	s.newsStorage.EXPECT().MarkNewsAsSeen(s.ctx, userID, newsID).Return(nil)
+	s.newsStorage.EXPECT().MarkNewsAsSeen(s.ctx, userID, []uint64{newsID}).Return(nil)
 
-	err := s.saveService.MarkNewsAsSeen(s.ctx, userID, newsID)
+	err := s.saveService.MarkNewsAsSeen(s.ctx, userID, []uint64{newsID})
 
 	assert.NilError(s.T(), err)
 }
@@ -229,9 +360,9 @@ func (s *SaveServiceSuite) TestMarkNewsAsSeenStorageError() {
 	newsID := uint64(100)
 	wantErr := errors.New("storage error")
 
-	s.newsStorage.EXPECT().MarkNewsAsSeen(s.ctx, userID, newsID).Return(wantErr)
+	s.newsStorage.EXPECT().MarkNewsAsSeen(s.ctx, userID, []uint64{newsID}).Return(wantErr)
 
-	err := s.saveService.MarkNewsAsSeen(s.ctx, userID, newsID)
+	err := s.saveService.MarkNewsAsSeen(s.ctx, userID, []uint64{newsID})
 
 	assert.ErrorIs(s.T(), err, wantErr)
 }
@@ -249,11 +380,12 @@ func (s *SaveServiceSuite) TestSaveNewsSuccess() {
 		},
 	}
 
-	s.newsStorage.EXPECT().UpsertNews(s.ctx, news).Return(nil)
+	s.newsStorage.EXPECT().UpsertNews(s.ctx, news).Return([]uint64{7}, nil)
 
-	err := s.saveService.SaveNews(s.ctx, news)
+	ids, err := s.saveService.SaveNews(s.ctx, news)
 
 	assert.NilError(s.T(), err)
+	assert.DeepEqual(s.T(), []uint64{7}, ids)
 }
 
 func (s *SaveServiceSuite) TestSaveNewsStorageError() {
@@ -270,9 +402,9 @@ func (s *SaveServiceSuite) TestSaveNewsStorageError() {
 	}
 	wantErr := errors.New("storage error")
 
-	s.newsStorage.EXPECT().UpsertNews(s.ctx, news).Return(wantErr)
+	s.newsStorage.EXPECT().UpsertNews(s.ctx, news).Return(nil, wantErr)
 
-	err := s.saveService.SaveNews(s.ctx, news)
+	_, err := s.saveService.SaveNews(s.ctx, news)
 
 	assert.ErrorIs(s.T(), err, wantErr)
 }
@@ -312,8 +444,125 @@ func (s *SaveServiceSuite) TestGetNewsByIDsStorageError() {
 	assert.Assert(s.T(), actualNews == nil)
 }
 
+func (s *SaveServiceSuite) TestCreateSavedSearchAlreadyExists() {
+	savedSearch := &models.SavedSearch{UserID: 1, Name: "crypto", Query: "bitcoin"}
+
+	s.newsStorage.EXPECT().CreateSavedSearch(s.ctx, savedSearch).Return(nil, models.ErrAlreadyExists)
+
+	actual, err := s.saveService.CreateSavedSearch(s.ctx, savedSearch)
+
+	assert.ErrorIs(s.T(), err, models.ErrAlreadyExists)
+	assert.Assert(s.T(), actual == nil)
+}
+
+func (s *SaveServiceSuite) TestListSavedSearchesDueOnly() {
+	expected := []*models.SavedSearch{
+		{ID: 1, UserID: 2, Name: "crypto", Query: "bitcoin", RefreshIntervalMinutes: 60},
+	}
+
+	s.newsStorage.EXPECT().ListSavedSearches(s.ctx, uint64(0), models.PageRequest{Size: maxPageSize}, true).Return(expected, "next", nil)
+
+	actual, next, err := s.saveService.ListSavedSearches(s.ctx, 0, models.PageRequest{Size: 500}, true)
+
+	assert.NilError(s.T(), err)
+	assert.Equal(s.T(), "next", next)
+	assert.DeepEqual(s.T(), expected, actual)
+}
+
+func (s *SaveServiceSuite) TestRecordSavedSearchRun() {
+	resultIDs := []uint64{1, 2, 3}
+	expected := &models.SavedSearch{ID: 7, LastResultIDs: resultIDs, NewResultIDs: []uint64{3}}
+
+	s.newsStorage.EXPECT().RecordSavedSearchRun(s.ctx, uint64(7), resultIDs).Return(expected, nil)
+
+	actual, err := s.saveService.RecordSavedSearchRun(s.ctx, 7, resultIDs)
+
+	assert.NilError(s.T(), err)
+	assert.DeepEqual(s.T(), expected, actual)
+}
+
+func (s *SaveServiceSuite) TestShareCollectionGeneratesToken() {
+	var token string
+	s.newsStorage.EXPECT().SetCollectionShareToken(s.ctx, uint64(1), uint64(5), mock.AnythingOfType("string")).
+		RunAndReturn(func(_ context.Context, userID, collectionID uint64, shareToken string) (*models.Collection, error) {
+			token = shareToken
+			return &models.Collection{ID: collectionID, UserID: userID, ShareToken: shareToken}, nil
+		})
+
+	collection, err := s.saveService.ShareCollection(s.ctx, 1, 5, true)
+
+	assert.NilError(s.T(), err)
+	assert.Assert(s.T(), len(token) >= 32)
+	assert.Equal(s.T(), token, collection.ShareToken)
+}
+
+func (s *SaveServiceSuite) TestShareCollectionRevoke() {
+	s.newsStorage.EXPECT().SetCollectionShareToken(s.ctx, uint64(1), uint64(5), "").Return(&models.Collection{ID: 5}, nil)
+
+	collection, err := s.saveService.ShareCollection(s.ctx, 1, 5, false)
+
+	assert.NilError(s.T(), err)
+	assert.Equal(s.T(), "", collection.ShareToken)
+}
+
+func (s *SaveServiceSuite) TestDeleteDefaultCollection() {
+	s.newsStorage.EXPECT().DeleteCollection(s.ctx, uint64(1), uint64(0)).Return(models.ErrDefaultCollection)
+
+	err := s.saveService.DeleteCollection(s.ctx, 1, 0)
+
+	assert.ErrorIs(s.T(), err, models.ErrDefaultCollection)
+}
+
+func (s *SaveServiceSuite) TestSetFavouriteTagsNormalizes() {
+	expected := &models.FavouriteAnnotation{NewsID: 7, Tags: []string{"ai", "markets"}}
+
+	s.newsStorage.EXPECT().SetFavouriteTags(s.ctx, uint64(1), uint64(7), []string{"ai", "markets"}).Return(expected, nil)
+
+	actual, err := s.saveService.SetFavouriteTags(s.ctx, 1, 7, []string{" AI ", "markets", "", "ai"})
+
+	assert.NilError(s.T(), err)
+	assert.DeepEqual(s.T(), expected, actual)
+}
+
+func (s *SaveServiceSuite) TestSearchFavouritesByTag() {
+	filter := models.FavouriteFilter{Tag: "markets", NoteQuery: "rates"}
+
+	s.newsStorage.EXPECT().SearchFavourites(s.ctx, uint64(1), filter, models.PageRequest{Size: defaultPageSize}).Return(nil, "", nil)
+
+	_, _, err := s.saveService.SearchFavourites(s.ctx, 1, models.FavouriteFilter{Tag: " Markets", NoteQuery: "rates "}, models.PageRequest{})
+
+	assert.NilError(s.T(), err)
+}
+
+func (s *SaveServiceSuite) TestAddHighlightOutOfContent() {
+	highlight := &models.Highlight{UserID: 1, NewsID: 7, StartOffset: 10, EndOffset: 5000}
+
+	s.newsStorage.EXPECT().AddHighlight(s.ctx, highlight).Return(nil, models.ErrInvalidHighlight)
+
+	actual, err := s.saveService.AddHighlight(s.ctx, highlight)
+
+	assert.ErrorIs(s.T(), err, models.ErrInvalidHighlight)
+	assert.Assert(s.T(), actual == nil)
+}
+
+func (s *SaveServiceSuite) TestMarkNewsAsSeenDeduplicates() {
+	s.newsStorage.EXPECT().MarkNewsAsSeen(s.ctx, uint64(1), []uint64{100, 101}).Return(nil)
+
+	err := s.saveService.MarkNewsAsSeen(s.ctx, 1, []uint64{100, 101, 100})
+
+	assert.NilError(s.T(), err)
+}
+
+func (s *SaveServiceSuite) TestGetFavouritesUnseenOnly() {
+	s.newsStorage.EXPECT().GetFavourites(s.ctx, uint64(1), models.PageRequest{Size: defaultPageSize}, true).Return(nil, "", nil)
+
+	_, _, err := s.saveService.GetFavourites(s.ctx, 1, models.PageRequest{}, true)
+
+	assert.NilError(s.T(), err)
+}
+
 func (s *SaveServiceSuite) TestNewSaveService() {
-	service := NewSaveService(s.ctx, s.newsStorage)
+	service := NewSaveService(s.ctx, s.newsStorage, defaultPageSize, maxPageSize)
 	assert.Assert(s.T(), service != nil)
 	assert.Equal(s.T(), s.newsStorage, service.newsStorage)
 }
@@ -321,3 +570,107 @@ func (s *SaveServiceSuite) TestNewSaveService() {
 func TestSaveServiceSuite(t *testing.T) {
 	suite.Run(t, new(SaveServiceSuite))
 }
+
+func testUserExport() *models.UserExport {
+	return &models.UserExport{
+		ExportedAt:    time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		Profile:       &models.User{ID: 1, DisplayName: "alice"},
+		Subscriptions: []*models.Subscription{{ID: 1, UserID: 1, Keyword: "go"}},
+	}
+}
+
+func (s *SaveServiceSuite) TestExportUserDataJSON() {
+	s.newsStorage.EXPECT().ExportUserData(s.ctx, uint64(1)).Return(testUserExport(), nil)
+
+	file, err := s.saveService.ExportUserData(s.ctx, 1, models.ExportFormatJSON)
+
+	assert.NilError(s.T(), err)
+	assert.Equal(s.T(), "application/json", file.ContentType)
+	assert.Equal(s.T(), "user-1-export-20240102T030405Z.json", file.Filename)
+
+	var export models.UserExport
+	assert.NilError(s.T(), json.Unmarshal(file.Data, &export))
+	assert.Equal(s.T(), "alice", export.Profile.DisplayName)
+	assert.Equal(s.T(), "go", export.Subscriptions[0].Keyword)
+}
+
+func (s *SaveServiceSuite) TestExportUserDataZIP() {
+	s.newsStorage.EXPECT().ExportUserData(s.ctx, uint64(1)).Return(testUserExport(), nil)
+
+	file, err := s.saveService.ExportUserData(s.ctx, 1, models.ExportFormatZIP)
+
+	assert.NilError(s.T(), err)
+	assert.Equal(s.T(), "application/zip", file.ContentType)
+
+	archive, err := zip.NewReader(bytes.NewReader(file.Data), int64(len(file.Data)))
+	assert.NilError(s.T(), err)
+	names := make([]string, len(archive.File))
+	for i, f := range archive.File {
+		names[i] = f.Name
+	}
+	assert.DeepEqual(s.T(), []string{"profile.json", "collections.json", "annotations.json", "search_history.json",
+		"saved_searches.json", "subscriptions.json", "seen_news.json", "notifications.json"}, names)
+}
+
+func (s *SaveServiceSuite) TestExportUserDataNotFound() {
+	s.newsStorage.EXPECT().ExportUserData(s.ctx, uint64(1)).Return(nil, models.ErrNotFound)
+
+	_, err := s.saveService.ExportUserData(s.ctx, 1, models.ExportFormatJSON)
+
+	assert.ErrorIs(s.T(), err, models.ErrNotFound)
+}
+
+func (s *SaveServiceSuite) TestDeleteUser() {
+	s.newsStorage.EXPECT().DeleteUser(s.ctx, uint64(1)).Return(nil)
+
+	err := s.saveService.DeleteUser(s.ctx, 1)
+
+	assert.NilError(s.T(), err)
+}
+
+func (s *SaveServiceSuite) TestCreateAPIKeyStoresOnlyHash() {
+	var storedHash string
+	s.newsStorage.EXPECT().CreateAPIKey(s.ctx, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, key *models.APIKey, keyHash string) (*models.APIKey, error) {
+			storedHash = keyHash
+			key.ID = 5
+			return key, nil
+		})
+
+	key, secret, err := s.saveService.CreateAPIKey(s.ctx, " ingest ", []string{"read:search", " Read:Search", "admin"}, 0)
+
+	assert.NilError(s.T(), err)
+	assert.Equal(s.T(), "ingest", key.Name)
+	assert.DeepEqual(s.T(), []string{models.ScopeReadSearch, models.ScopeAdmin}, key.Scopes)
+	assert.Equal(s.T(), secret[:apiKeyPrefixLength], key.Prefix)
+	assert.Equal(s.T(), hashAPIKey(secret), storedHash)
+	assert.Assert(s.T(), storedHash != secret)
+	assert.Assert(s.T(), key.UserID == nil)
+}
+
+func (s *SaveServiceSuite) TestCreateAPIKeyUnknownScope() {
+	_, _, err := s.saveService.CreateAPIKey(s.ctx, "ingest", []string{"delete:everything"}, 0)
+
+	assert.ErrorIs(s.T(), err, models.ErrInvalidAPIKeySettings)
+}
+
+func (s *SaveServiceSuite) TestAuthenticateAPIKey() {
+	secret := apiKeyTokenPrefix + "secret"
+	s.newsStorage.EXPECT().GetAPIKeyByHash(s.ctx, hashAPIKey(secret)).
+		Return(&models.APIKey{ID: 5, Scopes: []string{models.ScopeReadSearch}}, nil)
+	s.newsStorage.EXPECT().TouchAPIKey(s.ctx, uint64(5)).Return(nil)
+
+	key, err := s.saveService.AuthenticateAPIKey(s.ctx, secret)
+
+	assert.NilError(s.T(), err)
+	assert.Equal(s.T(), uint64(5), key.ID)
+}
+
+func (s *SaveServiceSuite) TestAuthenticateAPIKeyRevoked() {
+	secret := apiKeyTokenPrefix + "revoked"
+	s.newsStorage.EXPECT().GetAPIKeyByHash(s.ctx, hashAPIKey(secret)).Return(nil, models.ErrNotFound)
+
+	_, err := s.saveService.AuthenticateAPIKey(s.ctx, secret)
+
+	assert.ErrorIs(s.T(), err, models.ErrInvalidAPIKey)
+}
@@ -0,0 +1,159 @@
+package saveService
+
+import (
+	"context"
+	"fmt"
+	"gonews/save_service/internal/models"
+	"net/mail"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+const (
+	maxHandleLength      = 32
+	maxDisplayNameLength = 255
+	maxDefaultPageSize   = 100
+)
+
+var (
+	handlePattern  = regexp.MustCompile(`^[a-z0-9_]{2,32}$`)
+	isoCodePattern = regexp.MustCompile(`^[a-z]{2}$`)
+	nonHandleChars = regexp.MustCompile(`[^a-z0-9_]+`)
+	sortByValues   = []string{"relevancy", "popularity", "publishedAt"}
+)
+
+// CreateUser - создаём пользователя; без handle он строится из отображаемого имени
+func (s *SaveService) CreateUser(ctx context.Context, user *models.User) (*models.User, error) {
+	user.DisplayName = strings.TrimSpace(user.DisplayName)
+	user.Handle = normalizeHandle(user.Handle)
+	if user.Handle == "" {
+		user.Handle = handleFromName(user.DisplayName)
+	}
+	if user.DisplayName == "" {
+		user.DisplayName = user.Handle
+	}
+	user.Email = strings.TrimSpace(user.Email)
+	user.Language = normalizeCode(user.Language)
+	user.Country = normalizeCode(user.Country)
+	user.Timezone = strings.TrimSpace(user.Timezone)
+
+	err := validateProfile(&models.UserUpdate{
+		Handle:         &user.Handle,
+		Email:          &user.Email,
+		DisplayName:    &user.DisplayName,
+		Language:       &user.Language,
+		Country:        &user.Country,
+		Timezone:       &user.Timezone,
+		DefaultFilters: &user.DefaultFilters,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.newsStorage.CreateUser(ctx, user)
+}
+
+func (s *SaveService) GetUser(ctx context.Context, userID uint64) (*models.User, error) {
+	return s.newsStorage.GetUser(ctx, userID)
+}
+
+func (s *SaveService) GetUserByHandle(ctx context.Context, handle string) (*models.User, error) {
+	return s.newsStorage.GetUserByHandle(ctx, normalizeHandle(handle))
+}
+
+// ListUsers - все пользователи постранично, для администрирования
+func (s *SaveService) ListUsers(ctx context.Context, page models.PageRequest) ([]*models.User, string, error) {
+	return s.newsStorage.ListUsers(ctx, s.pageRequest(page))
+}
+
+// RunMigrations - повторно применяет схему базы и новые миграции данных, не дожидаясь перезапуска сервиса
+func (s *SaveService) RunMigrations(ctx context.Context) error {
+	return s.newsStorage.InitTables(ctx)
+}
+
+// UpdateUser - частичное изменение профиля
+func (s *SaveService) UpdateUser(ctx context.Context, userID uint64, update *models.UserUpdate) (*models.User, error) {
+	trim := func(v *string, normalize func(string) string) {
+		if v != nil {
+			*v = normalize(*v)
+		}
+	}
+	trim(update.Handle, normalizeHandle)
+	trim(update.Email, strings.TrimSpace)
+	trim(update.DisplayName, strings.TrimSpace)
+	trim(update.Language, normalizeCode)
+	trim(update.Country, normalizeCode)
+	trim(update.Timezone, strings.TrimSpace)
+
+	if err := validateProfile(update); err != nil {
+		return nil, err
+	}
+
+	return s.newsStorage.UpdateUser(ctx, userID, update)
+}
+
+// normalizeHandle - handle сравнивается без учёта регистра
+func normalizeHandle(handle string) string {
+	return strings.ToLower(strings.TrimSpace(handle))
+}
+
+// normalizeCode - коды языка и страны NewsAPI в нижнем регистре
+func normalizeCode(code string) string {
+	return strings.ToLower(strings.TrimSpace(code))
+}
+
+// handleFromName - handle из имени: латиница, цифры и подчёркивания
+func handleFromName(name string) string {
+	handle := strings.Trim(nonHandleChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if len(handle) > maxHandleLength {
+		handle = strings.TrimRight(handle[:maxHandleLength], "_")
+	}
+	return handle
+}
+
+// validateProfile - проверяет заданные поля профиля, ошибка оборачивает ErrInvalidProfile
+func validateProfile(update *models.UserUpdate) error {
+	invalid := func(format string, args ...any) error {
+		return fmt.Errorf("%w: %s", models.ErrInvalidProfile, fmt.Sprintf(format, args...))
+	}
+
+	if update.Handle != nil && !handlePattern.MatchString(*update.Handle) {
+		return invalid("handle must be 2-32 characters of a-z, 0-9 and _")
+	}
+	if update.DisplayName != nil && (*update.DisplayName == "" || len(*update.DisplayName) > maxDisplayNameLength) {
+		return invalid("display name must be 1-%d characters", maxDisplayNameLength)
+	}
+	if update.Email != nil && *update.Email != "" {
+		address, err := mail.ParseAddress(*update.Email)
+		if err != nil || address.Address != *update.Email {
+			return invalid("email is not valid")
+		}
+	}
+	if update.Language != nil && *update.Language != "" && !isoCodePattern.MatchString(*update.Language) {
+		return invalid("language must be a two-letter code")
+	}
+	if update.Country != nil && *update.Country != "" && !isoCodePattern.MatchString(*update.Country) {
+		return invalid("country must be a two-letter code")
+	}
+	if update.Timezone != nil && *update.Timezone != "" {
+		if _, err := time.LoadLocation(*update.Timezone); err != nil {
+			return invalid("unknown timezone %q", *update.Timezone)
+		}
+	}
+	if filters := update.DefaultFilters; filters != nil {
+		if filters.SortBy != nil && !lo.Contains(sortByValues, *filters.SortBy) {
+			return invalid("default sort_by must be one of %s", strings.Join(sortByValues, ", "))
+		}
+		if filters.PageSize != nil && (*filters.PageSize <= 0 || *filters.PageSize > maxDefaultPageSize) {
+			return invalid("default page_size must be between 1 and %d", maxDefaultPageSize)
+		}
+		if filters.From != nil || filters.To != nil || filters.Page != nil {
+			return invalid("default filters cannot include from, to or page")
+		}
+	}
+
+	return nil
+}
@@ -9,8 +9,11 @@ import (
 	"github.com/samber/lo"
 )
 
-func (storage *PGStorage) UpsertNews(ctx context.Context, news []*models.News) error {
-	news_ := lo.Map(news, func(n *models.News, _ int) *News {
+// UpsertNews - сохраняем новости (url уникален) и возвращаем их ID в порядке входного списка
+func (storage *PGStorage) UpsertNews(ctx context.Context, news []*models.News) ([]uint64, error) {
+	// ON CONFLICT не может обновить одну строку дважды за запрос, поэтому убираем дубли по url
+	uniqueNews := lo.UniqBy(news, func(n *models.News) string { return n.URL })
+	news_ := lo.Map(uniqueNews, func(n *models.News, _ int) *News {
 		return &News{
 			Source:      n.Source,
 			Author:      n.Author,
@@ -19,23 +22,47 @@ func (storage *PGStorage) UpsertNews(ctx context.Context, news []*models.News) e
 			URL:         n.URL,
 			ImageURL:    n.ImageURL,
 			PublishedAt: n.PublishedAt,
+			Content:     n.Content,
 		}
 	})
 	query := squirrel.Insert("news").
-		Columns("source", "author", "title", "description", "url", "image_url", "published_at").
+		Columns("source", "author", "title", "description", "url", "image_url", "published_at", "content").
+		Suffix(`ON CONFLICT (url) DO UPDATE SET
+			source = EXCLUDED.source,
+			author = EXCLUDED.author,
+			title = EXCLUDED.title,
+			description = EXCLUDED.description,
+			image_url = EXCLUDED.image_url,
+			published_at = EXCLUDED.published_at,
+			content = COALESCE(NULLIF(EXCLUDED.content, ''), news.content)
+			RETURNING id, url`).
 		PlaceholderFormat(squirrel.Dollar)
 	for _, n := range news_ {
-		query = query.Values(n.Source, n.Author, n.Title, n.Description, n.URL, n.ImageURL, n.PublishedAt)
+		query = query.Values(n.Source, n.Author, n.Title, n.Description, n.URL, n.ImageURL, n.PublishedAt, n.Content)
 	}
 	queryText, args, err := query.ToSql()
 	if err != nil {
-		return errors.Wrap(err, "query generation error")
+		return nil, errors.Wrap(err, "query generation error")
 	}
 
-	_, err = storage.DB.Exec(ctx, queryText, args...)
+	rows, err := storage.DB.Query(ctx, queryText, args...)
 	if err != nil {
-		return errors.Wrap(err, "query execution error")
+		return nil, errors.Wrap(err, "query execution error")
 	}
+	defer rows.Close()
 
-	return nil
+	idByURL := make(map[string]uint64, len(news_))
+	for rows.Next() {
+		var id uint64
+		var url string
+		if err := rows.Scan(&id, &url); err != nil {
+			return nil, errors.Wrap(err, "failed to scan row")
+		}
+		idByURL[url] = id
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "query execution error")
+	}
+
+	return lo.Map(news, func(n *models.News, _ int) uint64 { return idByURL[n.URL] }), nil
 }
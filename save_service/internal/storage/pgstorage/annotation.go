@@ -0,0 +1,305 @@
+package pgstorage
+
+import (
+	"context"
+	"gonews/save_service/internal/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+// savedByUserQuery - статьи, лежащие хотя бы в одной коллекции пользователя
+const savedByUserQuery = `SELECT ci.news_id FROM collection_items ci
+	JOIN collections c ON c.id = ci.collection_id
+	WHERE c.user_id = ?`
+
+// ensureSaved - пометки можно ставить только на сохранённые пользователем статьи
+func ensureSaved(ctx context.Context, q querier, userID, newsID uint64) error {
+	var saved bool
+	err := q.QueryRow(ctx, `
+		SELECT EXISTS(
+			SELECT 1 FROM collection_items ci
+			JOIN collections c ON c.id = ci.collection_id
+			WHERE c.user_id = $1 AND ci.news_id = $2
+		)`, userID, newsID).Scan(&saved)
+	if err != nil {
+		return errors.Wrap(err, "query execution error")
+	}
+	if !saved {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
+
+// GetFavouriteAnnotation - теги, заметка и выделения к сохранённой статье
+func (storage *PGStorage) GetFavouriteAnnotation(ctx context.Context, userID, newsID uint64) (*models.FavouriteAnnotation, error) {
+	if err := ensureSaved(ctx, storage.DB, userID, newsID); err != nil {
+		return nil, err
+	}
+
+	annotations, err := storage.annotations(ctx, userID, []uint64{newsID})
+	if err != nil {
+		return nil, err
+	}
+
+	return annotations[newsID], nil
+}
+
+// SetFavouriteTags - заменяем набор тегов статьи
+func (storage *PGStorage) SetFavouriteTags(ctx context.Context, userID, newsID uint64, tags []string) (*models.FavouriteAnnotation, error) {
+	tx, err := storage.DB.Begin(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback(ctx)
+
+	if err := ensureSaved(ctx, tx, userID, newsID); err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, `DELETE FROM favourite_tags WHERE user_id = $1 AND news_id = $2`, userID, newsID)
+	if err != nil {
+		return nil, errors.Wrap(err, "query execution error")
+	}
+
+	if len(tags) > 0 {
+		query := squirrel.Insert("favourite_tags").
+			Columns("user_id", "news_id", "tag").
+			Suffix("ON CONFLICT DO NOTHING").
+			PlaceholderFormat(squirrel.Dollar)
+		for _, tag := range tags {
+			query = query.Values(userID, newsID, tag)
+		}
+
+		queryText, args, err := query.ToSql()
+		if err != nil {
+			return nil, errors.Wrap(err, "query generation error")
+		}
+
+		if _, err := tx.Exec(ctx, queryText, args...); err != nil {
+			return nil, errors.Wrap(err, "query execution error")
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errors.Wrap(err, "failed to commit transaction")
+	}
+
+	return storage.GetFavouriteAnnotation(ctx, userID, newsID)
+}
+
+// SetFavouriteNote - сохраняем заметку к статье; пустая заметка удаляется
+func (storage *PGStorage) SetFavouriteNote(ctx context.Context, userID, newsID uint64, note string) (*models.FavouriteAnnotation, error) {
+	if err := ensureSaved(ctx, storage.DB, userID, newsID); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if note == "" {
+		_, err = storage.DB.Exec(ctx, `DELETE FROM favourite_notes WHERE user_id = $1 AND news_id = $2`, userID, newsID)
+	} else {
+		_, err = storage.DB.Exec(ctx, `
+			INSERT INTO favourite_notes (user_id, news_id, note) VALUES ($1, $2, $3)
+			ON CONFLICT (user_id, news_id) DO UPDATE SET note = EXCLUDED.note, updated_at = CURRENT_TIMESTAMP`,
+			userID, newsID, note)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "query execution error")
+	}
+
+	return storage.GetFavouriteAnnotation(ctx, userID, newsID)
+}
+
+// AddHighlight - сохраняем выделение вместе с текстом фрагмента.
+// Смещения проверяются по длине текста статьи в символах.
+func (storage *PGStorage) AddHighlight(ctx context.Context, highlight *models.Highlight) (*models.Highlight, error) {
+	if err := ensureSaved(ctx, storage.DB, highlight.UserID, highlight.NewsID); err != nil {
+		return nil, err
+	}
+
+	var h models.Highlight
+	err := storage.DB.QueryRow(ctx, `
+		INSERT INTO favourite_highlights (user_id, news_id, start_offset, end_offset, text, comment)
+		SELECT $1, n.id, $3::int, $4::int, substring(n.content FROM $3::int + 1 FOR $4::int - $3::int), $5
+		FROM news n
+		WHERE n.id = $2 AND char_length(n.content) >= $4::int
+		RETURNING id, user_id, news_id, start_offset, end_offset, text, comment, created_at`,
+		highlight.UserID, highlight.NewsID, highlight.StartOffset, highlight.EndOffset, highlight.Comment).
+		Scan(&h.ID, &h.UserID, &h.NewsID, &h.StartOffset, &h.EndOffset, &h.Text, &h.Comment, &h.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, models.ErrInvalidHighlight
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "query execution error")
+	}
+
+	return &h, nil
+}
+
+// DeleteHighlight - удаляем выделение пользователя
+func (storage *PGStorage) DeleteHighlight(ctx context.Context, userID, newsID, highlightID uint64) error {
+	tag, err := storage.DB.Exec(ctx, `
+		DELETE FROM favourite_highlights WHERE id = $1 AND user_id = $2 AND news_id = $3`,
+		highlightID, userID, newsID)
+	if err != nil {
+		return errors.Wrap(err, "query execution error")
+	}
+	if tag.RowsAffected() == 0 {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
+
+// SearchFavourites - сохранённые статьи пользователя с отбором по тегу и заметке (новые сначала)
+func (storage *PGStorage) SearchFavourites(ctx context.Context, userID uint64, filter models.FavouriteFilter, page models.PageRequest) ([]*models.AnnotatedNews, string, error) {
+	after, err := decodeCursor(page.Token)
+	if err != nil {
+		return nil, "", err
+	}
+
+	query := squirrel.Select("n.id", "n.source", "n.author", "n.title", "n.description",
+		"n.url", "n.image_url", "n.published_at").
+		From("news n").
+		Where("n.id IN ("+savedByUserQuery+")", userID).
+		OrderBy("n.id DESC").
+		Limit(uint64(page.Size) + 1).
+		PlaceholderFormat(squirrel.Dollar)
+	if filter.Tag != "" {
+		query = query.Where("n.id IN (SELECT news_id FROM favourite_tags WHERE user_id = ? AND tag = ?)",
+			userID, filter.Tag)
+	}
+	if filter.NoteQuery != "" {
+		query = query.Where(`n.id IN (SELECT news_id FROM favourite_notes WHERE user_id = ?
+			AND to_tsvector('simple', note) @@ websearch_to_tsquery('simple', ?))`, userID, filter.NoteQuery)
+	}
+	if after != nil {
+		query = query.Where(squirrel.Lt{"n.id": after.ID})
+	}
+
+	queryText, args, err := query.ToSql()
+	if err != nil {
+		return nil, "", errors.Wrap(err, "query generation error")
+	}
+
+	rows, err := storage.DB.Query(ctx, queryText, args...)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "query error")
+	}
+	defer rows.Close()
+
+	var favourites []*models.AnnotatedNews
+	for rows.Next() {
+		var n models.News
+		err := rows.Scan(&n.ID, &n.Source, &n.Author, &n.Title, &n.Description, &n.URL, &n.ImageURL, &n.PublishedAt)
+		if err != nil {
+			return nil, "", errors.Wrap(err, "failed to scan row")
+		}
+		favourites = append(favourites, &models.AnnotatedNews{News: &n})
+	}
+	rows.Close()
+
+	var nextToken string
+	if len(favourites) > page.Size {
+		favourites = favourites[:page.Size]
+		nextToken = encodeCursor(cursor{ID: favourites[page.Size-1].News.ID})
+	}
+
+	newsIDs := make([]uint64, len(favourites))
+	for i, f := range favourites {
+		newsIDs[i] = f.News.ID
+	}
+
+	annotations, err := storage.annotations(ctx, userID, newsIDs)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, f := range favourites {
+		f.Annotation = annotations[f.News.ID]
+	}
+
+	return favourites, nextToken, nil
+}
+
+// annotations - пометки пользователя к статьям, по одной на каждую статью из списка
+func (storage *PGStorage) annotations(ctx context.Context, userID uint64, newsIDs []uint64) (map[uint64]*models.FavouriteAnnotation, error) {
+	result := make(map[uint64]*models.FavouriteAnnotation, len(newsIDs))
+	for _, id := range newsIDs {
+		result[id] = &models.FavouriteAnnotation{NewsID: id, Tags: []string{}, Highlights: []*models.Highlight{}}
+	}
+	if len(newsIDs) == 0 {
+		return result, nil
+	}
+
+	tagRows, err := storage.query(ctx, squirrel.Select("news_id", "tag").
+		From("favourite_tags").
+		Where(squirrel.Eq{"user_id": userID, "news_id": newsIDs}).
+		OrderBy("tag"))
+	if err != nil {
+		return nil, err
+	}
+	for tagRows.Next() {
+		var newsID uint64
+		var tag string
+		if err := tagRows.Scan(&newsID, &tag); err != nil {
+			tagRows.Close()
+			return nil, errors.Wrap(err, "failed to scan row")
+		}
+		result[newsID].Tags = append(result[newsID].Tags, tag)
+	}
+	tagRows.Close()
+
+	noteRows, err := storage.query(ctx, squirrel.Select("news_id", "note").
+		From("favourite_notes").
+		Where(squirrel.Eq{"user_id": userID, "news_id": newsIDs}))
+	if err != nil {
+		return nil, err
+	}
+	for noteRows.Next() {
+		var newsID uint64
+		var note string
+		if err := noteRows.Scan(&newsID, &note); err != nil {
+			noteRows.Close()
+			return nil, errors.Wrap(err, "failed to scan row")
+		}
+		result[newsID].Note = note
+	}
+	noteRows.Close()
+
+	highlightRows, err := storage.query(ctx, squirrel.Select("id", "user_id", "news_id", "start_offset",
+		"end_offset", "text", "comment", "created_at").
+		From("favourite_highlights").
+		Where(squirrel.Eq{"user_id": userID, "news_id": newsIDs}).
+		OrderBy("start_offset", "id"))
+	if err != nil {
+		return nil, err
+	}
+	defer highlightRows.Close()
+	for highlightRows.Next() {
+		var h models.Highlight
+		err := highlightRows.Scan(&h.ID, &h.UserID, &h.NewsID, &h.StartOffset, &h.EndOffset, &h.Text, &h.Comment, &h.CreatedAt)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan row")
+		}
+		result[h.NewsID].Highlights = append(result[h.NewsID].Highlights, &h)
+	}
+
+	return result, nil
+}
+
+// query - выполняет select-запрос squirrel
+func (storage *PGStorage) query(ctx context.Context, query squirrel.SelectBuilder) (pgx.Rows, error) {
+	queryText, args, err := query.PlaceholderFormat(squirrel.Dollar).ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "query generation error")
+	}
+
+	rows, err := storage.DB.Query(ctx, queryText, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "query error")
+	}
+
+	return rows, nil
+}
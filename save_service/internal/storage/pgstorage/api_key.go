@@ -0,0 +1,174 @@
+package pgstorage
+
+import (
+	"context"
+	"gonews/save_service/internal/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+var apiKeyColumns = []string{
+	"id", "name", "key_prefix", "scopes", "created_at", "rotated_at", "last_used_at", "revoked_at", "user_id",
+}
+
+func scanAPIKey(row pgx.Row) (*models.APIKey, error) {
+	var key models.APIKey
+	err := row.Scan(&key.ID, &key.Name, &key.Prefix, &key.Scopes, &key.CreatedAt,
+		&key.RotatedAt, &key.LastUsedAt, &key.RevokedAt, &key.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// queryAPIKey - выполняет запрос, возвращающий одну строку api_keys
+func (storage *PGStorage) queryAPIKey(ctx context.Context, query squirrel.Sqlizer) (*models.APIKey, error) {
+	queryText, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "query generation error")
+	}
+
+	key, err := scanAPIKey(storage.DB.QueryRow(ctx, queryText, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, models.ErrNotFound
+	}
+	if isUniqueViolation(err) {
+		return nil, models.ErrAlreadyExists
+	}
+	if isForeignKeyViolation(err) {
+		return nil, models.ErrNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "query execution error")
+	}
+
+	return key, nil
+}
+
+// CreateAPIKey - сохраняем ключ; имя уникально среди неотозванных ключей, пользователь должен существовать
+func (storage *PGStorage) CreateAPIKey(ctx context.Context, key *models.APIKey, keyHash string) (*models.APIKey, error) {
+	query := squirrel.Insert("api_keys").
+		Columns("name", "key_prefix", "key_hash", "scopes", "user_id").
+		Values(key.Name, key.Prefix, keyHash, key.Scopes, key.UserID).
+		Suffix("RETURNING " + joinColumns(apiKeyColumns)).
+		PlaceholderFormat(squirrel.Dollar)
+
+	return storage.queryAPIKey(ctx, query)
+}
+
+// GetAPIKeyByHash - действующий (не отозванный) ключ по хэшу
+func (storage *PGStorage) GetAPIKeyByHash(ctx context.Context, keyHash string) (*models.APIKey, error) {
+	query := squirrel.Select(apiKeyColumns...).
+		From("api_keys").
+		Where(squirrel.Eq{"key_hash": keyHash, "revoked_at": nil}).
+		PlaceholderFormat(squirrel.Dollar)
+
+	return storage.queryAPIKey(ctx, query)
+}
+
+// ListAPIKeys - страница ключей, отозванные только при includeRevoked
+func (storage *PGStorage) ListAPIKeys(ctx context.Context, page models.PageRequest, includeRevoked bool) ([]*models.APIKey, string, error) {
+	after, err := decodeCursor(page.Token)
+	if err != nil {
+		return nil, "", err
+	}
+
+	query := squirrel.Select(apiKeyColumns...).
+		From("api_keys").
+		OrderBy("id").
+		Limit(uint64(page.Size) + 1).
+		PlaceholderFormat(squirrel.Dollar)
+	if !includeRevoked {
+		query = query.Where(squirrel.Eq{"revoked_at": nil})
+	}
+	if after != nil {
+		query = query.Where(squirrel.Gt{"id": after.ID})
+	}
+
+	queryText, args, err := query.ToSql()
+	if err != nil {
+		return nil, "", errors.Wrap(err, "query generation error")
+	}
+
+	rows, err := storage.DB.Query(ctx, queryText, args...)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "query error")
+	}
+	defer rows.Close()
+
+	var keys []*models.APIKey
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, "", errors.Wrap(err, "failed to scan row")
+		}
+		keys = append(keys, key)
+	}
+
+	var nextToken string
+	if len(keys) > page.Size {
+		keys = keys[:page.Size]
+		nextToken = encodeCursor(cursor{ID: keys[page.Size-1].ID})
+	}
+
+	return keys, nextToken, nil
+}
+
+// RotateAPIKey - заменяем секрет действующего ключа, имя и права сохраняются
+func (storage *PGStorage) RotateAPIKey(ctx context.Context, id uint64, prefix, keyHash string) (*models.APIKey, error) {
+	query := squirrel.Update("api_keys").
+		Set("key_prefix", prefix).
+		Set("key_hash", keyHash).
+		Set("rotated_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{"id": id, "revoked_at": nil}).
+		Suffix("RETURNING " + joinColumns(apiKeyColumns)).
+		PlaceholderFormat(squirrel.Dollar)
+
+	return storage.queryAPIKey(ctx, query)
+}
+
+// RevokeAPIKey - отзываем ключ; строка остаётся для истории
+func (storage *PGStorage) RevokeAPIKey(ctx context.Context, id uint64) error {
+	query := squirrel.Update("api_keys").
+		Set("revoked_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{"id": id, "revoked_at": nil}).
+		PlaceholderFormat(squirrel.Dollar)
+
+	queryText, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "query generation error")
+	}
+
+	tag, err := storage.DB.Exec(ctx, queryText, args...)
+	if err != nil {
+		return errors.Wrap(err, "query execution error")
+	}
+	if tag.RowsAffected() == 0 {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
+
+// TouchAPIKey - отмечаем использование ключа не чаще раза в минуту, чтобы не писать на каждый запрос
+func (storage *PGStorage) TouchAPIKey(ctx context.Context, id uint64) error {
+	query := squirrel.Update("api_keys").
+		Set("last_used_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{"id": id}).
+		Where("(last_used_at IS NULL OR last_used_at < CURRENT_TIMESTAMP - INTERVAL '1 minute')").
+		PlaceholderFormat(squirrel.Dollar)
+
+	queryText, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "query generation error")
+	}
+
+	if _, err := storage.DB.Exec(ctx, queryText, args...); err != nil {
+		return errors.Wrap(err, "query execution error")
+	}
+
+	return nil
+}
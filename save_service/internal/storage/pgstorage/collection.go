@@ -0,0 +1,440 @@
+package pgstorage
+
+import (
+	"context"
+	"gonews/save_service/internal/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+)
+
+const defaultCollectionName = "Favourites"
+
+// querier - общие методы пула и транзакции
+type querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+var collectionColumns = []string{
+	"c.id", "c.user_id", "c.name", "c.is_default", "COALESCE(c.share_token, '')",
+	"(SELECT COUNT(*) FROM collection_items ci WHERE ci.collection_id = c.id)", "c.created_at",
+}
+
+func scanCollection(row pgx.Row) (*models.Collection, error) {
+	var c models.Collection
+	err := row.Scan(&c.ID, &c.UserID, &c.Name, &c.IsDefault, &c.ShareToken, &c.ItemCount, &c.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// getCollection - коллекция по условию, ErrNotFound если её нет
+func (storage *PGStorage) getCollection(ctx context.Context, where squirrel.Sqlizer) (*models.Collection, error) {
+	queryText, args, err := squirrel.Select(collectionColumns...).
+		From("collections c").
+		Where(where).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "query generation error")
+	}
+
+	collection, err := scanCollection(storage.DB.QueryRow(ctx, queryText, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, models.ErrNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "query execution error")
+	}
+
+	return collection, nil
+}
+
+// resolveCollection - проверяет, что коллекция принадлежит пользователю.
+// collectionID == 0 - коллекция по умолчанию, создаётся при первом обращении.
+func resolveCollection(ctx context.Context, q querier, userID, collectionID uint64) (uint64, error) {
+	if collectionID == 0 {
+		_, err := q.Exec(ctx, `
+			INSERT INTO collections (user_id, name, is_default) VALUES ($1, $2, TRUE)
+			ON CONFLICT (user_id) WHERE is_default DO NOTHING`, userID, defaultCollectionName)
+		if isForeignKeyViolation(err) {
+			return 0, models.ErrNotFound
+		}
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to create default collection")
+		}
+	}
+
+	var id uint64
+	err := q.QueryRow(ctx, `
+		SELECT id FROM collections
+		WHERE user_id = $1 AND (id = $2 OR ($2 = 0 AND is_default))`, userID, collectionID).Scan(&id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, models.ErrNotFound
+	}
+	if err != nil {
+		return 0, errors.Wrap(err, "query execution error")
+	}
+
+	return id, nil
+}
+
+// CreateCollection - создаём коллекцию; имя уникально в пределах пользователя
+func (storage *PGStorage) CreateCollection(ctx context.Context, userID uint64, name string) (*models.Collection, error) {
+	queryText, args, err := squirrel.Insert("collections").
+		Columns("user_id", "name").
+		Values(userID, name).
+		Suffix("RETURNING id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "query generation error")
+	}
+
+	var id uint64
+	err = storage.DB.QueryRow(ctx, queryText, args...).Scan(&id)
+	if isUniqueViolation(err) {
+		return nil, models.ErrAlreadyExists
+	}
+	if isForeignKeyViolation(err) {
+		return nil, models.ErrNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "query execution error")
+	}
+
+	return storage.getCollection(ctx, squirrel.Eq{"c.id": id})
+}
+
+// ListCollections - страница коллекций пользователя
+func (storage *PGStorage) ListCollections(ctx context.Context, userID uint64, page models.PageRequest) ([]*models.Collection, string, error) {
+	after, err := decodeCursor(page.Token)
+	if err != nil {
+		return nil, "", err
+	}
+
+	query := squirrel.Select(collectionColumns...).
+		From("collections c").
+		Where(squirrel.Eq{"c.user_id": userID}).
+		OrderBy("c.id").
+		Limit(uint64(page.Size) + 1).
+		PlaceholderFormat(squirrel.Dollar)
+	if after != nil {
+		query = query.Where(squirrel.Gt{"c.id": after.ID})
+	}
+
+	queryText, args, err := query.ToSql()
+	if err != nil {
+		return nil, "", errors.Wrap(err, "query generation error")
+	}
+
+	rows, err := storage.DB.Query(ctx, queryText, args...)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "query error")
+	}
+	defer rows.Close()
+
+	var collections []*models.Collection
+	for rows.Next() {
+		collection, err := scanCollection(rows)
+		if err != nil {
+			return nil, "", errors.Wrap(err, "failed to scan row")
+		}
+		collections = append(collections, collection)
+	}
+
+	var nextToken string
+	if len(collections) > page.Size {
+		collections = collections[:page.Size]
+		nextToken = encodeCursor(cursor{ID: collections[page.Size-1].ID})
+	}
+
+	return collections, nextToken, nil
+}
+
+// RenameCollection - переименовываем коллекцию пользователя
+func (storage *PGStorage) RenameCollection(ctx context.Context, userID, collectionID uint64, name string) (*models.Collection, error) {
+	id, err := resolveCollection(ctx, storage.DB, userID, collectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = storage.DB.Exec(ctx, `UPDATE collections SET name = $1 WHERE id = $2`, name, id)
+	if isUniqueViolation(err) {
+		return nil, models.ErrAlreadyExists
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "query execution error")
+	}
+
+	return storage.getCollection(ctx, squirrel.Eq{"c.id": id})
+}
+
+// DeleteCollection - удаляем коллекцию вместе с её содержимым; избранное удалить нельзя
+func (storage *PGStorage) DeleteCollection(ctx context.Context, userID, collectionID uint64) error {
+	if collectionID == 0 {
+		return models.ErrDefaultCollection
+	}
+
+	collection, err := storage.getCollection(ctx, squirrel.Eq{"c.id": collectionID, "c.user_id": userID})
+	if err != nil {
+		return err
+	}
+	if collection.IsDefault {
+		return models.ErrDefaultCollection
+	}
+
+	_, err = storage.DB.Exec(ctx, `DELETE FROM collections WHERE id = $1`, collection.ID)
+	if err != nil {
+		return errors.Wrap(err, "query execution error")
+	}
+
+	return nil
+}
+
+// AddToCollection - добавляем новость в начало коллекции
+func (storage *PGStorage) AddToCollection(ctx context.Context, userID, collectionID, newsID uint64) error {
+	id, err := resolveCollection(ctx, storage.DB, userID, collectionID)
+	if err != nil {
+		return err
+	}
+
+	_, err = storage.DB.Exec(ctx, `
+		INSERT INTO collection_items (collection_id, news_id, position)
+		SELECT $1, $2, COALESCE(MIN(position), 0) - 1 FROM collection_items WHERE collection_id = $1
+		ON CONFLICT (collection_id, news_id) DO NOTHING`, id, newsID)
+	if isForeignKeyViolation(err) {
+		return models.ErrNotFound
+	}
+	if err != nil {
+		return errors.Wrap(err, "query execution error")
+	}
+
+	return nil
+}
+
+// RemoveFromCollection - убираем новость из коллекции
+func (storage *PGStorage) RemoveFromCollection(ctx context.Context, userID, collectionID, newsID uint64) error {
+	id, err := resolveCollection(ctx, storage.DB, userID, collectionID)
+	if err != nil {
+		return err
+	}
+
+	tag, err := storage.DB.Exec(ctx, `DELETE FROM collection_items WHERE collection_id = $1 AND news_id = $2`, id, newsID)
+	if err != nil {
+		return errors.Wrap(err, "query execution error")
+	}
+	if tag.RowsAffected() == 0 {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
+
+// GetCollectionItems - коллекция пользователя и страница её новостей
+func (storage *PGStorage) GetCollectionItems(ctx context.Context, userID, collectionID uint64, page models.PageRequest) (*models.Collection, []*models.News, string, error) {
+	id, err := resolveCollection(ctx, storage.DB, userID, collectionID)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	collection, err := storage.getCollection(ctx, squirrel.Eq{"c.id": id})
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	news, nextToken, err := storage.collectionItems(ctx, id, page, 0)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	return collection, news, nextToken, nil
+}
+
+// GetSharedCollection - опубликованная коллекция по токену ссылки
+func (storage *PGStorage) GetSharedCollection(ctx context.Context, shareToken string, page models.PageRequest) (*models.Collection, []*models.News, string, error) {
+	collection, err := storage.getCollection(ctx, squirrel.Eq{"c.share_token": shareToken})
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	news, nextToken, err := storage.collectionItems(ctx, collection.ID, page, 0)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	return collection, news, nextToken, nil
+}
+
+// collectionItems - страница новостей коллекции в пользовательском порядке.
+// excludeSeenBy != 0 - без новостей, просмотренных этим пользователем.
+func (storage *PGStorage) collectionItems(ctx context.Context, collectionID uint64, page models.PageRequest, excludeSeenBy uint64) ([]*models.News, string, error) {
+	after, err := decodeCursor(page.Token)
+	if err != nil {
+		return nil, "", err
+	}
+	if after != nil && after.Position == nil {
+		return nil, "", models.ErrInvalidPageToken
+	}
+
+	query := squirrel.Select("ci.id", "ci.position", "n.id", "n.source", "n.author", "n.title", "n.description",
+		"n.url", "n.image_url", "n.published_at").
+		From("collection_items ci").
+		Join("news n ON ci.news_id = n.id").
+		Where(squirrel.Eq{"ci.collection_id": collectionID}).
+		OrderBy("ci.position", "ci.id").
+		Limit(uint64(page.Size) + 1).
+		PlaceholderFormat(squirrel.Dollar)
+	if after != nil {
+		query = query.Where("(ci.position, ci.id) > (?, ?)", *after.Position, after.ID)
+	}
+	if excludeSeenBy != 0 {
+		query = query.Where("NOT EXISTS (SELECT 1 FROM UserToSeenNews s WHERE s.user_id = ? AND s.news_id = ci.news_id)",
+			excludeSeenBy)
+	}
+
+	queryText, args, err := query.ToSql()
+	if err != nil {
+		return nil, "", errors.Wrap(err, "query generation error")
+	}
+
+	rows, err := storage.DB.Query(ctx, queryText, args...)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "query error")
+	}
+	defer rows.Close()
+
+	var news []*models.News
+	var positions []cursor
+	for rows.Next() {
+		var n models.News
+		var c cursor
+		var position int64
+		err := rows.Scan(&c.ID, &position, &n.ID, &n.Source, &n.Author, &n.Title, &n.Description,
+			&n.URL, &n.ImageURL, &n.PublishedAt)
+		if err != nil {
+			return nil, "", errors.Wrap(err, "failed to scan row")
+		}
+		c.Position = &position
+		news = append(news, &n)
+		positions = append(positions, c)
+	}
+
+	var nextToken string
+	if len(news) > page.Size {
+		news = news[:page.Size]
+		nextToken = encodeCursor(positions[page.Size-1])
+	}
+
+	return news, nextToken, nil
+}
+
+// CopyCollectionItems - копируем новости в начало другой коллекции, при move убираем из исходной
+func (storage *PGStorage) CopyCollectionItems(ctx context.Context, userID, fromID, toID uint64, newsIDs []uint64, move bool) error {
+	tx, err := storage.DB.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback(ctx)
+
+	fromID, err = resolveCollection(ctx, tx, userID, fromID)
+	if err != nil {
+		return err
+	}
+	toID, err = resolveCollection(ctx, tx, userID, toID)
+	if err != nil {
+		return err
+	}
+	if fromID == toID {
+		return nil
+	}
+
+	// Скопированные новости сохраняют взаимный порядок исходной коллекции
+	items := squirrel.Select().
+		Column("?::bigint", toID).
+		Column("ci.news_id").
+		Column("(SELECT COALESCE(MIN(position), 0) FROM collection_items WHERE collection_id = ?) - "+
+			"ROW_NUMBER() OVER (ORDER BY ci.position DESC, ci.id DESC)", toID).
+		From("collection_items ci").
+		Where(squirrel.Eq{"ci.collection_id": fromID, "ci.news_id": newsIDs})
+
+	queryText, args, err := squirrel.Insert("collection_items").
+		Columns("collection_id", "news_id", "position").
+		Select(items).
+		Suffix("ON CONFLICT (collection_id, news_id) DO NOTHING").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "query generation error")
+	}
+
+	if _, err := tx.Exec(ctx, queryText, args...); err != nil {
+		return errors.Wrap(err, "query execution error")
+	}
+
+	if move {
+		queryText, args, err := squirrel.Delete("collection_items").
+			Where(squirrel.Eq{"collection_id": fromID, "news_id": newsIDs}).
+			PlaceholderFormat(squirrel.Dollar).
+			ToSql()
+		if err != nil {
+			return errors.Wrap(err, "query generation error")
+		}
+
+		if _, err := tx.Exec(ctx, queryText, args...); err != nil {
+			return errors.Wrap(err, "query execution error")
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+
+	return nil
+}
+
+// ReorderCollection - перечисленные новости встают в начало в заданном порядке, остальные следом
+func (storage *PGStorage) ReorderCollection(ctx context.Context, userID, collectionID uint64, newsIDs []uint64) error {
+	id, err := resolveCollection(ctx, storage.DB, userID, collectionID)
+	if err != nil {
+		return err
+	}
+
+	order := lo.Map(newsIDs, func(id uint64, _ int) int64 { return int64(id) })
+	_, err = storage.DB.Exec(ctx, `
+		WITH ordered AS (
+			SELECT id, ROW_NUMBER() OVER (
+				ORDER BY COALESCE(array_position($2::bigint[], news_id), 2147483647), position, id
+			) AS pos
+			FROM collection_items
+			WHERE collection_id = $1
+		)
+		UPDATE collection_items ci SET position = ordered.pos
+		FROM ordered
+		WHERE ci.id = ordered.id`, id, order)
+	if err != nil {
+		return errors.Wrap(err, "query execution error")
+	}
+
+	return nil
+}
+
+// SetCollectionShareToken - публикуем коллекцию по токену; пустой токен отзывает ссылку
+func (storage *PGStorage) SetCollectionShareToken(ctx context.Context, userID, collectionID uint64, shareToken string) (*models.Collection, error) {
+	id, err := resolveCollection(ctx, storage.DB, userID, collectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = storage.DB.Exec(ctx, `UPDATE collections SET share_token = NULLIF($1, '') WHERE id = $2`, shareToken, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "query execution error")
+	}
+
+	return storage.getCollection(ctx, squirrel.Eq{"c.id": id})
+}
@@ -0,0 +1,42 @@
+package pgstorage
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"gonews/save_service/internal/models"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// cursor - позиция последней записи страницы для keyset-пагинации.
+// Клиенту отдаётся в виде непрозрачной base64-строки.
+type cursor struct {
+	ID       uint64     `json:"id"`
+	Time     *time.Time `json:"t,omitempty"`
+	Position *int64     `json:"p,omitempty"`
+}
+
+func encodeCursor(c cursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCursor - пустой токен означает первую страницу
+func decodeCursor(token string) (*cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.Wrap(models.ErrInvalidPageToken, err.Error())
+	}
+
+	var c cursor
+	if err := json.Unmarshal(data, &c); err != nil || c.ID == 0 {
+		return nil, models.ErrInvalidPageToken
+	}
+
+	return &c, nil
+}
@@ -0,0 +1,160 @@
+package pgstorage
+
+import (
+	"context"
+	"gonews/save_service/internal/models"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+)
+
+// exportPageSize - размер страницы при обходе данных пользователя для выгрузки
+const exportPageSize = 500
+
+// collectPages - проходит все страницы постраничного метода хранилища
+func collectPages[T any](fetch func(page models.PageRequest) ([]T, string, error)) ([]T, error) {
+	result := []T{}
+	page := models.PageRequest{Size: exportPageSize}
+	for {
+		items, nextToken, err := fetch(page)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, items...)
+		if nextToken == "" {
+			return result, nil
+		}
+		page.Token = nextToken
+	}
+}
+
+// ExportUserData - собираем все данные пользователя, ErrNotFound если его нет
+func (storage *PGStorage) ExportUserData(ctx context.Context, userID uint64) (*models.UserExport, error) {
+	export := &models.UserExport{ExportedAt: time.Now().UTC()}
+
+	profile, err := storage.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	export.Profile = profile
+
+	collections, err := collectPages(func(page models.PageRequest) ([]*models.Collection, string, error) {
+		return storage.ListCollections(ctx, userID, page)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to export collections")
+	}
+	for _, collection := range collections {
+		items, err := collectPages(func(page models.PageRequest) ([]*models.News, string, error) {
+			return storage.collectionItems(ctx, collection.ID, page, 0)
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to export collection items")
+		}
+		export.Collections = append(export.Collections, &models.CollectionExport{Collection: collection, Items: items})
+	}
+
+	export.Annotations, err = storage.exportAnnotations(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to export annotations")
+	}
+
+	history, err := collectPages(func(page models.PageRequest) ([]*models.SearchHistoryEntry, string, error) {
+		return storage.GetSearchHistory(ctx, userID, page, false)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to export search history")
+	}
+	resultIDs := lo.Uniq(lo.FlatMap(history, func(entry *models.SearchHistoryEntry, _ int) []uint64 {
+		return entry.ResultIDs
+	}))
+	resultNews, err := storage.GetNewsByIDs(ctx, resultIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to export search results")
+	}
+	newsByID := lo.KeyBy(resultNews, func(n *models.News) uint64 { return n.ID })
+	for _, entry := range history {
+		results := []*models.News{}
+		for _, id := range entry.ResultIDs {
+			if n, ok := newsByID[id]; ok {
+				results = append(results, n)
+			}
+		}
+		export.SearchHistory = append(export.SearchHistory, &models.SearchHistoryExport{Entry: entry, Results: results})
+	}
+
+	export.SavedSearches, err = collectPages(func(page models.PageRequest) ([]*models.SavedSearch, string, error) {
+		return storage.ListSavedSearches(ctx, userID, page, false)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to export saved searches")
+	}
+
+	export.Subscriptions, err = collectPages(func(page models.PageRequest) ([]*models.Subscription, string, error) {
+		return storage.GetSubscriptions(ctx, userID, page)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to export subscriptions")
+	}
+
+	export.SeenNews, err = collectPages(func(page models.PageRequest) ([]*models.UserToSeenNews, string, error) {
+		return storage.GetSeenNews(ctx, userID, nil, page)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to export seen news")
+	}
+
+	export.Notifications, err = storage.getNotifications(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to export notifications")
+	}
+
+	return export, nil
+}
+
+// exportAnnotations - пометки пользователя ко всем статьям, где есть теги, заметка или выделения
+func (storage *PGStorage) exportAnnotations(ctx context.Context, userID uint64) ([]*models.FavouriteAnnotation, error) {
+	rows, err := storage.DB.Query(ctx, `
+		SELECT news_id FROM favourite_tags WHERE user_id = $1
+		UNION SELECT news_id FROM favourite_notes WHERE user_id = $1
+		UNION SELECT news_id FROM favourite_highlights WHERE user_id = $1
+		ORDER BY news_id`, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "query error")
+	}
+	newsIDs, err := pgx.CollectRows(rows, pgx.RowTo[uint64])
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to scan row")
+	}
+
+	annotations, err := storage.annotations(ctx, userID, newsIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(newsIDs, func(id uint64, _ int) *models.FavouriteAnnotation { return annotations[id] }), nil
+}
+
+// DeleteUser - удаляем пользователя; остальные его данные удаляются каскадно
+func (storage *PGStorage) DeleteUser(ctx context.Context, userID uint64) error {
+	queryText, args, err := squirrel.Delete("users").
+		Where(squirrel.Eq{"id": userID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "query generation error")
+	}
+
+	tag, err := storage.DB.Exec(ctx, queryText, args...)
+	if err != nil {
+		return errors.Wrap(err, "query execution error")
+	}
+	if tag.RowsAffected() == 0 {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
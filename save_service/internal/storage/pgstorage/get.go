@@ -9,7 +9,7 @@ import (
 )
 
 func (storage *PGStorage) GetNewsByIDs(ctx context.Context, IDs []uint64) ([]*models.News, error) {
-	query := squirrel.Select("id", "source", "author", "title", "description", "url", "image_url", "published_at").
+	query := squirrel.Select("id", "source", "author", "title", "description", "url", "image_url", "published_at", "content").
 		From("News").
 		Where(squirrel.Eq{"id": IDs}).
 		PlaceholderFormat(squirrel.Dollar)
@@ -26,7 +26,7 @@ func (storage *PGStorage) GetNewsByIDs(ctx context.Context, IDs []uint64) ([]*mo
 	var news []*models.News
 	for rows.Next() {
 		var n models.News
-		err := rows.Scan(&n.ID, &n.Source, &n.Author, &n.Title, &n.Description, &n.URL, &n.ImageURL, &n.PublishedAt)
+		err := rows.Scan(&n.ID, &n.Source, &n.Author, &n.Title, &n.Description, &n.URL, &n.ImageURL, &n.PublishedAt, &n.Content)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to scan row")
 		}
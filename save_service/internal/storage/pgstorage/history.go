@@ -3,18 +3,31 @@ package pgstorage
 import (
 	"context"
 	"encoding/json"
+	"gonews/save_service/internal/models"
 	"time"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
 	"github.com/pkg/errors"
 )
 
 // AddToSearchHistory - добавляем запись в историю поиска
-func (storage *PGStorage) AddToSearchHistory(ctx context.Context, userID uint64, query string, results []uint64) error {
+func (storage *PGStorage) AddToSearchHistory(ctx context.Context, entry *models.SearchHistoryEntry) error {
+	filtersJSON, err := json.Marshal(entry.Filters)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal filters")
+	}
+
+	tx, err := storage.DB.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, "transaction begin error")
+	}
+	defer tx.Rollback(ctx)
+
 	// Сохраняем запрос
 	searchQuery := squirrel.Insert("search_history").
-		Columns("user_id", "query", "searched_at").
-		Values(userID, query, time.Now()).
+		Columns("user_id", "query", "filters", "total_results", "searched_at").
+		Values(entry.UserID, entry.Query, filtersJSON, entry.TotalResults, time.Now()).
 		Suffix("RETURNING id").
 		PlaceholderFormat(squirrel.Dollar)
 
@@ -24,14 +37,17 @@ func (storage *PGStorage) AddToSearchHistory(ctx context.Context, userID uint64,
 	}
 
 	var searchID uint64
-	err = storage.DB.QueryRow(ctx, queryText, args...).Scan(&searchID)
+	err = tx.QueryRow(ctx, queryText, args...).Scan(&searchID)
+	if isForeignKeyViolation(err) {
+		return models.ErrNotFound
+	}
 	if err != nil {
 		return errors.Wrap(err, "query execution error")
 	}
 
 	// Сохраняем результаты поиска
-	if len(results) > 0 {
-		resultsJSON, err := json.Marshal(results)
+	if len(entry.ResultIDs) > 0 {
+		resultsJSON, err := json.Marshal(entry.ResultIDs)
 		if err != nil {
 			return errors.Wrap(err, "failed to marshal results")
 		}
@@ -46,65 +62,124 @@ func (storage *PGStorage) AddToSearchHistory(ctx context.Context, userID uint64,
 			return errors.Wrap(err, "results query generation error")
 		}
 
-		_, err = storage.DB.Exec(ctx, resultsQueryText, resultsArgs...)
+		_, err = tx.Exec(ctx, resultsQueryText, resultsArgs...)
 		if err != nil {
 			return errors.Wrap(err, "results query execution error")
 		}
 	}
 
+	if err := tx.Commit(ctx); err != nil {
+		return errors.Wrap(err, "transaction commit error")
+	}
+
+	return nil
+}
+
+// historyEntryQuery - записи истории вместе с сохранёнными результатами.
+// Для uniqueQueries повторы одного поиска (запрос + фильтры) схлопываются в самую свежую запись.
+func historyEntryQuery(userID uint64, uniqueQueries bool) squirrel.SelectBuilder {
+	query := squirrel.Select("h.id", "h.query", "h.searched_at", "h.filters", "h.total_results",
+		"COALESCE(r.news_ids, '[]'::jsonb) AS news_ids").
+		From("search_history h").
+		LeftJoin("search_results r ON r.search_id = h.id").
+		Where(squirrel.Eq{"h.user_id": userID})
+
+	if uniqueQueries {
+		return query.
+			Options("DISTINCT ON (h.query, h.filters)").
+			Column("COUNT(*) OVER (PARTITION BY h.query, h.filters) AS occurrences").
+			OrderBy("h.query", "h.filters", "h.searched_at DESC", "h.id DESC")
+	}
+
+	return query.Column("1 AS occurrences")
+}
+
+func scanHistoryEntry(row pgx.Row, entry *models.SearchHistoryEntry) error {
+	var filtersJSON, resultsJSON []byte
+	err := row.Scan(&entry.ID, &entry.Query, &entry.SearchedAt, &filtersJSON, &entry.TotalResults,
+		&resultsJSON, &entry.Occurrences)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(filtersJSON, &entry.Filters); err != nil {
+		return errors.Wrap(err, "failed to unmarshal filters")
+	}
+	if err := json.Unmarshal(resultsJSON, &entry.ResultIDs); err != nil {
+		return errors.Wrap(err, "failed to unmarshal results")
+	}
+
 	return nil
 }
 
-// GetSearchHistory - получаем историю поиска пользователя
-func (storage *PGStorage) GetSearchHistory(ctx context.Context, userID uint64) ([]string, error) {
-	query := squirrel.Select("query").
-		From("search_history").
-		Where(squirrel.Eq{"user_id": userID}).
-		OrderBy("searched_at DESC").
-		Limit(50).
+// GetSearchHistory - получаем страницу истории поиска пользователя (новые сначала)
+func (storage *PGStorage) GetSearchHistory(ctx context.Context, userID uint64, page models.PageRequest, uniqueQueries bool) ([]*models.SearchHistoryEntry, string, error) {
+	after, err := decodeCursor(page.Token)
+	if err != nil {
+		return nil, "", err
+	}
+	if after != nil && after.Time == nil {
+		return nil, "", models.ErrInvalidPageToken
+	}
+
+	query := squirrel.Select("id", "query", "searched_at", "filters", "total_results", "news_ids", "occurrences").
+		FromSelect(historyEntryQuery(userID, uniqueQueries), "h").
+		OrderBy("searched_at DESC", "id DESC").
+		Limit(uint64(page.Size) + 1).
 		PlaceholderFormat(squirrel.Dollar)
+	if after != nil {
+		query = query.Where(squirrel.Expr("(searched_at, id) < (?, ?)", *after.Time, after.ID))
+	}
 
 	queryText, args, err := query.ToSql()
 	if err != nil {
-		return nil, errors.Wrap(err, "query generation error")
+		return nil, "", errors.Wrap(err, "query generation error")
 	}
 
 	rows, err := storage.DB.Query(ctx, queryText, args...)
 	if err != nil {
-		return nil, errors.Wrap(err, "query error")
+		return nil, "", errors.Wrap(err, "query error")
 	}
 	defer rows.Close()
 
-	var queries []string
+	var entries []*models.SearchHistoryEntry
 	for rows.Next() {
-		var query string
-		err := rows.Scan(&query)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to scan row")
+		entry := &models.SearchHistoryEntry{UserID: userID}
+		if err := scanHistoryEntry(rows, entry); err != nil {
+			return nil, "", errors.Wrap(err, "failed to scan row")
 		}
-		queries = append(queries, query)
+		entries = append(entries, entry)
 	}
 
-	return queries, nil
+	var nextToken string
+	if len(entries) > page.Size {
+		entries = entries[:page.Size]
+		last := entries[page.Size-1]
+		nextToken = encodeCursor(cursor{ID: last.ID, Time: &last.SearchedAt})
+	}
+
+	return entries, nextToken, nil
 }
 
-// MarkNewsAsSeen - отмечаем новость как просмотренную
-func (storage *PGStorage) MarkNewsAsSeen(ctx context.Context, userID, newsID uint64) error {
-	query := squirrel.Insert("user_to_seen_news").
-		Columns("user_id", "news_id").
-		Values(userID, newsID).
-		Suffix("ON CONFLICT (user_id, news_id) DO UPDATE SET seen_at = CURRENT_TIMESTAMP").
+// GetSearchHistoryEntry - получаем одну запись истории пользователя
+func (storage *PGStorage) GetSearchHistoryEntry(ctx context.Context, userID, searchID uint64) (*models.SearchHistoryEntry, error) {
+	query := historyEntryQuery(userID, false).
+		Where(squirrel.Eq{"h.id": searchID}).
 		PlaceholderFormat(squirrel.Dollar)
 
 	queryText, args, err := query.ToSql()
 	if err != nil {
-		return errors.Wrap(err, "query generation error")
+		return nil, errors.Wrap(err, "query generation error")
 	}
 
-	_, err = storage.DB.Exec(ctx, queryText, args...)
+	entry := &models.SearchHistoryEntry{UserID: userID}
+	err = scanHistoryEntry(storage.DB.QueryRow(ctx, queryText, args...), entry)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, models.ErrNotFound
+	}
 	if err != nil {
-		return errors.Wrap(err, "query execution error")
+		return nil, errors.Wrap(err, "query execution error")
 	}
 
-	return nil
+	return entry, nil
 }
@@ -0,0 +1,107 @@
+package pgstorage
+
+import (
+	"context"
+	"errors"
+	"gonews/pkg/metrics"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var queryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: metrics.Namespace,
+	Subsystem: "db",
+	Name:      "query_duration_seconds",
+	Help:      "Postgres query latency by SQL operation and outcome.",
+	Buckets:   []float64{0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5},
+}, []string{"operation", "outcome"})
+
+// sqlOperations - операции, которые попадают в метку как есть, остальные - other
+var sqlOperations = map[string]bool{
+	"select": true, "insert": true, "update": true, "delete": true, "with": true, "create": true,
+}
+
+type queryStartKey struct{}
+
+type queryStart struct {
+	at        time.Time
+	operation string
+}
+
+// queryTracer - время каждого запроса пула; подключается в NewPgstorage
+type queryTracer struct{}
+
+func (queryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	return context.WithValue(ctx, queryStartKey{}, queryStart{at: time.Now(), operation: sqlOperation(data.SQL)})
+}
+
+func (queryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	start, ok := ctx.Value(queryStartKey{}).(queryStart)
+	if !ok {
+		return
+	}
+
+	// Пустой результат QueryRow ошибкой базы не считаем
+	err := data.Err
+	if errors.Is(err, pgx.ErrNoRows) {
+		err = nil
+	}
+	queryDuration.WithLabelValues(start.operation, metrics.Outcome(err)).Observe(time.Since(start.at).Seconds())
+}
+
+// sqlOperation - первое слово запроса в нижнем регистре
+func sqlOperation(sql string) string {
+	operation, _, _ := strings.Cut(strings.TrimSpace(sql), " ")
+	operation = strings.ToLower(strings.TrimSpace(operation))
+	if !sqlOperations[operation] {
+		return "other"
+	}
+	return operation
+}
+
+// poolCollector - состояние пула соединений на момент сбора метрик
+type poolCollector struct {
+	pool *pgxpool.Pool
+
+	connections    *prometheus.Desc
+	maxConnections *prometheus.Desc
+	acquireWait    *prometheus.Desc
+	emptyAcquires  *prometheus.Desc
+}
+
+func newPoolCollector(pool *pgxpool.Pool) *poolCollector {
+	name := func(n string) string { return prometheus.BuildFQName(metrics.Namespace, "db", n) }
+	return &poolCollector{
+		pool: pool,
+		connections: prometheus.NewDesc(name("pool_connections"),
+			"Pool connections by state (acquired, idle, constructing).", []string{"state"}, nil),
+		maxConnections: prometheus.NewDesc(name("pool_max_connections"),
+			"Maximum size of the pool.", nil, nil),
+		acquireWait: prometheus.NewDesc(name("pool_acquire_wait_seconds_total"),
+			"Total time spent waiting for a connection.", nil, nil),
+		emptyAcquires: prometheus.NewDesc(name("pool_empty_acquires_total"),
+			"Acquires that had to wait because the pool had no idle connection.", nil, nil),
+	}
+}
+
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.connections
+	ch <- c.maxConnections
+	ch <- c.acquireWait
+	ch <- c.emptyAcquires
+}
+
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+	ch <- prometheus.MustNewConstMetric(c.connections, prometheus.GaugeValue, float64(stat.AcquiredConns()), "acquired")
+	ch <- prometheus.MustNewConstMetric(c.connections, prometheus.GaugeValue, float64(stat.IdleConns()), "idle")
+	ch <- prometheus.MustNewConstMetric(c.connections, prometheus.GaugeValue, float64(stat.ConstructingConns()), "constructing")
+	ch <- prometheus.MustNewConstMetric(c.maxConnections, prometheus.GaugeValue, float64(stat.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(c.acquireWait, prometheus.CounterValue, stat.AcquireDuration().Seconds())
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquires, prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+}
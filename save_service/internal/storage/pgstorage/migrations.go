@@ -0,0 +1,110 @@
+package pgstorage
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/pkg/errors"
+)
+
+// migration - одноразовое изменение данных; в отличие от DDL в InitTables применяется ровно один раз,
+// версия и время применения остаются в schema_migrations
+type migration struct {
+	version int
+	name    string
+	sql     string
+}
+
+// migrations - только дописываются в конец; применённую миграцию не меняем, исправление - новой версией
+var migrations = []migration{
+	{
+		version: 1,
+		name:    "move_favourites_to_collections",
+		// Избранное переезжает в коллекции по умолчанию (новые сначала). UserToFavouriteNews после
+		// этого не используется; таблица удаляется отдельной миграцией, когда старых реплик не останется.
+		sql: `
+			INSERT INTO collections (user_id, name, is_default)
+				SELECT DISTINCT user_id, 'Favourites', TRUE FROM UserToFavouriteNews
+				ON CONFLICT (user_id) WHERE is_default DO NOTHING;
+
+			INSERT INTO collection_items (collection_id, news_id, position)
+				SELECT c.id, f.news_id, -f.id
+				FROM UserToFavouriteNews f
+				JOIN collections c ON c.user_id = f.user_id AND c.is_default
+				ON CONFLICT (collection_id, news_id) DO NOTHING;
+
+			DELETE FROM UserToFavouriteNews;
+
+			DROP INDEX IF EXISTS idx_user_favourite_news_user_id;
+		`,
+	},
+	{
+		version: 2,
+		name:    "backfill_user_handles",
+		// Пользователям, созданным до профилей, handle строится из имени; суффикс с id исключает совпадения
+		sql: `
+			UPDATE Users
+				SET handle = lower(regexp_replace(name, '[^a-zA-Z0-9_]+', '_', 'g')) || '_' || id
+				WHERE handle IS NULL;
+		`,
+	},
+}
+
+// migrationsLock - ключ advisory lock: реплики, стартующие одновременно, применяют миграции по очереди
+const migrationsLock = 7340120
+
+// applyMigrations - применяет ещё не применённые миграции, каждую в своей транзакции
+func (storage *PGStorage) applyMigrations(ctx context.Context) error {
+	_, err := storage.DB.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version     INT           PRIMARY KEY,
+			name        VARCHAR(255)  NOT NULL,
+			applied_at  TIMESTAMP     DEFAULT CURRENT_TIMESTAMP
+		)`)
+	if err != nil {
+		return errors.Wrap(err, "failed to create schema_migrations")
+	}
+
+	for _, m := range migrations {
+		if err := storage.applyMigration(ctx, m); err != nil {
+			return errors.Wrapf(err, "migration %d %s", m.version, m.name)
+		}
+	}
+
+	return nil
+}
+
+func (storage *PGStorage) applyMigration(ctx context.Context, m migration) error {
+	tx, err := storage.DB.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock($1)", migrationsLock); err != nil {
+		return errors.Wrap(err, "failed to lock migrations")
+	}
+
+	var applied bool
+	err = tx.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM schema_migrations WHERE version = $1)", m.version).Scan(&applied)
+	if err != nil {
+		return errors.Wrap(err, "failed to check migration")
+	}
+	if applied {
+		return nil
+	}
+
+	if _, err := tx.Exec(ctx, m.sql); err != nil {
+		return errors.Wrap(err, "query execution error")
+	}
+	if _, err := tx.Exec(ctx, "INSERT INTO schema_migrations (version, name) VALUES ($1, $2)", m.version, m.name); err != nil {
+		return errors.Wrap(err, "failed to record migration")
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.Wrap(err, "failed to commit migration")
+	}
+
+	slog.InfoContext(ctx, "migration applied", "version", m.version, "name", m.name)
+	return nil
+}
@@ -16,6 +16,7 @@ type News struct {
 	URL         string    `db:"url"`
 	ImageURL    string    `db:"image_url"`
 	PublishedAt time.Time `db:"published_at"`
+	Content     string    `db:"content"`
 }
 
 type UserToFavouriteNews struct {
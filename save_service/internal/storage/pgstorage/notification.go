@@ -0,0 +1,64 @@
+package pgstorage
+
+import (
+	"context"
+	"encoding/json"
+	"gonews/save_service/internal/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+)
+
+// RecordNotification - сохраняем отправленное уведомление вместе с копией статьи
+func (storage *PGStorage) RecordNotification(ctx context.Context, notification *models.Notification) error {
+	articleJSON, err := json.Marshal(notification.Article)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal article")
+	}
+
+	queryText, args, err := squirrel.Insert("notifications").
+		Columns("user_id", "keyword", "article").
+		Values(notification.UserID, notification.Keyword, articleJSON).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "query generation error")
+	}
+
+	_, err = storage.DB.Exec(ctx, queryText, args...)
+	if isForeignKeyViolation(err) {
+		return models.ErrNotFound
+	}
+	if err != nil {
+		return errors.Wrap(err, "query execution error")
+	}
+
+	return nil
+}
+
+// getNotifications - все уведомления пользователя, последние сначала
+func (storage *PGStorage) getNotifications(ctx context.Context, userID uint64) ([]*models.Notification, error) {
+	rows, err := storage.query(ctx, squirrel.Select("id", "user_id", "keyword", "article", "sent_at").
+		From("notifications").
+		Where(squirrel.Eq{"user_id": userID}).
+		OrderBy("sent_at DESC", "id DESC"))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	notifications := []*models.Notification{}
+	for rows.Next() {
+		var n models.Notification
+		var articleJSON []byte
+		if err := rows.Scan(&n.ID, &n.UserID, &n.Keyword, &articleJSON, &n.SentAt); err != nil {
+			return nil, errors.Wrap(err, "failed to scan row")
+		}
+		if err := json.Unmarshal(articleJSON, &n.Article); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal article")
+		}
+		notifications = append(notifications, &n)
+	}
+
+	return notifications, nil
+}
@@ -2,16 +2,21 @@ package pgstorage
 
 import (
 	"context"
+	"gonews/pkg/logging"
+	"log/slog"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 type PGStorage struct {
 	DB *pgxpool.Pool
 }
 
-func (storage *PGStorage) InitTables() error {
+// InitTables - создаёт и дополняет схему, затем применяет новые миграции данных (migrations.go);
+// каждый шаг идемпотентен, повторный запуск безопасен
+func (storage *PGStorage) InitTables(ctx context.Context) error {
 	sql := `
 		CREATE TABLE IF NOT EXISTS Users(
 			id    SERIAL        PRIMARY KEY,
@@ -105,13 +110,219 @@ func (storage *PGStorage) InitTables() error {
 				REFERENCES search_history(id) 
 				ON DELETE CASCADE
 		);
+
+		CREATE TABLE IF NOT EXISTS saved_searches (
+			id                        SERIAL        PRIMARY KEY,
+			user_id                   BIGINT        NOT NULL,
+			name                      VARCHAR(255)  NOT NULL,
+			query                     TEXT          NOT NULL,
+			filters                   JSONB         NOT NULL DEFAULT '{}'::jsonb,
+			refresh_interval_minutes  INT           NOT NULL DEFAULT 0,
+			notify                    BOOLEAN       NOT NULL DEFAULT FALSE,
+			last_run_at               TIMESTAMP,
+			last_result_ids           JSONB         NOT NULL DEFAULT '[]'::jsonb,
+			new_result_ids            JSONB         NOT NULL DEFAULT '[]'::jsonb,
+			created_at                TIMESTAMP     DEFAULT CURRENT_TIMESTAMP,
+
+			CONSTRAINT fk_saved_searches_user
+				FOREIGN KEY (user_id)
+				REFERENCES Users(id)
+				ON DELETE CASCADE,
+
+			CONSTRAINT unique_saved_searches_user_name
+				UNIQUE (user_id, name)
+		);
+
+		CREATE TABLE IF NOT EXISTS collections (
+			id           SERIAL        PRIMARY KEY,
+			user_id      BIGINT        NOT NULL,
+			name         VARCHAR(255)  NOT NULL,
+			is_default   BOOLEAN       NOT NULL DEFAULT FALSE,
+			share_token  VARCHAR(64)   UNIQUE,
+			created_at   TIMESTAMP     DEFAULT CURRENT_TIMESTAMP,
+
+			CONSTRAINT fk_collections_user
+				FOREIGN KEY (user_id)
+				REFERENCES Users(id)
+				ON DELETE CASCADE
+		);
+
+		CREATE UNIQUE INDEX IF NOT EXISTS idx_collections_user_default
+			ON collections (user_id) WHERE is_default;
+
+		CREATE UNIQUE INDEX IF NOT EXISTS idx_collections_user_name
+			ON collections (user_id, name) WHERE NOT is_default;
+
+		CREATE TABLE IF NOT EXISTS collection_items (
+			id             SERIAL     PRIMARY KEY,
+			collection_id  BIGINT     NOT NULL,
+			news_id        BIGINT     NOT NULL,
+			position       BIGINT     NOT NULL,
+			added_at       TIMESTAMP  DEFAULT CURRENT_TIMESTAMP,
+
+			CONSTRAINT fk_collection_items_collection
+				FOREIGN KEY (collection_id)
+				REFERENCES collections(id)
+				ON DELETE CASCADE,
+
+			CONSTRAINT fk_collection_items_news
+				FOREIGN KEY (news_id)
+				REFERENCES News(id)
+				ON DELETE CASCADE,
+
+			CONSTRAINT unique_collection_items_collection_news
+				UNIQUE (collection_id, news_id)
+		);
+
+		CREATE INDEX IF NOT EXISTS idx_collection_items_collection_position
+			ON collection_items (collection_id, position, id);
+
+		ALTER TABLE News
+			ADD COLUMN IF NOT EXISTS content  TEXT  NOT NULL DEFAULT '';
+
+		CREATE TABLE IF NOT EXISTS favourite_tags (
+			user_id  BIGINT       NOT NULL,
+			news_id  BIGINT       NOT NULL,
+			tag      VARCHAR(64)  NOT NULL,
+
+			PRIMARY KEY (user_id, news_id, tag),
+
+			CONSTRAINT fk_favourite_tags_user
+				FOREIGN KEY (user_id)
+				REFERENCES Users(id)
+				ON DELETE CASCADE,
+
+			CONSTRAINT fk_favourite_tags_news
+				FOREIGN KEY (news_id)
+				REFERENCES News(id)
+				ON DELETE CASCADE
+		);
+
+		CREATE INDEX IF NOT EXISTS idx_favourite_tags_user_tag
+			ON favourite_tags (user_id, tag);
+
+		CREATE TABLE IF NOT EXISTS favourite_notes (
+			user_id     BIGINT     NOT NULL,
+			news_id     BIGINT     NOT NULL,
+			note        TEXT       NOT NULL,
+			updated_at  TIMESTAMP  DEFAULT CURRENT_TIMESTAMP,
+
+			PRIMARY KEY (user_id, news_id),
+
+			CONSTRAINT fk_favourite_notes_user
+				FOREIGN KEY (user_id)
+				REFERENCES Users(id)
+				ON DELETE CASCADE,
+
+			CONSTRAINT fk_favourite_notes_news
+				FOREIGN KEY (news_id)
+				REFERENCES News(id)
+				ON DELETE CASCADE
+		);
+
+		CREATE INDEX IF NOT EXISTS idx_favourite_notes_note
+			ON favourite_notes USING GIN (to_tsvector('simple', note));
+
+		CREATE TABLE IF NOT EXISTS favourite_highlights (
+			id            SERIAL     PRIMARY KEY,
+			user_id       BIGINT     NOT NULL,
+			news_id       BIGINT     NOT NULL,
+			start_offset  INT        NOT NULL,
+			end_offset    INT        NOT NULL,
+			text          TEXT       NOT NULL,
+			comment       TEXT       NOT NULL DEFAULT '',
+			created_at    TIMESTAMP  DEFAULT CURRENT_TIMESTAMP,
+
+			CONSTRAINT fk_favourite_highlights_user
+				FOREIGN KEY (user_id)
+				REFERENCES Users(id)
+				ON DELETE CASCADE,
+
+			CONSTRAINT fk_favourite_highlights_news
+				FOREIGN KEY (news_id)
+				REFERENCES News(id)
+				ON DELETE CASCADE,
+
+			CONSTRAINT check_favourite_highlights_offsets
+				CHECK (start_offset >= 0 AND end_offset > start_offset)
+		);
+
+		CREATE INDEX IF NOT EXISTS idx_favourite_highlights_user_news
+			ON favourite_highlights (user_id, news_id, start_offset);
+
+		CREATE INDEX IF NOT EXISTS idx_user_seen_news_user_seen_at
+			ON UserToSeenNews (user_id, seen_at DESC, id DESC);
+
+		ALTER TABLE search_history
+			ADD COLUMN IF NOT EXISTS filters        JSONB  NOT NULL DEFAULT '{}'::jsonb,
+			ADD COLUMN IF NOT EXISTS total_results  INT    NOT NULL DEFAULT 0;
+
+		CREATE INDEX IF NOT EXISTS idx_search_results_search_id
+			ON search_results (search_id);
+
+		CREATE INDEX IF NOT EXISTS idx_search_history_user_searched_at
+			ON search_history (user_id, searched_at DESC, id DESC);
+
+		CREATE TABLE IF NOT EXISTS notifications (
+			id       SERIAL     PRIMARY KEY,
+			user_id  BIGINT     NOT NULL,
+			keyword  TEXT       NOT NULL,
+			article  JSONB      NOT NULL,
+			sent_at  TIMESTAMP  DEFAULT CURRENT_TIMESTAMP,
+
+			CONSTRAINT fk_notifications_user
+				FOREIGN KEY (user_id)
+				REFERENCES Users(id)
+				ON DELETE CASCADE
+		);
+
+		CREATE INDEX IF NOT EXISTS idx_notifications_user_sent_at
+			ON notifications (user_id, sent_at DESC, id DESC);
+
+		ALTER TABLE Users
+			ADD COLUMN IF NOT EXISTS handle           TEXT,
+			ADD COLUMN IF NOT EXISTS email            TEXT,
+			ADD COLUMN IF NOT EXISTS language         TEXT       NOT NULL DEFAULT '',
+			ADD COLUMN IF NOT EXISTS country          TEXT       NOT NULL DEFAULT '',
+			ADD COLUMN IF NOT EXISTS timezone         TEXT       NOT NULL DEFAULT '',
+			ADD COLUMN IF NOT EXISTS default_filters  JSONB      NOT NULL DEFAULT '{}'::jsonb,
+			ADD COLUMN IF NOT EXISTS created_at       TIMESTAMP  DEFAULT CURRENT_TIMESTAMP,
+			ADD COLUMN IF NOT EXISTS updated_at       TIMESTAMP  DEFAULT CURRENT_TIMESTAMP;
+
+		CREATE UNIQUE INDEX IF NOT EXISTS idx_users_handle
+			ON Users (lower(handle));
+
+		CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email
+			ON Users (lower(email)) WHERE email IS NOT NULL;
+
+		CREATE TABLE IF NOT EXISTS api_keys (
+			id            SERIAL        PRIMARY KEY,
+			name          VARCHAR(255)  NOT NULL,
+			key_prefix    VARCHAR(16)   NOT NULL,
+			key_hash      CHAR(64)      NOT NULL UNIQUE,
+			scopes        TEXT[]        NOT NULL,
+			created_at    TIMESTAMP     DEFAULT CURRENT_TIMESTAMP,
+			rotated_at    TIMESTAMP,
+			last_used_at  TIMESTAMP,
+			revoked_at    TIMESTAMP
+		);
+
+		CREATE UNIQUE INDEX IF NOT EXISTS idx_api_keys_active_name
+			ON api_keys (name) WHERE revoked_at IS NULL;
+
+		-- Ключ пользователя удаляется вместе с аккаунтом
+		ALTER TABLE api_keys
+			ADD COLUMN IF NOT EXISTS user_id INT REFERENCES Users(id) ON DELETE CASCADE;
+
+		ALTER TABLE user_subscriptions
+			ADD COLUMN IF NOT EXISTS schedule TEXT NOT NULL DEFAULT '';
 	`
-	_, err := storage.DB.Exec(context.Background(), sql)
+	_, err := storage.DB.Exec(ctx, sql)
 	if err != nil {
 		return errors.Wrap(err, "table initialization error")
 	}
 
-	return nil
+	return storage.applyMigrations(ctx)
 }
 
 func NewPgstorage(connectionString string) (*PGStorage, error) {
@@ -120,16 +331,26 @@ func NewPgstorage(connectionString string) (*PGStorage, error) {
 		return nil, errors.Wrap(err, "config parsing error")
 	}
 
+	config.ConnConfig.Tracer = queryTracer{}
+
 	db, err := pgxpool.NewWithConfig(context.Background(), config)
 	if err != nil {
 		return nil, errors.Wrap(err, "connection error")
 	}
+	if err := prometheus.Register(newPoolCollector(db)); err != nil {
+		slog.Error("failed to register pool metrics", logging.Err(err))
+	}
 
 	storage := &PGStorage{DB: db}
-	err = storage.InitTables()
+	err = storage.InitTables(context.Background())
 	if err != nil {
 		return nil, err
 	}
 
 	return storage, nil
 }
+
+// Ping - проверка соединения с базой для health check
+func (storage *PGStorage) Ping(ctx context.Context) error {
+	return storage.DB.Ping(ctx)
+}
@@ -0,0 +1,227 @@
+package pgstorage
+
+import (
+	"context"
+	"encoding/json"
+	"gonews/save_service/internal/models"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+var savedSearchColumns = []string{
+	"id", "user_id", "name", "query", "filters", "refresh_interval_minutes", "notify",
+	"last_run_at", "last_result_ids", "new_result_ids", "created_at",
+}
+
+func joinColumns(columns []string) string {
+	return strings.Join(columns, ", ")
+}
+
+func scanSavedSearch(row pgx.Row) (*models.SavedSearch, error) {
+	var s models.SavedSearch
+	var filtersJSON, lastResultsJSON, newResultsJSON []byte
+	err := row.Scan(&s.ID, &s.UserID, &s.Name, &s.Query, &filtersJSON, &s.RefreshIntervalMinutes, &s.Notify,
+		&s.LastRunAt, &lastResultsJSON, &newResultsJSON, &s.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(filtersJSON, &s.Filters); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal filters")
+	}
+	if err := json.Unmarshal(lastResultsJSON, &s.LastResultIDs); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal last results")
+	}
+	if err := json.Unmarshal(newResultsJSON, &s.NewResultIDs); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal new results")
+	}
+
+	return &s, nil
+}
+
+// querySavedSearch - выполняет запрос, возвращающий одну строку saved_searches
+func (storage *PGStorage) querySavedSearch(ctx context.Context, query squirrel.Sqlizer) (*models.SavedSearch, error) {
+	queryText, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "query generation error")
+	}
+
+	savedSearch, err := scanSavedSearch(storage.DB.QueryRow(ctx, queryText, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, models.ErrNotFound
+	}
+	if isUniqueViolation(err) {
+		return nil, models.ErrAlreadyExists
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "query execution error")
+	}
+
+	return savedSearch, nil
+}
+
+// CreateSavedSearch - сохраняем поиск; имя уникально в пределах пользователя
+func (storage *PGStorage) CreateSavedSearch(ctx context.Context, savedSearch *models.SavedSearch) (*models.SavedSearch, error) {
+	filtersJSON, err := json.Marshal(savedSearch.Filters)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal filters")
+	}
+
+	query := squirrel.Insert("saved_searches").
+		Columns("user_id", "name", "query", "filters", "refresh_interval_minutes", "notify").
+		Values(savedSearch.UserID, savedSearch.Name, savedSearch.Query, filtersJSON,
+			savedSearch.RefreshIntervalMinutes, savedSearch.Notify).
+		Suffix("RETURNING " + joinColumns(savedSearchColumns)).
+		PlaceholderFormat(squirrel.Dollar)
+
+	return storage.querySavedSearch(ctx, query)
+}
+
+// GetSavedSearch - получаем сохранённый поиск пользователя
+func (storage *PGStorage) GetSavedSearch(ctx context.Context, userID, id uint64) (*models.SavedSearch, error) {
+	query := squirrel.Select(savedSearchColumns...).
+		From("saved_searches").
+		Where(squirrel.Eq{"id": id, "user_id": userID}).
+		PlaceholderFormat(squirrel.Dollar)
+
+	return storage.querySavedSearch(ctx, query)
+}
+
+// ListSavedSearches - страница сохранённых поисков (всех пользователей, если userID == 0).
+// dueOnly - только поиски с расписанием, которые пора обновить.
+func (storage *PGStorage) ListSavedSearches(ctx context.Context, userID uint64, page models.PageRequest, dueOnly bool) ([]*models.SavedSearch, string, error) {
+	after, err := decodeCursor(page.Token)
+	if err != nil {
+		return nil, "", err
+	}
+
+	query := squirrel.Select(savedSearchColumns...).
+		From("saved_searches").
+		OrderBy("id").
+		Limit(uint64(page.Size) + 1).
+		PlaceholderFormat(squirrel.Dollar)
+	if userID != 0 {
+		query = query.Where(squirrel.Eq{"user_id": userID})
+	}
+	if dueOnly {
+		query = query.Where("refresh_interval_minutes > 0").
+			Where("(last_run_at IS NULL OR last_run_at + make_interval(mins => refresh_interval_minutes) <= CURRENT_TIMESTAMP)")
+	}
+	if after != nil {
+		query = query.Where(squirrel.Gt{"id": after.ID})
+	}
+
+	queryText, args, err := query.ToSql()
+	if err != nil {
+		return nil, "", errors.Wrap(err, "query generation error")
+	}
+
+	rows, err := storage.DB.Query(ctx, queryText, args...)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "query error")
+	}
+	defer rows.Close()
+
+	var savedSearches []*models.SavedSearch
+	for rows.Next() {
+		savedSearch, err := scanSavedSearch(rows)
+		if err != nil {
+			return nil, "", errors.Wrap(err, "failed to scan row")
+		}
+		savedSearches = append(savedSearches, savedSearch)
+	}
+
+	var nextToken string
+	if len(savedSearches) > page.Size {
+		savedSearches = savedSearches[:page.Size]
+		nextToken = encodeCursor(cursor{ID: savedSearches[page.Size-1].ID})
+	}
+
+	return savedSearches, nextToken, nil
+}
+
+// UpdateSavedSearch - меняем только заданные поля
+func (storage *PGStorage) UpdateSavedSearch(ctx context.Context, userID, id uint64, update *models.SavedSearchUpdate) (*models.SavedSearch, error) {
+	values := map[string]interface{}{}
+	if update.Name != nil {
+		values["name"] = *update.Name
+	}
+	if update.Query != nil {
+		values["query"] = *update.Query
+	}
+	if update.Filters != nil {
+		filtersJSON, err := json.Marshal(update.Filters)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal filters")
+		}
+		values["filters"] = filtersJSON
+	}
+	if update.RefreshIntervalMinutes != nil {
+		values["refresh_interval_minutes"] = *update.RefreshIntervalMinutes
+	}
+	if update.Notify != nil {
+		values["notify"] = *update.Notify
+	}
+	if len(values) == 0 {
+		return storage.GetSavedSearch(ctx, userID, id)
+	}
+
+	query := squirrel.Update("saved_searches").
+		SetMap(values).
+		Where(squirrel.Eq{"id": id, "user_id": userID}).
+		Suffix("RETURNING " + joinColumns(savedSearchColumns)).
+		PlaceholderFormat(squirrel.Dollar)
+
+	return storage.querySavedSearch(ctx, query)
+}
+
+// DeleteSavedSearch - удаляем сохранённый поиск пользователя
+func (storage *PGStorage) DeleteSavedSearch(ctx context.Context, userID, id uint64) error {
+	query := squirrel.Delete("saved_searches").
+		Where(squirrel.Eq{"id": id, "user_id": userID}).
+		PlaceholderFormat(squirrel.Dollar)
+
+	queryText, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "query generation error")
+	}
+
+	tag, err := storage.DB.Exec(ctx, queryText, args...)
+	if err != nil {
+		return errors.Wrap(err, "query execution error")
+	}
+	if tag.RowsAffected() == 0 {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
+
+// RecordSavedSearchRun - запоминаем результаты запуска; новыми считаются ID, которых не было в прошлом запуске
+func (storage *PGStorage) RecordSavedSearchRun(ctx context.Context, id uint64, resultIDs []uint64) (*models.SavedSearch, error) {
+	if resultIDs == nil {
+		resultIDs = []uint64{}
+	}
+	resultsJSON, err := json.Marshal(resultIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal results")
+	}
+
+	// В SET все выражения видят значения строки до обновления
+	query := squirrel.Update("saved_searches").
+		Set("new_result_ids", squirrel.Expr(`(
+			SELECT COALESCE(jsonb_agg(r.id), '[]'::jsonb)
+			FROM jsonb_array_elements(?::jsonb) AS r(id)
+			WHERE NOT last_result_ids @> jsonb_build_array(r.id)
+		)`, resultsJSON)).
+		Set("last_result_ids", resultsJSON).
+		Set("last_run_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{"id": id}).
+		Suffix("RETURNING " + joinColumns(savedSearchColumns)).
+		PlaceholderFormat(squirrel.Dollar)
+
+	return storage.querySavedSearch(ctx, query)
+}
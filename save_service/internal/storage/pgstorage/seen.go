@@ -0,0 +1,106 @@
+package pgstorage
+
+import (
+	"context"
+	"gonews/save_service/internal/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+)
+
+// Таблица создаётся как UserToSeenNews без кавычек, поэтому обращаемся к ней тем же именем
+
+// MarkNewsAsSeen - отмечаем новости как просмотренные
+func (storage *PGStorage) MarkNewsAsSeen(ctx context.Context, userID uint64, newsIDs []uint64) error {
+	query := squirrel.Insert("UserToSeenNews").
+		Columns("user_id", "news_id").
+		Suffix("ON CONFLICT (user_id, news_id) DO UPDATE SET seen_at = CURRENT_TIMESTAMP").
+		PlaceholderFormat(squirrel.Dollar)
+	for _, newsID := range newsIDs {
+		query = query.Values(userID, newsID)
+	}
+
+	queryText, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "query generation error")
+	}
+
+	_, err = storage.DB.Exec(ctx, queryText, args...)
+	if isForeignKeyViolation(err) {
+		return models.ErrNotFound
+	}
+	if err != nil {
+		return errors.Wrap(err, "query execution error")
+	}
+
+	return nil
+}
+
+// MarkNewsAsUnseen - снимаем отметку о просмотре
+func (storage *PGStorage) MarkNewsAsUnseen(ctx context.Context, userID uint64, newsIDs []uint64) error {
+	queryText, args, err := squirrel.Delete("UserToSeenNews").
+		Where(squirrel.Eq{"user_id": userID, "news_id": newsIDs}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "query generation error")
+	}
+
+	_, err = storage.DB.Exec(ctx, queryText, args...)
+	if err != nil {
+		return errors.Wrap(err, "query execution error")
+	}
+
+	return nil
+}
+
+// GetSeenNews - отметки о просмотре. Если newsIDs заданы - только среди них и без пагинации,
+// иначе - страница всех отметок пользователя (последние сначала).
+func (storage *PGStorage) GetSeenNews(ctx context.Context, userID uint64, newsIDs []uint64, page models.PageRequest) ([]*models.UserToSeenNews, string, error) {
+	query := squirrel.Select("id", "user_id", "news_id", "seen_at").
+		From("UserToSeenNews").
+		Where(squirrel.Eq{"user_id": userID}).
+		OrderBy("seen_at DESC", "id DESC").
+		PlaceholderFormat(squirrel.Dollar)
+
+	paged := len(newsIDs) == 0
+	if paged {
+		after, err := decodeCursor(page.Token)
+		if err != nil {
+			return nil, "", err
+		}
+		if after != nil {
+			if after.Time == nil {
+				return nil, "", models.ErrInvalidPageToken
+			}
+			query = query.Where("(seen_at, id) < (?, ?)", *after.Time, after.ID)
+		}
+		query = query.Limit(uint64(page.Size) + 1)
+	} else {
+		query = query.Where(squirrel.Eq{"news_id": newsIDs})
+	}
+
+	rows, err := storage.query(ctx, query)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var seen []*models.UserToSeenNews
+	for rows.Next() {
+		var s models.UserToSeenNews
+		if err := rows.Scan(&s.ID, &s.UserID, &s.NewsID, &s.SeenAt); err != nil {
+			return nil, "", errors.Wrap(err, "failed to scan row")
+		}
+		seen = append(seen, &s)
+	}
+
+	var nextToken string
+	if paged && len(seen) > page.Size {
+		seen = seen[:page.Size]
+		last := seen[page.Size-1]
+		nextToken = encodeCursor(cursor{ID: last.ID, Time: &last.SeenAt})
+	}
+
+	return seen, nextToken, nil
+}
@@ -5,14 +5,15 @@ import (
 	"gonews/save_service/internal/models"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
 	"github.com/pkg/errors"
 )
 
-// Subscribe - добавляем подписку пользователя
-func (storage *PGStorage) Subscribe(ctx context.Context, userID uint64, keyword string) error {
+// Subscribe - добавляем подписку пользователя; повтор подписки - ErrAlreadyExists
+func (storage *PGStorage) Subscribe(ctx context.Context, userID uint64, keyword, schedule string) error {
 	query := squirrel.Insert("user_subscriptions").
-		Columns("user_id", "keyword").
-		Values(userID, keyword).
+		Columns("user_id", "keyword", "schedule").
+		Values(userID, keyword, schedule).
 		PlaceholderFormat(squirrel.Dollar)
 
 	queryText, args, err := query.ToSql()
@@ -21,6 +22,12 @@ func (storage *PGStorage) Subscribe(ctx context.Context, userID uint64, keyword
 	}
 
 	_, err = storage.DB.Exec(ctx, queryText, args...)
+	if isForeignKeyViolation(err) {
+		return models.ErrNotFound
+	}
+	if isUniqueViolation(err) {
+		return models.ErrAlreadyExists
+	}
 	if err != nil {
 		return errors.Wrap(err, "query execution error")
 	}
@@ -28,32 +35,76 @@ func (storage *PGStorage) Subscribe(ctx context.Context, userID uint64, keyword
 	return nil
 }
 
-// GetSubscriptions - получаем все подписки
-func (storage *PGStorage) GetSubscriptions(ctx context.Context) ([]*models.Subscription, error) {
-	query := squirrel.Select("id", "user_id", "keyword").
+// GetSubscriptions - получаем страницу подписок (всех, если userID == 0)
+func (storage *PGStorage) GetSubscriptions(ctx context.Context, userID uint64, page models.PageRequest) ([]*models.Subscription, string, error) {
+	after, err := decodeCursor(page.Token)
+	if err != nil {
+		return nil, "", err
+	}
+
+	query := squirrel.Select("id", "user_id", "keyword", "schedule").
 		From("user_subscriptions").
+		OrderBy("id").
+		Limit(uint64(page.Size) + 1).
 		PlaceholderFormat(squirrel.Dollar)
+	if userID != 0 {
+		query = query.Where(squirrel.Eq{"user_id": userID})
+	}
+	if after != nil {
+		query = query.Where(squirrel.Gt{"id": after.ID})
+	}
 
 	queryText, args, err := query.ToSql()
 	if err != nil {
-		return nil, errors.Wrap(err, "query generation error")
+		return nil, "", errors.Wrap(err, "query generation error")
 	}
 
 	rows, err := storage.DB.Query(ctx, queryText, args...)
 	if err != nil {
-		return nil, errors.Wrap(err, "query error")
+		return nil, "", errors.Wrap(err, "query error")
 	}
 	defer rows.Close()
 
 	var subscriptions []*models.Subscription
 	for rows.Next() {
 		var s models.Subscription
-		err := rows.Scan(&s.ID, &s.UserID, &s.Keyword)
+		err := rows.Scan(&s.ID, &s.UserID, &s.Keyword, &s.Schedule)
 		if err != nil {
-			return nil, errors.Wrap(err, "failed to scan row")
+			return nil, "", errors.Wrap(err, "failed to scan row")
 		}
 		subscriptions = append(subscriptions, &s)
 	}
 
-	return subscriptions, nil
+	var nextToken string
+	if len(subscriptions) > page.Size {
+		subscriptions = subscriptions[:page.Size]
+		nextToken = encodeCursor(cursor{ID: subscriptions[page.Size-1].ID})
+	}
+
+	return subscriptions, nextToken, nil
+}
+
+// UpdateSubscription - меняем расписание подписки; чужая или несуществующая подписка - ErrNotFound
+func (storage *PGStorage) UpdateSubscription(ctx context.Context, userID, id uint64, schedule string) (*models.Subscription, error) {
+	query := squirrel.Update("user_subscriptions").
+		Set("schedule", schedule).
+		Where(squirrel.Eq{"id": id, "user_id": userID}).
+		Suffix("RETURNING id, user_id, keyword, schedule").
+		PlaceholderFormat(squirrel.Dollar)
+
+	queryText, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "query generation error")
+	}
+
+	var s models.Subscription
+	err = storage.DB.QueryRow(ctx, queryText, args...).Scan(&s.ID, &s.UserID, &s.Keyword, &s.Schedule)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, models.ErrNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "query execution error")
+	}
+
+	return &s, nil
 }
@@ -2,29 +2,180 @@ package pgstorage
 
 import (
 	"context"
+	"encoding/json"
+	"gonews/save_service/internal/models"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
 	"github.com/pkg/errors"
 )
 
-// CreateUser - создаем пользователя
-func (storage *PGStorage) CreateUser(ctx context.Context, name string) (uint64, error) {
+var userColumns = []string{
+	"id", "handle", "COALESCE(email, '')", "name", "language", "country", "timezone",
+	"default_filters", "created_at", "updated_at",
+}
+
+func scanUser(row pgx.Row) (*models.User, error) {
+	var u models.User
+	var filtersJSON []byte
+	err := row.Scan(&u.ID, &u.Handle, &u.Email, &u.DisplayName, &u.Language, &u.Country, &u.Timezone,
+		&filtersJSON, &u.CreatedAt, &u.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(filtersJSON, &u.DefaultFilters); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal default filters")
+	}
+
+	return &u, nil
+}
+
+// queryUser - выполняет запрос, возвращающий одну строку users
+func (storage *PGStorage) queryUser(ctx context.Context, query squirrel.Sqlizer) (*models.User, error) {
+	queryText, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "query generation error")
+	}
+
+	user, err := scanUser(storage.DB.QueryRow(ctx, queryText, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, models.ErrNotFound
+	}
+	if isUniqueViolation(err) {
+		return nil, models.ErrAlreadyExists
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "query execution error")
+	}
+
+	return user, nil
+}
+
+// nullIfEmpty - пустой email хранится как NULL, чтобы не мешать уникальному индексу
+func nullIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// CreateUser - создаем пользователя; handle и email уникальны
+func (storage *PGStorage) CreateUser(ctx context.Context, user *models.User) (*models.User, error) {
+	filtersJSON, err := json.Marshal(user.DefaultFilters)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal default filters")
+	}
+
 	query := squirrel.Insert("users").
-		Columns("name").
-		Values(name).
-		Suffix("RETURNING id").
+		Columns("handle", "email", "name", "language", "country", "timezone", "default_filters").
+		Values(user.Handle, nullIfEmpty(user.Email), user.DisplayName, user.Language, user.Country,
+			user.Timezone, filtersJSON).
+		Suffix("RETURNING " + joinColumns(userColumns)).
 		PlaceholderFormat(squirrel.Dollar)
 
+	return storage.queryUser(ctx, query)
+}
+
+// GetUser - профиль пользователя по id
+func (storage *PGStorage) GetUser(ctx context.Context, userID uint64) (*models.User, error) {
+	return storage.queryUser(ctx, squirrel.Select(userColumns...).
+		From("users").
+		Where(squirrel.Eq{"id": userID}).
+		PlaceholderFormat(squirrel.Dollar))
+}
+
+// GetUserByHandle - профиль пользователя по handle без учёта регистра
+func (storage *PGStorage) GetUserByHandle(ctx context.Context, handle string) (*models.User, error) {
+	return storage.queryUser(ctx, squirrel.Select(userColumns...).
+		From("users").
+		Where("lower(handle) = lower(?)", handle).
+		PlaceholderFormat(squirrel.Dollar))
+}
+
+// ListUsers - страница всех пользователей по возрастанию id
+func (storage *PGStorage) ListUsers(ctx context.Context, page models.PageRequest) ([]*models.User, string, error) {
+	after, err := decodeCursor(page.Token)
+	if err != nil {
+		return nil, "", err
+	}
+
+	query := squirrel.Select(userColumns...).
+		From("users").
+		OrderBy("id").
+		Limit(uint64(page.Size) + 1).
+		PlaceholderFormat(squirrel.Dollar)
+	if after != nil {
+		query = query.Where(squirrel.Gt{"id": after.ID})
+	}
+
 	queryText, args, err := query.ToSql()
 	if err != nil {
-		return 0, errors.Wrap(err, "query generation error")
+		return nil, "", errors.Wrap(err, "query generation error")
 	}
 
-	var id uint64
-	err = storage.DB.QueryRow(ctx, queryText, args...).Scan(&id)
+	rows, err := storage.DB.Query(ctx, queryText, args...)
 	if err != nil {
-		return 0, errors.Wrap(err, "query execution error")
+		return nil, "", errors.Wrap(err, "query error")
+	}
+	defer rows.Close()
+
+	var users []*models.User
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, "", errors.Wrap(err, "failed to scan row")
+		}
+		users = append(users, user)
+	}
+
+	var nextToken string
+	if len(users) > page.Size {
+		users = users[:page.Size]
+		nextToken = encodeCursor(cursor{ID: users[page.Size-1].ID})
 	}
 
-	return id, nil
+	return users, nextToken, nil
+}
+
+// UpdateUser - меняем только заданные поля профиля
+func (storage *PGStorage) UpdateUser(ctx context.Context, userID uint64, update *models.UserUpdate) (*models.User, error) {
+	values := map[string]interface{}{}
+	if update.Handle != nil {
+		values["handle"] = *update.Handle
+	}
+	if update.Email != nil {
+		values["email"] = nullIfEmpty(*update.Email)
+	}
+	if update.DisplayName != nil {
+		values["name"] = *update.DisplayName
+	}
+	if update.Language != nil {
+		values["language"] = *update.Language
+	}
+	if update.Country != nil {
+		values["country"] = *update.Country
+	}
+	if update.Timezone != nil {
+		values["timezone"] = *update.Timezone
+	}
+	if update.DefaultFilters != nil {
+		filtersJSON, err := json.Marshal(update.DefaultFilters)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal default filters")
+		}
+		values["default_filters"] = filtersJSON
+	}
+	if len(values) == 0 {
+		return storage.GetUser(ctx, userID)
+	}
+	values["updated_at"] = squirrel.Expr("CURRENT_TIMESTAMP")
+
+	query := squirrel.Update("users").
+		SetMap(values).
+		Where(squirrel.Eq{"id": userID}).
+		Suffix("RETURNING " + joinColumns(userColumns)).
+		PlaceholderFormat(squirrel.Dollar)
+
+	return storage.queryUser(ctx, query)
 }
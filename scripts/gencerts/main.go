@@ -0,0 +1,150 @@
+// gencerts - локальный CA и сертификаты сервисов gonews для TLS и mTLS между ними.
+// Работает без сети и без openssl:
+//
+//	go run ./scripts/gencerts -out certs
+//
+// Существующий CA переиспользуется, поэтому повторный запуск только перевыпускает сертификаты
+// сервисов - запущенные сервисы подхватят их с диска без перезапуска.
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// services - имена совпадают с хостами в docker-compose.yaml и путями в config.yaml сервисов;
+// gonewsctl - клиентский сертификат админской утилиты
+var services = []string{"api-gateway", "save-service", "search-service", "notify-service", "gonewsctl"}
+
+func main() {
+	out := flag.String("out", "certs", "directory for ca.crt and <service>.crt/.key")
+	days := flag.Int("days", 365, "validity of service certificates in days")
+	hosts := flag.String("hosts", "localhost,127.0.0.1", "extra comma-separated SANs for every service")
+	flag.Parse()
+
+	if err := os.MkdirAll(*out, 0o755); err != nil {
+		log.Fatalf("Failed to create %s: %v", *out, err)
+	}
+
+	ca, caKey, err := loadOrCreateCA(*out)
+	if err != nil {
+		log.Fatalf("Failed to prepare CA: %v", err)
+	}
+
+	validFor := time.Duration(*days) * 24 * time.Hour
+	for _, name := range services {
+		if err := issue(*out, name, strings.Split(*hosts, ","), validFor, ca, caKey); err != nil {
+			log.Fatalf("Failed to issue certificate for %s: %v", name, err)
+		}
+		fmt.Printf("%s/%s.crt\n", *out, name)
+	}
+}
+
+func loadOrCreateCA(dir string) (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	certFile, keyFile := filepath.Join(dir, "ca.crt"), filepath.Join(dir, "ca.key")
+
+	pair, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err == nil {
+		key, ok := pair.PrivateKey.(*ecdsa.PrivateKey)
+		if !ok {
+			return nil, nil, errors.New("ca.key is not an ECDSA key")
+		}
+		cert, err := x509.ParseCertificate(pair.Certificate[0])
+		return cert, key, err
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, nil, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          serial(),
+		Subject:               pkix.Name{CommonName: "gonews local CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().AddDate(10, 0, 0),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := write(certFile, keyFile, der, key); err != nil {
+		return nil, nil, err
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	return cert, key, err
+}
+
+// issue - сертификат сервиса: им он и принимает соединения, и представляется как клиент (mTLS)
+func issue(dir, name string, hosts []string, validFor time.Duration, ca *x509.Certificate, caKey *ecdsa.PrivateKey) error {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: serial(),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(validFor),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	for _, host := range hosts {
+		host = strings.TrimSpace(host)
+		if ip := net.ParseIP(host); ip != nil {
+			tmpl.IPAddresses = append(tmpl.IPAddresses, ip)
+		} else if host != "" {
+			tmpl.DNSNames = append(tmpl.DNSNames, host)
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca, &key.PublicKey, caKey)
+	if err != nil {
+		return err
+	}
+	return write(filepath.Join(dir, name+".crt"), filepath.Join(dir, name+".key"), der, key)
+}
+
+func write(certFile, keyFile string, der []byte, key *ecdsa.PrivateKey) error {
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return err
+	}
+
+	// ключ пишем первым: сервис перечитывает пару, когда меняется сертификат
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		return err
+	}
+	return os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o644)
+}
+
+func serial() *big.Int {
+	n, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 127))
+	if err != nil {
+		log.Fatalf("Failed to generate serial number: %v", err)
+	}
+	return n
+}
@@ -9,5 +9,9 @@ protoc --go_out=%OUTPUT_DIR% ^
        --go_opt=paths=source_relative ^
        --go-grpc_out=%OUTPUT_DIR% ^
        --go-grpc_opt=paths=source_relative ^
+       --grpc-gateway_out=%OUTPUT_DIR% ^
+       --grpc-gateway_opt=paths=source_relative ^
        -I %PROTO_DIR% ^
-       %PROTO_DIR%\*.proto
+       -I %PROTO_DIR%\third_party ^
+       %PROTO_DIR%\*.proto ^
+       %PROTO_DIR%\v2\*.proto
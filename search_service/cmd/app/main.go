@@ -8,18 +8,20 @@ import (
 )
 
 func main() {
-	path := os.Getenv("configPath")
-	if path == "" {
-		path = "/config/config.yaml"
-	}
-	cfg, err := config.LoadConfig(path)
+	cfg, err := config.LoadConfig(os.Args[1:])
 	if err != nil {
 		panic(fmt.Sprintf("config load error: %v", err))
 	}
 
+	bootstrap.InitLogging(cfg)
+	shutdownTracing := bootstrap.InitTracing(cfg)
+	bootstrap.InitMetrics(cfg)
 	redisStorage := bootstrap.InitRedisStorage(cfg)
-	newsAPIClient := bootstrap.InitNewsAPIClient(cfg)
-	searchService := bootstrap.InitSearchService(newsAPIClient, redisStorage, cfg)
-	grpcServer := bootstrap.InitGRPCServer(searchService, cfg)
-	bootstrap.AppRun(grpcServer, cfg)
+	breakers := bootstrap.InitBreakers(cfg)
+	newsAPIClient := bootstrap.InitNewsAPIClient(cfg, breakers)
+	searchService := bootstrap.InitSearchService(newsAPIClient, redisStorage, breakers, cfg)
+	bootstrap.InitConfigReload(cfg, os.Args[1:], searchService)
+	healthServer := bootstrap.InitHealthServer(redisStorage, newsAPIClient, breakers, cfg)
+	grpcServer := bootstrap.InitGRPCServer(searchService, healthServer, cfg)
+	bootstrap.AppRun(grpcServer, shutdownTracing, cfg)
 }
@@ -1,28 +1,52 @@
 package config
 
 import (
-	"fmt"
-	"os"
-
-	"gopkg.in/yaml.v3"
+	"context"
+	"gonews/pkg/breaker"
+	"gonews/pkg/configloader"
+	"gonews/pkg/logging"
+	"gonews/pkg/tlsconfig"
+	"gonews/pkg/tracing"
 )
 
 type RedisConfig struct {
-	Host string `yaml:"host"`
-	Port int    `yaml:"port"`
+	Host string `yaml:"host" default:"redis" validate:"required"`
+	Port int    `yaml:"port" default:"6379" validate:"port"`
 }
 
 type GRPCConfig struct {
-	Port int `yaml:"port"`
+	Port int `yaml:"port" default:"50052" validate:"port"`
+	// DefaultDeadlineSeconds - дедлайн вызовов, пришедших без дедлайна клиента
+	DefaultDeadlineSeconds int `yaml:"default_deadline_seconds" default:"20" validate:"min=1"`
 }
 
 type SaveServiceConfig struct {
-	Host string `yaml:"host"`
-	Port int    `yaml:"port"`
+	Host string `yaml:"host" default:"save-service" validate:"required"`
+	Port int    `yaml:"port" default:"50051" validate:"port"`
 }
 
 type NewsAPIConfig struct {
-	APIKey string `yaml:"api_key"`
+	// Enabled - выключенный NewsAPI не тратит квоту: отвечаем только из кэша
+	Enabled bool   `yaml:"enabled" default:"true" reload:"true"`
+	APIKey  string `yaml:"api_key" env:"NEWS_API_KEY" validate:"required" secret:"true"`
+}
+
+// CacheConfig - сколько живут в Redis ответы NewsAPI
+type CacheConfig struct {
+	SearchTTLMinutes    int `yaml:"search_ttl_minutes" default:"10" validate:"min=1" reload:"true"`
+	HeadlinesTTLMinutes int `yaml:"headlines_ttl_minutes" default:"5" validate:"min=1" reload:"true"`
+	CheckTTLMinutes     int `yaml:"check_ttl_minutes" default:"30" validate:"min=1" reload:"true"`
+	// StaleTTLMinutes - копия ответа, которую отдаём, когда NewsAPI недоступен
+	StaleTTLMinutes int `yaml:"stale_ttl_minutes" default:"1440" validate:"min=1" reload:"true"`
+}
+
+type HealthConfig struct {
+	IntervalSeconds int `yaml:"interval_seconds" default:"10" validate:"min=1"`
+	TimeoutSeconds  int `yaml:"timeout_seconds" default:"2" validate:"min=1"`
+}
+
+type MetricsConfig struct {
+	Port int `yaml:"port" default:"9102" validate:"port"`
 }
 
 type Config struct {
@@ -30,23 +54,32 @@ type Config struct {
 	GRPC        GRPCConfig        `yaml:"grpc"`
 	SaveService SaveServiceConfig `yaml:"save_service"`
 	NewsAPI     NewsAPIConfig     `yaml:"newsapi"`
+	Cache       CacheConfig       `yaml:"cache"`
+	// CircuitBreaker - пороги breaker-ов NewsAPI и save service
+	CircuitBreaker breaker.Config   `yaml:"circuit_breaker"`
+	Health         HealthConfig     `yaml:"health"`
+	Metrics        MetricsConfig    `yaml:"metrics"`
+	Logging        logging.Config   `yaml:"logging"`
+	Tracing        tracing.Config   `yaml:"tracing"`
+	TLS            tlsconfig.Config `yaml:"tls"`
 }
 
-func LoadConfig(filename string) (*Config, error) {
-	if filename == "" {
-		filename = "config/config.yaml"
-	}
-
-	data, err := os.ReadFile(filename)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read config file: %w", err)
-	}
+func options(args []string) configloader.Options {
+	return configloader.Options{DefaultPath: "/config/config.yaml", Args: args}
+}
 
+// LoadConfig - значения по умолчанию, YAML, переменные окружения (GONEWS_*) и флаги из args
+func LoadConfig(args []string) (*Config, error) {
 	var config Config
-	err = yaml.Unmarshal(data, &config)
-	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal yaml: %w", err)
+	if err := configloader.Load(&config, options(args)); err != nil {
+		return nil, err
 	}
 
 	return &config, nil
 }
+
+// WatchConfig - по SIGHUP и при изменении файла передаёт в apply конфиг с новыми значениями
+// полей reload:"true"; остальные поля применяются только после перезапуска
+func WatchConfig(ctx context.Context, current *Config, args []string, apply func(*Config)) {
+	configloader.Watch(ctx, current, options(args), apply)
+}
@@ -4,16 +4,9 @@ import (
 	"context"
 	"gonews/protos/pb"
 	"time"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 func (s *GRPCServer) CheckNewArticles(ctx context.Context, req *pb.CheckNewArticlesRequest) (*pb.CheckNewArticlesResponse, error) {
-	if req.Keyword == "" {
-		return nil, status.Error(codes.InvalidArgument, "keyword is required")
-	}
-
 	lastCheckTime := req.LastCheckTime
 	if lastCheckTime == "" {
 		// Если время не указано, используем время 24 часа назад
@@ -23,7 +16,7 @@ func (s *GRPCServer) CheckNewArticles(ctx context.Context, req *pb.CheckNewArtic
 	// Вызываем метод searchService со string
 	news, err := s.searchService.CheckNewArticles(ctx, req.Keyword, lastCheckTime)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, searchError(ctx, err)
 	}
 
 	protoNews := make([]*pb.News, len(news))
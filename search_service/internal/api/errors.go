@@ -0,0 +1,37 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"gonews/pkg/breaker"
+	"gonews/pkg/logging"
+	"gonews/search_service/internal/services/searchService"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// searchError - переводим ошибки поиска в gRPC коды, детали внутренних ошибок только в лог.
+// Ошибки провайдера помечаются breaker.UpstreamError: breaker gateway не должен размыкаться из-за квоты
+// или недоступности NewsAPI, пока сам search service отвечает
+func searchError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(err, searchService.ErrProviderRateLimited):
+		return breaker.UpstreamError(codes.ResourceExhausted, "PROVIDER_RATE_LIMITED", "news provider rate limit exceeded, try again later")
+	case errors.Is(err, searchService.ErrProviderRejected):
+		return status.Error(codes.InvalidArgument, "news provider rejected the request parameters")
+	case errors.Is(err, searchService.ErrProviderUnavailable):
+		return breaker.UpstreamError(codes.Unavailable, "PROVIDER_UNAVAILABLE", "news provider is unavailable")
+	case errors.Is(err, searchService.ErrInvalidCachePattern):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return breaker.UpstreamError(codes.DeadlineExceeded, "PROVIDER_TIMEOUT", "news provider did not respond in time")
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, "request canceled")
+	}
+
+	slog.ErrorContext(ctx, "search failed", logging.Err(err))
+	return status.Error(codes.Internal, "internal error")
+}
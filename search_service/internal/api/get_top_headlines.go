@@ -5,19 +5,13 @@ import (
 	"gonews/protos/pb"
 	"gonews/search_service/internal/services/searchService"
 	"time"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 func (s *GRPCServer) GetTopHeadlines(ctx context.Context, req *pb.GetTopHeadlinesRequest) (*pb.GetTopHeadlinesResponse, error) {
-	if req.UserId == 0 {
-		return nil, status.Error(codes.InvalidArgument, "user_id is required")
-	}
-
 	// Convert gRPC request to service request
 	headlinesReq := &searchService.TopHeadlinesRequest{
-		UserID: req.UserId,
+		UserID:     req.UserId,
+		UnseenOnly: req.UnseenOnly,
 	}
 
 	// опциональные поля
@@ -42,7 +36,7 @@ func (s *GRPCServer) GetTopHeadlines(ctx context.Context, req *pb.GetTopHeadline
 
 	news, totalResults, err := s.searchService.GetTopHeadlines(ctx, headlinesReq)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, searchError(ctx, err)
 	}
 
 	// Convert to protobuf response
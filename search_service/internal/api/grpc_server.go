@@ -12,6 +12,7 @@ type SearchService interface {
 	CheckNewArticles(ctx context.Context, keyword string, lastCheckTime string) ([]*searchService.News, error)
 	GetTopHeadlines(ctx context.Context, req *searchService.TopHeadlinesRequest) ([]*searchService.News, int, error)
 	SearchNews(ctx context.Context, req *searchService.SearchRequest) ([]*searchService.News, int, error)
+	PurgeCache(ctx context.Context, pattern string) (int64, error)
 }
 
 type GRPCServer struct {
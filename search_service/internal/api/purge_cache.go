@@ -0,0 +1,15 @@
+package api
+
+import (
+	"context"
+	"gonews/protos/pb"
+)
+
+func (s *GRPCServer) PurgeCache(ctx context.Context, req *pb.PurgeCacheRequest) (*pb.PurgeCacheResponse, error) {
+	deleted, err := s.searchService.PurgeCache(ctx, req.Pattern)
+	if err != nil {
+		return nil, searchError(ctx, err)
+	}
+
+	return &pb.PurgeCacheResponse{Deleted: deleted}, nil
+}
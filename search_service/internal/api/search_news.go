@@ -5,24 +5,15 @@ import (
 	"gonews/protos/pb"
 	"gonews/search_service/internal/services/searchService"
 	"time"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 func (s *GRPCServer) SearchNews(ctx context.Context, req *pb.SearchNewsRequest) (*pb.SearchNewsResponse, error) {
-	if req.UserId == 0 {
-		return nil, status.Error(codes.InvalidArgument, "user_id is required")
-	}
-
-	if req.Query == "" {
-		return nil, status.Error(codes.InvalidArgument, "query is required")
-	}
-
 	// Convert gRPC request to service request
 	searchReq := &searchService.SearchRequest{
-		UserID: req.UserId,
-		Query:  req.Query,
+		UserID:      req.UserId,
+		Query:       req.Query,
+		SkipHistory: req.SkipHistory,
+		UnseenOnly:  req.UnseenOnly,
 	}
 
 	// опциональные поля
@@ -53,7 +44,7 @@ func (s *GRPCServer) SearchNews(ctx context.Context, req *pb.SearchNewsRequest)
 
 	news, totalResults, err := s.searchService.SearchNews(ctx, searchReq)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, searchError(ctx, err)
 	}
 
 	// Convert to protobuf response
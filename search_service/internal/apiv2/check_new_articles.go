@@ -0,0 +1,41 @@
+package apiv2
+
+import (
+	"context"
+	pbv2 "gonews/protos/pb/v2"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *GRPCServer) CheckNewArticles(ctx context.Context, req *pbv2.CheckNewArticlesRequest) (*pbv2.CheckNewArticlesResponse, error) {
+	if req.Keyword == "" {
+		return nil, status.Error(codes.InvalidArgument, "keyword is required")
+	}
+
+	lastCheckTs := req.LastCheckTime
+	if lastCheckTs == nil {
+		// Время не указано - проверяем за последние 24 часа
+		lastCheckTs = timestamppb.New(time.Now().Add(-24 * time.Hour))
+	}
+	lastCheckTime, err := timeParam("last_check_time", lastCheckTs)
+	if err != nil {
+		return nil, err
+	}
+
+	news, err := s.searchService.CheckNewArticles(ctx, req.Keyword, lastCheckTime)
+	if err != nil {
+		return nil, searchError(ctx, err)
+	}
+
+	protoNews := make([]*pbv2.News, len(news))
+	for i, n := range news {
+		protoNews[i] = newsToProto(n)
+	}
+
+	return &pbv2.CheckNewArticlesResponse{
+		NewArticles: protoNews,
+	}, nil
+}
@@ -0,0 +1,103 @@
+package apiv2
+
+import (
+	"fmt"
+	pbv2 "gonews/protos/pb/v2"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Значения параметров NewsAPI для перечислений v2.
+// UNSPECIFIED означает, что параметр не передаётся.
+
+var sortByValues = map[pbv2.SortBy]string{
+	pbv2.SortBy_SORT_BY_UNSPECIFIED:  "",
+	pbv2.SortBy_SORT_BY_RELEVANCY:    "relevancy",
+	pbv2.SortBy_SORT_BY_POPULARITY:   "popularity",
+	pbv2.SortBy_SORT_BY_PUBLISHED_AT: "publishedAt",
+}
+
+var categoryValues = map[pbv2.Category]string{
+	pbv2.Category_CATEGORY_UNSPECIFIED:   "",
+	pbv2.Category_CATEGORY_BUSINESS:      "business",
+	pbv2.Category_CATEGORY_ENTERTAINMENT: "entertainment",
+	pbv2.Category_CATEGORY_GENERAL:       "general",
+	pbv2.Category_CATEGORY_HEALTH:        "health",
+	pbv2.Category_CATEGORY_SCIENCE:       "science",
+	pbv2.Category_CATEGORY_SPORTS:        "sports",
+	pbv2.Category_CATEGORY_TECHNOLOGY:    "technology",
+}
+
+var languageValues = map[pbv2.Language]string{
+	pbv2.Language_LANGUAGE_UNSPECIFIED: "",
+	pbv2.Language_LANGUAGE_AR:          "ar",
+	pbv2.Language_LANGUAGE_DE:          "de",
+	pbv2.Language_LANGUAGE_EN:          "en",
+	pbv2.Language_LANGUAGE_ES:          "es",
+	pbv2.Language_LANGUAGE_FR:          "fr",
+	pbv2.Language_LANGUAGE_HE:          "he",
+	pbv2.Language_LANGUAGE_IT:          "it",
+	pbv2.Language_LANGUAGE_NL:          "nl",
+	pbv2.Language_LANGUAGE_NO:          "no",
+	pbv2.Language_LANGUAGE_PT:          "pt",
+	pbv2.Language_LANGUAGE_RU:          "ru",
+	pbv2.Language_LANGUAGE_SV:          "sv",
+	pbv2.Language_LANGUAGE_UD:          "ud",
+	pbv2.Language_LANGUAGE_ZH:          "zh",
+}
+
+func sortByParam(v pbv2.SortBy) (string, error) {
+	param, ok := sortByValues[v]
+	if !ok {
+		return "", status.Error(codes.InvalidArgument, fmt.Sprintf("unknown sort_by: %d", v))
+	}
+	return param, nil
+}
+
+func categoryParam(v pbv2.Category) (string, error) {
+	param, ok := categoryValues[v]
+	if !ok {
+		return "", status.Error(codes.InvalidArgument, fmt.Sprintf("unknown category: %d", v))
+	}
+	return param, nil
+}
+
+func languageParam(v pbv2.Language) (string, error) {
+	param, ok := languageValues[v]
+	if !ok {
+		return "", status.Error(codes.InvalidArgument, fmt.Sprintf("unknown language: %d", v))
+	}
+	return param, nil
+}
+
+// timeParam проверяет Timestamp и возвращает его в формате RFC3339 (пустая строка, если не задан)
+func timeParam(field string, ts *timestamppb.Timestamp) (string, error) {
+	if ts == nil {
+		return "", nil
+	}
+	if err := ts.CheckValid(); err != nil {
+		return "", status.Error(codes.InvalidArgument, fmt.Sprintf("%s: %v", field, err))
+	}
+	return ts.AsTime().UTC().Format(time.RFC3339), nil
+}
+
+// pagingParams проверяет page_size (1..100, ограничение NewsAPI) и page (>= 1)
+func pagingParams(pageSize, page *int32) (int, int, error) {
+	var ps, p int
+	if pageSize != nil {
+		if *pageSize < 1 || *pageSize > 100 {
+			return 0, 0, status.Error(codes.InvalidArgument, "page_size must be between 1 and 100")
+		}
+		ps = int(*pageSize)
+	}
+	if page != nil {
+		if *page < 1 {
+			return 0, 0, status.Error(codes.InvalidArgument, "page must be positive")
+		}
+		p = int(*page)
+	}
+	return ps, p, nil
+}
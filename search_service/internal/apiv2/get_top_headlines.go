@@ -0,0 +1,56 @@
+package apiv2
+
+import (
+	"context"
+	pbv2 "gonews/protos/pb/v2"
+	"gonews/search_service/internal/services/searchService"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) GetTopHeadlines(ctx context.Context, req *pbv2.GetTopHeadlinesRequest) (*pbv2.GetTopHeadlinesResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	category, err := categoryParam(req.Category)
+	if err != nil {
+		return nil, err
+	}
+	pageSize, page, err := pagingParams(req.PageSize, req.Page)
+	if err != nil {
+		return nil, err
+	}
+
+	headlinesReq := &searchService.TopHeadlinesRequest{
+		UserID:   req.UserId,
+		Category: category,
+		PageSize: pageSize,
+		Page:     page,
+	}
+	if req.Country != nil {
+		headlinesReq.Country = *req.Country
+	}
+	if req.Sources != nil {
+		headlinesReq.Sources = *req.Sources
+	}
+	if req.Query != nil {
+		headlinesReq.Query = *req.Query
+	}
+
+	news, totalResults, err := s.searchService.GetTopHeadlines(ctx, headlinesReq)
+	if err != nil {
+		return nil, searchError(ctx, err)
+	}
+
+	protoNews := make([]*pbv2.News, len(news))
+	for i, n := range news {
+		protoNews[i] = newsToProto(n)
+	}
+
+	return &pbv2.GetTopHeadlinesResponse{
+		News:         protoNews,
+		TotalResults: int32(totalResults),
+	}, nil
+}
@@ -0,0 +1,50 @@
+package apiv2
+
+import (
+	"context"
+	pbv2 "gonews/protos/pb/v2"
+	"gonews/search_service/internal/services/searchService"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type SearchService interface {
+	CheckNewArticles(ctx context.Context, keyword string, lastCheckTime string) ([]*searchService.News, error)
+	GetTopHeadlines(ctx context.Context, req *searchService.TopHeadlinesRequest) ([]*searchService.News, int, error)
+	SearchNews(ctx context.Context, req *searchService.SearchRequest) ([]*searchService.News, int, error)
+}
+
+// GRPCServer - реализация news.v2.SearchService поверх того же SearchService, что и v1
+type GRPCServer struct {
+	pbv2.UnimplementedSearchServiceServer
+	searchService SearchService
+}
+
+func NewGRPCServer(searchService SearchService) *GRPCServer {
+	return &GRPCServer{
+		searchService: searchService,
+	}
+}
+
+func (s *GRPCServer) Register(server *grpc.Server) {
+	pbv2.RegisterSearchServiceServer(server, s)
+}
+
+func newsToProto(n *searchService.News) *pbv2.News {
+	var publishedAt *timestamppb.Timestamp
+	if !n.PublishedAt.IsZero() {
+		publishedAt = timestamppb.New(n.PublishedAt)
+	}
+
+	return &pbv2.News{
+		Id:          n.ID,
+		Source:      n.Source,
+		Author:      n.Author,
+		Title:       n.Title,
+		Description: n.Description,
+		Url:         n.URL,
+		ImageUrl:    n.ImageURL,
+		PublishedAt: publishedAt,
+	}
+}
@@ -0,0 +1,77 @@
+package apiv2
+
+import (
+	"context"
+	pbv2 "gonews/protos/pb/v2"
+	"gonews/search_service/internal/services/searchService"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) SearchNews(ctx context.Context, req *pbv2.SearchNewsRequest) (*pbv2.SearchNewsResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if req.Query == "" {
+		return nil, status.Error(codes.InvalidArgument, "query is required")
+	}
+
+	from, err := timeParam("from", req.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := timeParam("to", req.To)
+	if err != nil {
+		return nil, err
+	}
+	if req.From != nil && req.To != nil && req.From.AsTime().After(req.To.AsTime()) {
+		return nil, status.Error(codes.InvalidArgument, "from must not be after to")
+	}
+
+	language, err := languageParam(req.Language)
+	if err != nil {
+		return nil, err
+	}
+	sortBy, err := sortByParam(req.SortBy)
+	if err != nil {
+		return nil, err
+	}
+	pageSize, page, err := pagingParams(req.PageSize, req.Page)
+	if err != nil {
+		return nil, err
+	}
+
+	searchReq := &searchService.SearchRequest{
+		UserID:   req.UserId,
+		Query:    req.Query,
+		From:     from,
+		To:       to,
+		Language: language,
+		SortBy:   sortBy,
+		PageSize: pageSize,
+		Page:     page,
+	}
+	if req.Sources != nil {
+		searchReq.Sources = *req.Sources
+	}
+	if req.Domains != nil {
+		searchReq.Domains = *req.Domains
+	}
+
+	news, totalResults, err := s.searchService.SearchNews(ctx, searchReq)
+	if err != nil {
+		return nil, searchError(ctx, err)
+	}
+
+	protoNews := make([]*pbv2.News, len(news))
+	for i, n := range news {
+		protoNews[i] = newsToProto(n)
+	}
+
+	return &pbv2.SearchNewsResponse{
+		News:         protoNews,
+		TotalResults: int32(totalResults),
+	}, nil
+}
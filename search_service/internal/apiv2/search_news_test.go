@@ -0,0 +1,77 @@
+package apiv2
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pbv2 "gonews/protos/pb/v2"
+	"gonews/search_service/internal/services/searchService"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+)
+
+type fakeSearchService struct {
+	searchReq *searchService.SearchRequest
+}
+
+func (f *fakeSearchService) CheckNewArticles(ctx context.Context, keyword string, lastCheckTime string) ([]*searchService.News, error) {
+	return nil, nil
+}
+
+func (f *fakeSearchService) GetTopHeadlines(ctx context.Context, req *searchService.TopHeadlinesRequest) ([]*searchService.News, int, error) {
+	return nil, 0, nil
+}
+
+func (f *fakeSearchService) SearchNews(ctx context.Context, req *searchService.SearchRequest) ([]*searchService.News, int, error) {
+	f.searchReq = req
+	return nil, 0, nil
+}
+
+func TestSearchNewsConvertsTypedFields(t *testing.T) {
+	service := &fakeSearchService{}
+	server := NewGRPCServer(service)
+	from := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	_, err := server.SearchNews(context.Background(), &pbv2.SearchNewsRequest{
+		UserId:   1,
+		Query:    "bitcoin",
+		From:     timestamppb.New(from),
+		Language: pbv2.Language_LANGUAGE_EN,
+		SortBy:   pbv2.SortBy_SORT_BY_PUBLISHED_AT,
+	})
+
+	assert.NilError(t, err)
+	assert.Equal(t, "2025-01-02T03:04:05Z", service.searchReq.From)
+	assert.Equal(t, "", service.searchReq.To)
+	assert.Equal(t, "en", service.searchReq.Language)
+	assert.Equal(t, "publishedAt", service.searchReq.SortBy)
+}
+
+func TestSearchNewsRejectsInvalidArguments(t *testing.T) {
+	now := time.Now()
+	pageSize := int32(500)
+
+	tests := map[string]*pbv2.SearchNewsRequest{
+		"invalid timestamp": {UserId: 1, Query: "q", From: &timestamppb.Timestamp{Nanos: -1}},
+		"from after to":     {UserId: 1, Query: "q", From: timestamppb.New(now), To: timestamppb.New(now.Add(-time.Hour))},
+		"unknown language":  {UserId: 1, Query: "q", Language: pbv2.Language(99)},
+		"unknown sort_by":   {UserId: 1, Query: "q", SortBy: pbv2.SortBy(99)},
+		"page size too big": {UserId: 1, Query: "q", PageSize: &pageSize},
+	}
+
+	for name, req := range tests {
+		t.Run(name, func(t *testing.T) {
+			service := &fakeSearchService{}
+			server := NewGRPCServer(service)
+
+			_, err := server.SearchNews(context.Background(), req)
+
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.Assert(t, service.searchReq == nil)
+		})
+	}
+}
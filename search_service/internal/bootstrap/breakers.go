@@ -0,0 +1,20 @@
+package bootstrap
+
+import (
+	"gonews/pkg/breaker"
+	"gonews/search_service/config"
+	"gonews/search_service/internal/newsapi"
+)
+
+// Breakers - circuit breaker-ы исходящих вызовов search service
+type Breakers struct {
+	NewsAPI     *breaker.Breaker
+	SaveService *breaker.Breaker
+}
+
+func InitBreakers(cfg *config.Config) Breakers {
+	return Breakers{
+		NewsAPI:     breaker.New("newsapi", cfg.CircuitBreaker, newsapi.IsFailure),
+		SaveService: breaker.New("save_service", cfg.CircuitBreaker, breaker.GRPCFailure),
+	}
+}
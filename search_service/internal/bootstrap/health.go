@@ -0,0 +1,24 @@
+package bootstrap
+
+import (
+	"context"
+	"gonews/pkg/health"
+	"gonews/search_service/config"
+	"gonews/search_service/internal/newsapi"
+	"gonews/search_service/internal/storage"
+	"time"
+)
+
+func InitHealthServer(redisStorage *storage.RedisStorage, newsAPIClient *newsapi.Client, breakers Breakers, cfg *config.Config) *health.Server {
+	healthServer := health.NewServer(
+		time.Duration(cfg.Health.IntervalSeconds)*time.Second,
+		time.Duration(cfg.Health.TimeoutSeconds)*time.Second,
+	)
+	healthServer.AddCheck("redis", redisStorage.Ping)
+	// без провайдера поиск отвечает из кэша и архива: это деградация, а не неготовность
+	healthServer.AddOptionalCheck("newsapi", newsAPIClient.Ping)
+	healthServer.AddBreaker(breakers.NewsAPI)
+	healthServer.AddBreaker(breakers.SaveService)
+	healthServer.Start(context.Background())
+	return healthServer
+}
@@ -0,0 +1,17 @@
+package bootstrap
+
+import (
+	"gonews/pkg/configloader"
+	"gonews/pkg/logging"
+	"gonews/search_service/config"
+	"log"
+	"log/slog"
+)
+
+func InitLogging(cfg *config.Config) {
+	if err := logging.Init("search-service", cfg.Logging); err != nil {
+		log.Fatalf("Failed to initialize logging: %v", err)
+	}
+
+	slog.Info("Effective config", "config", configloader.Redact(cfg))
+}
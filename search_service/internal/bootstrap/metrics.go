@@ -0,0 +1,11 @@
+package bootstrap
+
+import (
+	"gonews/pkg/metrics"
+	"gonews/search_service/config"
+)
+
+// InitMetrics - /metrics на отдельном порту, живёт до завершения процесса
+func InitMetrics(cfg *config.Config) {
+	metrics.Serve(cfg.Metrics.Port)
+}
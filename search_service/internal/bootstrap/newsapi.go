@@ -6,9 +6,9 @@ import (
 	"log"
 )
 
-func InitNewsAPIClient(cfg *config.Config) *newsapi.Client {
+func InitNewsAPIClient(cfg *config.Config, breakers Breakers) *newsapi.Client {
 	if cfg.NewsAPI.APIKey == "" {
 		log.Fatal("NewsAPI API key is required")
 	}
-	return newsapi.NewClient(cfg.NewsAPI.APIKey)
+	return newsapi.NewClient(cfg.NewsAPI.APIKey, breakers.NewsAPI)
 }
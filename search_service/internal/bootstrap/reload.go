@@ -0,0 +1,31 @@
+package bootstrap
+
+import (
+	"context"
+	"gonews/pkg/logging"
+	"gonews/search_service/config"
+	"gonews/search_service/internal/services/searchService"
+	"log/slog"
+	"time"
+)
+
+// searchSettings - настройки search service, которые меняются без перезапуска
+func searchSettings(cfg *config.Config) searchService.Settings {
+	return searchService.Settings{
+		ProviderEnabled: cfg.NewsAPI.Enabled,
+		SearchTTL:       time.Duration(cfg.Cache.SearchTTLMinutes) * time.Minute,
+		HeadlinesTTL:    time.Duration(cfg.Cache.HeadlinesTTLMinutes) * time.Minute,
+		CheckTTL:        time.Duration(cfg.Cache.CheckTTLMinutes) * time.Minute,
+		StaleTTL:        time.Duration(cfg.Cache.StaleTTLMinutes) * time.Minute,
+	}
+}
+
+// InitConfigReload - по SIGHUP и при изменении config.yaml применяет уровень логов, TTL кэша и newsapi.enabled
+func InitConfigReload(cfg *config.Config, args []string, searchService_ *searchService.SearchService) {
+	config.WatchConfig(context.Background(), cfg, args, func(cfg *config.Config) {
+		if err := logging.SetLevel(cfg.Logging.Level); err != nil {
+			slog.Warn("Failed to change log level", logging.Err(err))
+		}
+		searchService_.UpdateSettings(searchSettings(cfg))
+	})
+}
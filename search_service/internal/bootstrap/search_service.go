@@ -2,13 +2,22 @@ package bootstrap
 
 import (
 	"fmt"
+	"gonews/pkg/tlsconfig"
 	"gonews/search_service/config"
 	"gonews/search_service/internal/newsapi"
 	"gonews/search_service/internal/services/searchService"
 	"gonews/search_service/internal/storage"
+	"log"
 )
 
-func InitSearchService(newsAPI *newsapi.Client, cache *storage.RedisStorage, cfg *config.Config) *searchService.SearchService {
+func InitSearchService(newsAPI *newsapi.Client, cache *storage.RedisStorage, breakers Breakers, cfg *config.Config) *searchService.SearchService {
+	transport, err := tlsconfig.DialOption(cfg.TLS)
+	if err != nil {
+		log.Fatalf("Failed to configure TLS: %v", err)
+	}
+
 	saveServiceAddr := fmt.Sprintf("%s:%d", cfg.SaveService.Host, cfg.SaveService.Port)
-	return searchService.NewSearchService(newsAPI, cache, saveServiceAddr, cfg.NewsAPI.APIKey)
+	service := searchService.NewSearchService(newsAPI, cache, saveServiceAddr, cfg.NewsAPI.APIKey, transport, breakers.SaveService)
+	service.UpdateSettings(searchSettings(cfg))
+	return service
 }
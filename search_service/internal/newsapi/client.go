@@ -3,12 +3,15 @@ package newsapi
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
 	"time"
 
+	"gonews/pkg/breaker"
+	"gonews/pkg/tracing"
 	"gonews/search_service/internal/services/searchService"
 )
 
@@ -20,13 +23,17 @@ const (
 type Client struct {
 	apiKey     string
 	httpClient *http.Client
+	// breaker - пока NewsAPI недоступен, запросы сразу отказывают, не дожидаясь таймаута
+	breaker *breaker.Breaker
 }
 
-func NewClient(apiKey string) *Client {
+func NewClient(apiKey string, cb *breaker.Breaker) *Client {
 	return &Client{
-		apiKey: apiKey,
+		apiKey:  apiKey,
+		breaker: cb,
 		httpClient: &http.Client{
-			Timeout: 10 * time.Second,
+			Timeout:   10 * time.Second,
+			Transport: tracing.HTTPTransport(http.DefaultTransport, "newsapi"),
 		},
 	}
 }
@@ -57,7 +64,6 @@ type Source struct {
 func (c *Client) SearchEverything(ctx context.Context, req *searchService.SearchRequest) ([]*searchService.News, int, error) {
 	// Build URL with query parameters
 	params := url.Values{}
-	params.Add("apiKey", c.apiKey)
 	params.Add("q", req.Query)
 
 	if req.Sources != "" {
@@ -87,16 +93,12 @@ func (c *Client) SearchEverything(ctx context.Context, req *searchService.Search
 
 	requestURL := fmt.Sprintf("%s?%s", everythingURL, params.Encode())
 
-	resp, err := c.httpClient.Get(requestURL)
+	resp, err := c.get(ctx, requestURL)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to make request: %w", err)
+		return nil, 0, err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, 0, fmt.Errorf("newsapi returned status: %d", resp.StatusCode)
-	}
-
 	var apiResp NewsAPIResponse
 	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
 		return nil, 0, fmt.Errorf("failed to decode response: %w", err)
@@ -129,7 +131,6 @@ func (c *Client) SearchEverything(ctx context.Context, req *searchService.Search
 
 func (c *Client) GetTopHeadlines(ctx context.Context, req *searchService.TopHeadlinesRequest) ([]*searchService.News, int, error) {
 	params := url.Values{}
-	params.Add("apiKey", c.apiKey)
 
 	if req.Country != "" {
 		params.Add("country", req.Country)
@@ -152,16 +153,12 @@ func (c *Client) GetTopHeadlines(ctx context.Context, req *searchService.TopHead
 
 	requestURL := fmt.Sprintf("%s?%s", topHeadlinesURL, params.Encode())
 
-	resp, err := c.httpClient.Get(requestURL)
+	resp, err := c.get(ctx, requestURL)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to make request: %w", err)
+		return nil, 0, err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, 0, fmt.Errorf("newsapi returned status: %d", resp.StatusCode)
-	}
-
 	var apiResp NewsAPIResponse
 	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
 		return nil, 0, fmt.Errorf("failed to decode response: %w", err)
@@ -218,3 +215,80 @@ func (c *Client) CheckNewArticles(ctx context.Context, keyword, lastCheckTimeStr
 	n, _, e := c.SearchEverything(ctx, req)
 	return n, e
 }
+
+// IsFailure - ошибка говорит о недоступности NewsAPI и считается circuit breaker-ом
+func IsFailure(err error) bool {
+	return errors.Is(err, searchService.ErrProviderUnavailable)
+}
+
+// get - GET запрос к NewsAPI через circuit breaker; разомкнутый breaker - ErrProviderUnavailable
+func (c *Client) get(ctx context.Context, requestURL string) (*http.Response, error) {
+	var resp *http.Response
+	err := c.breaker.Do(func() error {
+		var err error
+		resp, err = c.do(ctx, requestURL)
+		return err
+	})
+	if errors.Is(err, breaker.ErrOpen) {
+		return nil, fmt.Errorf("%w: %v", searchService.ErrProviderUnavailable, err)
+	}
+	return resp, err
+}
+
+// do - GET запрос к NewsAPI; ответы не 200 превращаются в ошибки searchService.
+// Ключ передаём заголовком, чтобы он не попадал в URL спанов и логов.
+func (c *Client) do(ctx context.Context, requestURL string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("X-Api-Key", c.apiKey)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, fmt.Errorf("failed to make request: %w", ctxErr)
+		}
+		return nil, fmt.Errorf("%w: %v", searchService.ErrProviderUnavailable, err)
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		return resp, nil
+	}
+	resp.Body.Close()
+
+	return nil, statusError(resp.StatusCode)
+}
+
+// statusError - классифицируем HTTP статус NewsAPI
+func statusError(code int) error {
+	switch {
+	case code == http.StatusTooManyRequests:
+		return fmt.Errorf("%w: newsapi returned status %d", searchService.ErrProviderRateLimited, code)
+	case code == http.StatusBadRequest:
+		return fmt.Errorf("%w: newsapi returned status %d", searchService.ErrProviderRejected, code)
+	default:
+		// 401/403 - проблема нашего ключа, а не запроса клиента
+		return fmt.Errorf("%w: newsapi returned status %d", searchService.ErrProviderUnavailable, code)
+	}
+}
+
+// Ping - проверка доступности NewsAPI без ключа: ответ 401 не расходует квоту,
+// недоступным провайдер считается только при сетевой ошибке или 5xx
+func (c *Client) Ping(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, topHeadlinesURL, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("newsapi returned status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package searchService
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+// cachePrefixes - ключи кэша поиска; остальные ключи в Redis (лимиты запросов шлюза) не трогаем
+var cachePrefixes = []string{"search:", "headlines:", "check:", "stale:"}
+
+// PurgeCache - удаляет ключи кэша по glob pattern Redis; пусто - весь кэш поиска
+func (s *SearchService) PurgeCache(ctx context.Context, pattern string) (int64, error) {
+	var patterns []string
+	if pattern == "" {
+		for _, prefix := range cachePrefixes {
+			patterns = append(patterns, prefix+"*")
+		}
+	} else if !isCachePattern(pattern) {
+		return 0, fmt.Errorf("%w: must start with one of %s", ErrInvalidCachePattern, strings.Join(cachePrefixes, ", "))
+	} else {
+		patterns = []string{pattern}
+	}
+
+	var deleted int64
+	for _, p := range patterns {
+		n, err := s.cache.DeleteMatching(ctx, p)
+		deleted += n
+		if err != nil {
+			return deleted, fmt.Errorf("failed to purge %q: %w", p, err)
+		}
+	}
+
+	slog.InfoContext(ctx, "cache purged", "pattern", pattern, "deleted", deleted)
+	return deleted, nil
+}
+
+func isCachePattern(pattern string) bool {
+	for _, prefix := range cachePrefixes {
+		if strings.HasPrefix(pattern, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,13 @@
+package searchService
+
+import "errors"
+
+// Ошибки провайдера новостей, по ним api выбирает gRPC код
+var (
+	ErrProviderRateLimited = errors.New("news provider rate limit exceeded")
+	ErrProviderRejected    = errors.New("news provider rejected the request")
+	ErrProviderUnavailable = errors.New("news provider is unavailable")
+)
+
+// ErrInvalidCachePattern - шаблон PurgeCache задевает ключи вне кэша поиска
+var ErrInvalidCachePattern = errors.New("invalid cache pattern")
@@ -0,0 +1,140 @@
+package searchService
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"gonews/pkg/logging"
+	"gonews/protos/pb"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// archiveHistoryPageSize - сколько последних уникальных поисков пользователя просматриваем в архиве
+const archiveHistoryPageSize = 50
+
+// cachedResult - ответ NewsAPI в кэше
+type cachedResult struct {
+	News  []*News `json:"news"`
+	Total int     `json:"total"`
+}
+
+// staleKey - копия ответа, которая живёт дольше основного кэша и отдаётся, когда NewsAPI недоступен
+func staleKey(cacheKey string) string {
+	return "stale:" + cacheKey
+}
+
+// storeResult - свежий ответ в кэш на ttl и его копия для деградации на staleTTL
+func (s *SearchService) storeResult(ctx context.Context, cacheKey string, news []*News, total int, ttl, staleTTL time.Duration) {
+	resultJSON, _ := json.Marshal(cachedResult{News: news, Total: total})
+	s.cache.Set(ctx, cacheKey, string(resultJSON), ttl)
+	s.cache.Set(ctx, staleKey(cacheKey), string(resultJSON), staleTTL)
+}
+
+// fallback - ответ, когда NewsAPI недоступен, выключен или исчерпал квоту: устаревшая копия из кэша,
+// затем archive (если задан). false - отдать нечего, клиент получает исходную ошибку.
+func (s *SearchService) fallback(ctx context.Context, cache, cacheKey string, archive func() (cachedResult, bool), err error) (cachedResult, bool) {
+	if !errors.Is(err, ErrProviderUnavailable) && !errors.Is(err, ErrProviderRateLimited) {
+		return cachedResult{}, false
+	}
+
+	var result cachedResult
+	if cached, cacheErr := s.cache.Get(ctx, staleKey(cacheKey)); cacheErr == nil && cached != "" &&
+		json.Unmarshal([]byte(cached), &result) == nil {
+		observeFallback(cache, "stale_cache")
+		slog.WarnContext(ctx, "news provider failed, serving stale cache", "cache", cache, logging.Err(err))
+		return result, true
+	}
+
+	if archive != nil {
+		if result, ok := archive(); ok {
+			observeFallback(cache, "archive")
+			slog.WarnContext(ctx, "news provider failed, serving archived search results", "cache", cache, logging.Err(err))
+			return result, true
+		}
+	}
+
+	return cachedResult{}, false
+}
+
+// archiveResult - результаты последнего поиска пользователя с тем же запросом, фильтрами и страницей
+// из истории в save service. Поиск с другими фильтрами - другой ответ, его не подставляем.
+func archiveResult(ctx context.Context, client pb.SaveServiceClient, userID uint64, query string, filters *pb.SearchFilters) (cachedResult, bool) {
+	if client == nil || userID == 0 || query == "" {
+		return cachedResult{}, false
+	}
+
+	history, err := client.GetSearchHistory(ctx, &pb.GetSearchHistoryRequest{
+		UserId:        userID,
+		PageSize:      archiveHistoryPageSize,
+		UniqueQueries: true,
+	})
+	if err != nil {
+		return cachedResult{}, false
+	}
+
+	want := newArchiveKey(query, filters)
+	for _, entry := range history.Entries {
+		if newArchiveKey(entry.Query, entry.Filters) != want || len(entry.ResultIds) == 0 {
+			continue
+		}
+
+		resp, err := client.GetNewsByIDs(ctx, &pb.GetNewsByIDsRequest{Ids: entry.ResultIds})
+		if err != nil || len(resp.News) == 0 {
+			return cachedResult{}, false
+		}
+
+		news := make([]*News, len(resp.News))
+		for i, n := range resp.News {
+			publishedAt, _ := time.Parse(time.RFC3339, n.PublishedAt)
+			news[i] = &News{
+				ID:          n.Id,
+				Source:      n.Source,
+				Author:      n.Author,
+				Title:       n.Title,
+				Description: n.Description,
+				URL:         n.Url,
+				ImageURL:    n.ImageUrl,
+				PublishedAt: publishedAt,
+				Content:     n.Content,
+			}
+		}
+		return cachedResult{News: news, Total: len(news)}, true
+	}
+
+	return cachedResult{}, false
+}
+
+// archiveKey - нормализованные параметры поиска: регистр и пробелы не важны, страница 0 - первая
+type archiveKey struct {
+	query    string
+	sources  string
+	domains  string
+	from     string
+	to       string
+	language string
+	sortBy   string
+	pageSize int32
+	page     int32
+}
+
+func newArchiveKey(query string, filters *pb.SearchFilters) archiveKey {
+	normalize := func(s string) string { return strings.ToLower(strings.TrimSpace(s)) }
+
+	key := archiveKey{
+		query:    normalize(query),
+		sources:  normalize(filters.GetSources()),
+		domains:  normalize(filters.GetDomains()),
+		from:     strings.TrimSpace(filters.GetFrom()),
+		to:       strings.TrimSpace(filters.GetTo()),
+		language: normalize(filters.GetLanguage()),
+		sortBy:   normalize(filters.GetSortBy()),
+		pageSize: filters.GetPageSize(),
+		page:     filters.GetPage(),
+	}
+	if key.page == 0 {
+		key.page = 1
+	}
+	return key
+}
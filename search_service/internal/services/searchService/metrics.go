@@ -0,0 +1,69 @@
+package searchService
+
+import (
+	"context"
+	"errors"
+	"gonews/pkg/metrics"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	cacheLookups = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "search",
+		Name:      "cache_lookups_total",
+		Help:      "Redis cache lookups by cache (search, headlines) and result (hit, miss).",
+	}, []string{"cache", "result"})
+
+	newsAPIRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "search",
+		Name:      "newsapi_request_duration_seconds",
+		Help:      "NewsAPI call latency by endpoint and outcome; error rate is the share of outcome != ok.",
+		Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2, 5, 10},
+	}, []string{"endpoint", "outcome"})
+
+	fallbacks = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "search",
+		Name:      "fallbacks_total",
+		Help:      "Responses served without NewsAPI by cache (search, headlines) and source (stale_cache, archive).",
+	}, []string{"cache", "source"})
+)
+
+func observeCacheLookup(cache string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	cacheLookups.WithLabelValues(cache, result).Inc()
+}
+
+func observeFallback(cache, source string) {
+	fallbacks.WithLabelValues(cache, source).Inc()
+}
+
+// observeNewsAPI - время и результат вызова NewsAPI, начатого в start
+func observeNewsAPI(endpoint string, start time.Time, err error) {
+	newsAPIRequestDuration.WithLabelValues(endpoint, newsAPIOutcome(err)).Observe(time.Since(start).Seconds())
+}
+
+func newsAPIOutcome(err error) string {
+	switch {
+	case err == nil:
+		return metrics.OutcomeOK
+	case errors.Is(err, ErrProviderRateLimited):
+		return "rate_limited"
+	case errors.Is(err, ErrProviderRejected):
+		return "rejected"
+	case errors.Is(err, ErrProviderUnavailable):
+		return "unavailable"
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		return "canceled"
+	default:
+		return metrics.OutcomeError
+	}
+}
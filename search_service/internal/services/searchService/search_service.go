@@ -4,11 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"gonews/pkg/breaker"
+	"gonews/pkg/logging"
+	"gonews/pkg/metrics"
+	"gonews/pkg/tracing"
 	"gonews/protos/pb"
+	"sync/atomic"
 	"time"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 type News struct {
@@ -34,6 +38,10 @@ type SearchRequest struct {
 	SortBy   string
 	PageSize int
 	Page     int
+	// SkipHistory - не записывать поиск в историю пользователя
+	SkipHistory bool
+	// UnseenOnly - убрать новости, уже просмотренные пользователем
+	UnseenOnly bool
 }
 
 type TopHeadlinesRequest struct {
@@ -44,6 +52,8 @@ type TopHeadlinesRequest struct {
 	Query    string
 	PageSize int
 	Page     int
+	// UnseenOnly - убрать новости, уже просмотренные пользователем
+	UnseenOnly bool
 }
 
 type NewsAPIClient interface {
@@ -55,6 +65,29 @@ type NewsAPIClient interface {
 type CacheStorage interface {
 	Get(ctx context.Context, key string) (string, error)
 	Set(ctx context.Context, key string, value string, expiration time.Duration) error
+	DeleteMatching(ctx context.Context, pattern string) (int64, error)
+}
+
+// Settings - настройки, которые меняются без перезапуска сервиса
+type Settings struct {
+	// ProviderEnabled - ходить ли в NewsAPI; выключенный отвечает только из кэша
+	ProviderEnabled bool
+	SearchTTL       time.Duration
+	HeadlinesTTL    time.Duration
+	CheckTTL        time.Duration
+	// StaleTTL - сколько хранится копия ответа на случай недоступности NewsAPI
+	StaleTTL time.Duration
+}
+
+// DefaultSettings - настройки до первого UpdateSettings
+func DefaultSettings() Settings {
+	return Settings{
+		ProviderEnabled: true,
+		SearchTTL:       10 * time.Minute,
+		HeadlinesTTL:    5 * time.Minute,
+		CheckTTL:        30 * time.Minute,
+		StaleTTL:        24 * time.Hour,
+	}
 }
 
 type SearchService struct {
@@ -62,94 +95,280 @@ type SearchService struct {
 	cache           CacheStorage
 	saveServiceAddr string
 	newsAPIKey      string
+	// transport - TLS или незашифрованное соединение до save service
+	transport grpc.DialOption
+	// saveBreaker - пока save service недоступен, вызовы к нему сразу отказывают
+	saveBreaker *breaker.Breaker
+	settings    atomic.Pointer[Settings]
 }
 
-func NewSearchService(newsAPI NewsAPIClient, cache CacheStorage, saveServiceAddr, newsAPIKey string) *SearchService {
-	return &SearchService{
+func NewSearchService(newsAPI NewsAPIClient, cache CacheStorage, saveServiceAddr, newsAPIKey string, transport grpc.DialOption, saveBreaker *breaker.Breaker) *SearchService {
+	s := &SearchService{
 		newsAPI:         newsAPI,
 		cache:           cache,
 		saveServiceAddr: saveServiceAddr,
 		newsAPIKey:      newsAPIKey,
+		transport:       transport,
+		saveBreaker:     saveBreaker,
+	}
+	s.UpdateSettings(DefaultSettings())
+	return s
+}
+
+// UpdateSettings - применяет настройки к следующим запросам; безопасно вызывать во время работы
+func (s *SearchService) UpdateSettings(settings Settings) {
+	s.settings.Store(&settings)
+}
+
+// dialSave - соединение с save service; без него поиск работает, но без истории, профиля и ID новостей
+func (s *SearchService) dialSave() (*grpc.ClientConn, error) {
+	return grpc.Dial(s.saveServiceAddr, s.transport, tracing.DialOption(), logging.DialOption(), metrics.DialOption(), s.saveBreaker.DialOption())
+}
+
+// provider - текущие настройки; ErrProviderUnavailable, если NewsAPI выключен в конфиге
+func (s *SearchService) provider() (Settings, error) {
+	settings := *s.settings.Load()
+	if !settings.ProviderEnabled {
+		return settings, fmt.Errorf("%w: newsapi is disabled in config", ErrProviderUnavailable)
 	}
+	return settings, nil
 }
 
 func (s *SearchService) SearchNews(ctx context.Context, req *SearchRequest) ([]*News, int, error) {
+	conn, dialErr := s.dialSave()
+	var client pb.SaveServiceClient
+	if dialErr == nil {
+		defer conn.Close()
+		client = pb.NewSaveServiceClient(conn)
+	}
+
+	// Незаданные параметры берём из профиля пользователя
+	if client != nil {
+		applySearchDefaults(req, userProfile(ctx, client, req.UserID))
+	}
+
 	// Check cache
-	cacheKey := fmt.Sprintf("search:%s:%s:%s:%d:%d",
-		req.Query, req.From, req.To, req.PageSize, req.Page)
+	cacheKey := fmt.Sprintf("search:%s:%s:%s:%s:%s:%s:%s:%d:%d",
+		req.Query, req.Sources, req.Domains, req.From, req.To, req.Language, req.SortBy, req.PageSize, req.Page)
+
+	var cached cachedResult
+	cachedJSON, err := s.cache.Get(ctx, cacheKey)
+	isCached := err == nil && cachedJSON != "" && json.Unmarshal([]byte(cachedJSON), &cached) == nil
 
-	if cached, err := s.cache.Get(ctx, cacheKey); err == nil && cached != "" {
-		var cachedResult struct {
-			News  []*News `json:"news"`
-			Total int     `json:"total"`
+	observeCacheLookup("search", isCached)
+
+	news, total := cached.News, cached.Total
+
+	if !isCached {
+		settings, err := s.provider()
+		if err == nil {
+			// Call external API
+			start := time.Now()
+			news, total, err = s.newsAPI.SearchEverything(ctx, req)
+			observeNewsAPI("everything", start, err)
 		}
-		if err := json.Unmarshal([]byte(cached), &cachedResult); err == nil {
-			return cachedResult.News, cachedResult.Total, nil
+
+		if err != nil {
+			archive := func() (cachedResult, bool) {
+				return archiveResult(ctx, client, req.UserID, req.Query, searchFilters(req))
+			}
+			result, ok := s.fallback(ctx, "search", cacheKey, archive, err)
+			if !ok {
+				return nil, 0, err
+			}
+			news, total = result.News, result.Total
+		} else {
+			// Save to database via gRPC - news get their IDs
+			if client != nil {
+				saveNews(ctx, client, news)
+			}
+
+			s.storeResult(ctx, cacheKey, news, total, settings.SearchTTL, settings.StaleTTL)
 		}
 	}
 
-	// Call external API
-	news, total, err := s.newsAPI.SearchEverything(ctx, req)
-	if err != nil {
-		return nil, 0, err
+	// Save search history (cached searches are recorded too)
+	if client != nil && !req.SkipHistory {
+		newsIDs := make([]uint64, 0, len(news))
+		for _, n := range news {
+			if n.ID != 0 {
+				newsIDs = append(newsIDs, n.ID)
+			}
+		}
+		client.AddToSearchHistory(ctx, &pb.AddToSearchHistoryRequest{
+			UserId:       req.UserID,
+			Query:        req.Query,
+			Results:      newsIDs,
+			Filters:      searchFilters(req),
+			TotalResults: int32(total),
+		})
 	}
 
-	// Save to cache
-	resultJSON, _ := json.Marshal(map[string]interface{}{
-		"news":  news,
-		"total": total,
-	})
-	s.cache.Set(ctx, cacheKey, string(resultJSON), 10*time.Minute)
+	if req.UnseenOnly && client != nil {
+		news = filterSeen(ctx, client, req.UserID, news)
+	}
 
-	// Save to database via gRPC
-	conn, err := grpc.Dial(s.saveServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err == nil {
+	return news, total, nil
+}
+
+// saveNews - сохраняет новости в save service и проставляет им ID из базы
+func saveNews(ctx context.Context, client pb.SaveServiceClient, news []*News) {
+	if len(news) == 0 {
+		return
+	}
+
+	pbNews := make([]*pb.News, len(news))
+	for i, n := range news {
+		var publishedAtStr string
+		if !n.PublishedAt.IsZero() {
+			publishedAtStr = n.PublishedAt.Format(time.RFC3339)
+		}
+
+		pbNews[i] = &pb.News{
+			Source:      n.Source,
+			Author:      n.Author,
+			Title:       n.Title,
+			Description: n.Description,
+			Url:         n.URL,
+			ImageUrl:    n.ImageURL,
+			PublishedAt: publishedAtStr,
+			Content:     n.Content,
+		}
+	}
+
+	resp, err := client.SaveNews(ctx, &pb.SaveNewsRequest{News: pbNews})
+	if err != nil || len(resp.Ids) != len(news) {
+		return
+	}
+	for i, id := range resp.Ids {
+		news[i].ID = id
+	}
+}
+
+// searchFilters - параметры поиска для истории; пустые значения не сохраняются
+func searchFilters(req *SearchRequest) *pb.SearchFilters {
+	optionalString := func(v string) *string {
+		if v == "" {
+			return nil
+		}
+		return &v
+	}
+	optionalInt := func(v int) *int32 {
+		if v == 0 {
+			return nil
+		}
+		i := int32(v)
+		return &i
+	}
+
+	return &pb.SearchFilters{
+		Sources:  optionalString(req.Sources),
+		Domains:  optionalString(req.Domains),
+		From:     optionalString(req.From),
+		To:       optionalString(req.To),
+		Language: optionalString(req.Language),
+		SortBy:   optionalString(req.SortBy),
+		PageSize: optionalInt(req.PageSize),
+		Page:     optionalInt(req.Page),
+	}
+}
+
+func (s *SearchService) GetTopHeadlines(ctx context.Context, req *TopHeadlinesRequest) ([]*News, int, error) {
+	conn, dialErr := s.dialSave()
+	var client pb.SaveServiceClient
+	if dialErr == nil {
 		defer conn.Close()
+		client = pb.NewSaveServiceClient(conn)
+	}
 
-		client := pb.NewSaveServiceClient(conn)
-
-		// Convert to protobuf
-		pbNews := make([]*pb.News, len(news))
-		for i, n := range news {
-			pbNews[i] = &pb.News{
-				Source:      n.Source,
-				Author:      n.Author,
-				Title:       n.Title,
-				Description: n.Description,
-				Url:         n.URL,
-				ImageUrl:    n.ImageURL,
-				PublishedAt: n.PublishedAt.Format(time.RFC3339),
-			}
+	// Незаданные параметры берём из профиля пользователя
+	if client != nil {
+		applyHeadlinesDefaults(req, userProfile(ctx, client, req.UserID))
+	}
+
+	// Check cache
+	cacheKey := fmt.Sprintf("headlines:%s:%s:%s:%s:%d:%d",
+		req.Country, req.Category, req.Sources, req.Query, req.PageSize, req.Page)
+
+	var cached cachedResult
+	cachedJSON, err := s.cache.Get(ctx, cacheKey)
+	isCached := err == nil && cachedJSON != "" && json.Unmarshal([]byte(cachedJSON), &cached) == nil
+
+	observeCacheLookup("headlines", isCached)
+
+	news, total := cached.News, cached.Total
+
+	if !isCached {
+		settings, err := s.provider()
+		if err == nil {
+			start := time.Now()
+			news, total, err = s.newsAPI.GetTopHeadlines(ctx, req)
+			observeNewsAPI("top_headlines", start, err)
 		}
 
-		// Save news
-		client.SaveNews(ctx, &pb.SaveNewsRequest{News: pbNews})
+		if err != nil {
+			// у заголовков нет запроса пользователя, по которому искать в архиве
+			result, ok := s.fallback(ctx, "headlines", cacheKey, nil, err)
+			if !ok {
+				return nil, 0, err
+			}
+			news, total = result.News, result.Total
+		} else {
+			// Заголовки тоже сохраняем, чтобы у них были ID для отметок о просмотре
+			if client != nil {
+				saveNews(ctx, client, news)
+			}
 
-		// Save search history
-		newsIDs := make([]uint64, len(news))
-		for i, n := range news {
-			newsIDs[i] = n.ID
+			s.storeResult(ctx, cacheKey, news, total, settings.HeadlinesTTL, settings.StaleTTL)
 		}
-		client.AddToSearchHistory(ctx, &pb.AddToSearchHistoryRequest{
-			UserId:  req.UserID,
-			Query:   req.Query,
-			Results: newsIDs,
-		})
+	}
+
+	if req.UnseenOnly && client != nil {
+		news = filterSeen(ctx, client, req.UserID, news)
 	}
 
 	return news, total, nil
 }
 
-func (s *SearchService) GetTopHeadlines(ctx context.Context, req *TopHeadlinesRequest) ([]*News, int, error) {
-	// Similar implementation...
-	// todo
-	return nil, 0, nil
+// filterSeen - убирает новости, просмотренные пользователем; при ошибке save service возвращает всё
+func filterSeen(ctx context.Context, client pb.SaveServiceClient, userID uint64, news []*News) []*News {
+	ids := make([]uint64, 0, len(news))
+	for _, n := range news {
+		if n.ID != 0 {
+			ids = append(ids, n.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return news
+	}
+
+	resp, err := client.GetSeen(ctx, &pb.GetSeenRequest{UserId: userID, NewsIds: ids})
+	if err != nil {
+		return news
+	}
+
+	seen := make(map[uint64]bool, len(resp.Seen))
+	for _, s := range resp.Seen {
+		seen[s.NewsId] = true
+	}
+
+	unseen := make([]*News, 0, len(news))
+	for _, n := range news {
+		if !seen[n.ID] {
+			unseen = append(unseen, n)
+		}
+	}
+	return unseen
 }
 
 func (s *SearchService) CheckNewArticles(ctx context.Context, keyword, lastCheckTimeStr string) ([]*News, error) {
+	settings, err := s.provider()
+	if err != nil {
+		return nil, fmt.Errorf("failed to check new articles: %w", err)
+	}
+
 	// Парсим строку времени в time.Time
 	var lastCheckTime time.Time
-	var err error
 
 	if lastCheckTimeStr != "" {
 		lastCheckTime, err = time.Parse(time.RFC3339, lastCheckTimeStr)
@@ -173,7 +392,9 @@ func (s *SearchService) CheckNewArticles(ctx context.Context, keyword, lastCheck
 	}
 
 	// Ищем новые статьи
+	start := time.Now()
 	news, totalResults, err := s.newsAPI.SearchEverything(ctx, searchReq)
+	observeNewsAPI("everything", start, err)
 	if err != nil {
 		return nil, fmt.Errorf("failed to check new articles: %w", err)
 	}
@@ -185,36 +406,15 @@ func (s *SearchService) CheckNewArticles(ctx context.Context, keyword, lastCheck
 	// Сохраняем в кэш
 	cacheKey := fmt.Sprintf("check:%s:%s", keyword, fromTime)
 	newsJSON, _ := json.Marshal(news)
-	s.cache.Set(ctx, cacheKey, string(newsJSON), 30*time.Minute)
+	s.cache.Set(ctx, cacheKey, string(newsJSON), settings.CheckTTL)
 
 	// Сохраняем в базу через save service
-	conn, err := grpc.Dial(s.saveServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := s.dialSave()
 	if err == nil {
 		defer conn.Close()
 
-		client := pb.NewSaveServiceClient(conn)
-
-		// Конвертируем в protobuf
-		pbNews := make([]*pb.News, len(news))
-		for i, n := range news {
-			var publishedAtStr string
-			if !n.PublishedAt.IsZero() {
-				publishedAtStr = n.PublishedAt.Format(time.RFC3339)
-			}
-
-			pbNews[i] = &pb.News{
-				Source:      n.Source,
-				Author:      n.Author,
-				Title:       n.Title,
-				Description: n.Description,
-				Url:         n.URL,
-				ImageUrl:    n.ImageURL,
-				PublishedAt: publishedAtStr,
-			}
-		}
-
 		// Сохраняем новости
-		client.SaveNews(ctx, &pb.SaveNewsRequest{News: pbNews})
+		saveNews(ctx, pb.NewSaveServiceClient(conn), news)
 	}
 
 	return news, nil
@@ -0,0 +1,61 @@
+package searchService
+
+import (
+	"context"
+	"gonews/protos/pb"
+)
+
+// userProfile - профиль пользователя из save service; nil, если получить не удалось
+func userProfile(ctx context.Context, client pb.SaveServiceClient, userID uint64) *pb.User {
+	if userID == 0 {
+		return nil
+	}
+
+	resp, err := client.GetUser(ctx, &pb.GetUserRequest{UserId: userID})
+	if err != nil {
+		return nil
+	}
+	return resp.User
+}
+
+// applySearchDefaults - подставляет фильтры по умолчанию в незаданные поля поиска.
+// Язык берётся из фильтров, а если его там нет - из предпочтительного языка профиля.
+func applySearchDefaults(req *SearchRequest, user *pb.User) {
+	if user == nil {
+		return
+	}
+	filters := user.DefaultFilters
+	if filters == nil {
+		filters = &pb.SearchFilters{}
+	}
+
+	setDefault(&req.Sources, filters.GetSources())
+	setDefault(&req.Domains, filters.GetDomains())
+	setDefault(&req.Language, filters.GetLanguage())
+	setDefault(&req.Language, user.Language)
+	setDefault(&req.SortBy, filters.GetSortBy())
+	if req.PageSize == 0 {
+		req.PageSize = int(filters.GetPageSize())
+	}
+}
+
+// applyHeadlinesDefaults - страна из профиля и размер страницы из фильтров по умолчанию.
+// NewsAPI не принимает country вместе с sources, поэтому страну подставляем только без источников.
+func applyHeadlinesDefaults(req *TopHeadlinesRequest, user *pb.User) {
+	if user == nil {
+		return
+	}
+
+	if req.Sources == "" {
+		setDefault(&req.Country, user.Country)
+	}
+	if req.PageSize == 0 {
+		req.PageSize = int(user.DefaultFilters.GetPageSize())
+	}
+}
+
+func setDefault(field *string, value string) {
+	if *field == "" {
+		*field = value
+	}
+}
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"gonews/pkg/tracing"
 	"time"
 
 	"github.com/go-redis/redis/v8"
@@ -17,6 +18,7 @@ func NewRedisStorage(addr string) (*RedisStorage, error) {
 		Password: "", // no password set
 		DB:       0,  // use default DB
 	})
+	client.AddHook(tracing.RedisHook{})
 
 	// Test connection
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -40,3 +42,34 @@ func (r *RedisStorage) Set(ctx context.Context, key string, value string, expira
 func (r *RedisStorage) Delete(ctx context.Context, key string) error {
 	return r.client.Del(ctx, key).Err()
 }
+
+// deleteBatch - сколько ключей просим у SCAN и удаляем за один вызов
+const deleteBatch = 500
+
+// DeleteMatching - удаляет ключи по glob pattern; SCAN вместо KEYS, чтобы не блокировать Redis
+func (r *RedisStorage) DeleteMatching(ctx context.Context, pattern string) (int64, error) {
+	var deleted int64
+	var cursor uint64
+	for {
+		keys, next, err := r.client.Scan(ctx, cursor, pattern, deleteBatch).Result()
+		if err != nil {
+			return deleted, err
+		}
+		if len(keys) > 0 {
+			n, err := r.client.Unlink(ctx, keys...).Result()
+			deleted += n
+			if err != nil {
+				return deleted, err
+			}
+		}
+		if next == 0 {
+			return deleted, nil
+		}
+		cursor = next
+	}
+}
+
+// Ping - проверка соединения с Redis для health check
+func (r *RedisStorage) Ping(ctx context.Context) error {
+	return r.client.Ping(ctx).Err()
+}